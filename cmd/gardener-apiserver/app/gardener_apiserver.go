@@ -37,11 +37,16 @@ import (
 	"github.com/gardener/gardener/pkg/version"
 	controllerregistrationresources "github.com/gardener/gardener/plugin/pkg/controllerregistration/resources"
 	"github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation"
+	"github.com/gardener/gardener/plugin/pkg/global/projectresourcequota"
 	"github.com/gardener/gardener/plugin/pkg/global/resourcereferencemanager"
 	plantvalidator "github.com/gardener/gardener/plugin/pkg/plant"
 	shootdns "github.com/gardener/gardener/plugin/pkg/shoot/dns"
+	shootlock "github.com/gardener/gardener/plugin/pkg/shoot/lock"
 	clusteropenidconnectpreset "github.com/gardener/gardener/plugin/pkg/shoot/oidc/clusteropenidconnectpreset"
 	openidconnectpreset "github.com/gardener/gardener/plugin/pkg/shoot/oidc/openidconnectpreset"
+	shootpolicy "github.com/gardener/gardener/plugin/pkg/shoot/policy"
+	clustershootpreset "github.com/gardener/gardener/plugin/pkg/shoot/preset/clustershootpreset"
+	shootpreset "github.com/gardener/gardener/plugin/pkg/shoot/preset/shootpreset"
 	shootquotavalidator "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 	shootvalidator "github.com/gardener/gardener/plugin/pkg/shoot/validator"
 
@@ -138,24 +143,34 @@ func (o *Options) complete() error {
 	// Admission plugin registration
 	resourcereferencemanager.Register(o.Recommended.Admission.Plugins)
 	deletionconfirmation.Register(o.Recommended.Admission.Plugins)
+	shootlock.Register(o.Recommended.Admission.Plugins)
+	projectresourcequota.Register(o.Recommended.Admission.Plugins)
 	shootquotavalidator.Register(o.Recommended.Admission.Plugins)
 	shootdns.Register(o.Recommended.Admission.Plugins)
 	shootvalidator.Register(o.Recommended.Admission.Plugins)
+	shootpolicy.Register(o.Recommended.Admission.Plugins)
 	controllerregistrationresources.Register(o.Recommended.Admission.Plugins)
 	plantvalidator.Register(o.Recommended.Admission.Plugins)
 	openidconnectpreset.Register(o.Recommended.Admission.Plugins)
 	clusteropenidconnectpreset.Register(o.Recommended.Admission.Plugins)
+	shootpreset.Register(o.Recommended.Admission.Plugins)
+	clustershootpreset.Register(o.Recommended.Admission.Plugins)
 
 	allOrderedPlugins := []string{
 		resourcereferencemanager.PluginName,
 		shootdns.PluginName,
 		shootquotavalidator.PluginName,
 		shootvalidator.PluginName,
+		shootpolicy.PluginName,
 		controllerregistrationresources.PluginName,
 		plantvalidator.PluginName,
 		deletionconfirmation.PluginName,
+		shootlock.PluginName,
+		projectresourcequota.PluginName,
 		openidconnectpreset.PluginName,
 		clusteropenidconnectpreset.PluginName,
+		shootpreset.PluginName,
+		clustershootpreset.PluginName,
 	}
 
 	o.Recommended.Admission.RecommendedPluginOrder = append(o.Recommended.Admission.RecommendedPluginOrder, allOrderedPlugins...)
@@ -238,7 +253,9 @@ func (o *Options) config() (*apiserver.Config, error) {
 
 	return &apiserver.Config{
 		GenericConfig: gardenerAPIServerConfig,
-		ExtraConfig:   apiserver.ExtraConfig{},
+		ExtraConfig: apiserver.ExtraConfig{
+			KubeClient: kubeClient,
+		},
 	}, nil
 }
 
@@ -0,0 +1,50 @@
+<%
+  import os, yaml
+
+  values={}
+  if context.get("values", "") != "":
+    values=yaml.load(open(context.get("values", "")), Loader=yaml.Loader)
+
+  def value(path, default):
+    keys=str.split(path, ".")
+    root=values
+    for key in keys:
+      if isinstance(root, dict):
+        if key in root:
+          root=root[key]
+        else:
+          return default
+      else:
+        return default
+    return root
+
+  annotations = value("metadata.annotations", {}); labels = value("metadata.labels", {})
+%># ClusterShootPolicy contains constraints that are enforced for Shoot objects cluster-wide when they are created or updated.
+---
+apiVersion: settings.gardener.cloud/v1alpha1
+kind: ClusterShootPolicy
+metadata:
+  name:  ${value("metadata.name", "example-policy")}<% annotations = value("metadata.annotations", {}); labels = value("metadata.labels", {}) %>
+  % if annotations != {}:
+  annotations: ${yaml.dump(annotations, width=1000, default_flow_style=None)}
+  % endif
+  % if labels != {}:
+  labels: ${yaml.dump(labels, width=10000, default_flow_style=None)}
+  % endif
+spec:
+  projectSelector: # use {} to select all Projects
+    matchExpressions:
+    - {key: stage, operator: In, values: [production]}
+  kubernetesVersionConstraint: ">= 1.14, < 1.16"
+  regions:
+  - eu-west-1
+  - eu-central-1
+  machineTypes:
+  - m5.large
+  - m5.xlarge
+  maxWorkers: 10 # sum of the maximum sizes of all worker pools
+  allowPrivilegedContainers: false
+  requiredAddons: # supported values: kubernetes-dashboard, nginx-ingress
+  - nginx-ingress
+  # requiredExtensions:
+  # - foobar
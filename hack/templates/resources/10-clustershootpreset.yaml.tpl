@@ -0,0 +1,69 @@
+<%
+  import os, yaml
+
+  values={}
+  if context.get("values", "") != "":
+    values=yaml.load(open(context.get("values", "")), Loader=yaml.Loader)
+
+  def value(path, default):
+    keys=str.split(path, ".")
+    root=values
+    for key in keys:
+      if isinstance(root, dict):
+        if key in root:
+          root=root[key]
+        else:
+          return default
+      else:
+        return default
+    return root
+
+  annotations = value("metadata.annotations", {}); labels = value("metadata.labels", {})
+%># ClusterShootPreset contains default values that are applied to Shoot objects cluster-wide when they are created.
+---
+apiVersion: settings.gardener.cloud/v1alpha1
+kind: ClusterShootPreset
+metadata:
+  name:  ${value("metadata.name", "example-preset")}<% annotations = value("metadata.annotations", {}); labels = value("metadata.labels", {}) %>
+  % if annotations != {}:
+  annotations: ${yaml.dump(annotations, width=1000, default_flow_style=None)}
+  % endif
+  % if labels != {}:
+  labels: ${yaml.dump(labels, width=10000, default_flow_style=None)}
+  % endif
+spec:
+  shootSelector: # use {} to select all Shoots in a matched namespace
+    matchExpressions:
+    - {key: purpose, operator: In, values: [development]}
+  projectSelector: # use {} to select all Projects
+    matchExpressions:
+    - {key: shoot-presets, operator: In, values: [enabled]}
+  template:
+    maintenance:
+      timeWindow:
+        begin: 220000+0100
+        end: 230000+0100
+    # autoUpdate:
+    #   kubernetesVersion: true
+    #   machineImageVersion: true
+    hibernation:
+      schedules:
+      - start: "00 20 * * 1,2,3,4,5"
+        end: "00 08 * * 1,2,3,4,5"
+        location: Europe/Berlin
+    addons:
+      nginx-ingress:
+        enabled: false
+    # kubelet:
+    #   maxPods: 110
+    # extensions:
+    # - type: foobar
+    #   providerConfig:
+    #     apiVersion: foobar.extensions.gardener.cloud/v1alpha1
+    #     kind: FooBarConfiguration
+    #     foo: bar
+    monitoring:
+      alerting:
+        emailReceivers:
+        - john.doe@example.com
+  weight: 90 # value from 1 to 100
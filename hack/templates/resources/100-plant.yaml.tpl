@@ -28,6 +28,9 @@ metadata:
   namespace: ${value("metadata.namespace", "garden-dev")}
   % if annotations != {}:
   annotations: ${yaml.dump(annotations, width=1000, default_flow_style=None)}
+  % else:
+# annotations:
+#   plant.gardener.cloud/export-shoot-template: "true" # exports a Shoot manifest template of the cluster into the ConfigMap '${value("metadata.name", "example-plant")}.shoot-template'
   % endif
   % if labels != {}:
   labels: ${yaml.dump(labels, width=10000, default_flow_style=None)}
@@ -35,6 +38,7 @@ metadata:
 spec:
   secretRef:
     name: my-external-cluster-secret
+  cloudProfileName: aws # optional, used to check whether the Kubernetes version of the cluster is offered by this CloudProfile
   endpoints:
   - name: Kibana Dashboard
     url: https://...
@@ -0,0 +1,31 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+// Field path constants that are specific to the internal API
+// representation.
+const (
+	// BackupBucketSeedName is the field selector path for finding
+	// the Seed cluster of a core.gardener.cloud/v1alpha1 BackupBucket.
+	BackupBucketSeedName = "spec.seed"
+
+	// BackupEntrySeedName is the field selector path for finding
+	// the Seed cluster of a core.gardener.cloud/v1alpha1 BackupEntry.
+	BackupEntrySeedName = "spec.seed"
+
+	// ControllerInstallationSeedRefName is the field selector path for finding
+	// the Seed cluster of a core.gardener.cloud/v1alpha1 ControllerInstallation.
+	ControllerInstallationSeedRefName = "spec.seedRef.name"
+)
@@ -68,6 +68,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&garden.SeedList{},
 		&garden.Shoot{},
 		&garden.ShootList{},
+		&garden.AdminKubeconfigRequest{},
 	)
 	return nil
 }
@@ -60,6 +60,8 @@ type ControllerInstallationStatus struct {
 	// ProviderStatus contains type-specific status.
 	// +optional
 	ProviderStatus *ProviderConfig
+	// ObservedGeneration is the most recent generation observed for this ControllerInstallation.
+	ObservedGeneration int64
 }
 
 const (
@@ -68,4 +70,8 @@ const (
 
 	// ControllerInstallationInstalled is a condition type for indicating whether the controller has been installed.
 	ControllerInstallationInstalled ConditionType = "Installed"
+
+	// ControllerInstallationHealthy is a condition type for indicating whether the resources deployed for the
+	// controller are healthy.
+	ControllerInstallationHealthy ConditionType = "Healthy"
 )
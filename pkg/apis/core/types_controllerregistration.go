@@ -29,6 +29,8 @@ type ControllerRegistration struct {
 	metav1.ObjectMeta
 	// Spec contains the specification of this registration.
 	Spec ControllerRegistrationSpec
+	// Status contains the status of this registration.
+	Status ControllerRegistrationStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -49,6 +51,26 @@ type ControllerRegistrationSpec struct {
 	Resources []ControllerResource
 	// Deployment contains information for how this controller is deployed.
 	Deployment *ControllerDeployment
+	// RolloutStrategy defines how changes of this registration are rolled out to the seeds. If it is not specified
+	// then all ControllerInstallations are updated at once.
+	RolloutStrategy *ControllerRolloutStrategy
+	// SeedSelector is a label selector for the seeds to which the controller is installed. If it is not specified
+	// then the controller is installed to all seeds.
+	SeedSelector *metav1.LabelSelector
+	// Dependencies is a list of other ControllerRegistrations which must be installed to a seed before this
+	// controller is installed. They are not uninstalled as long as this controller is installed.
+	Dependencies []ControllerDependency
+}
+
+// ControllerDependency is a dependency on another ControllerRegistration. It either references the registration by
+// its name or by a resource kind/type which is supported by the registration.
+type ControllerDependency struct {
+	// Name is the name of the required ControllerRegistration.
+	Name string
+	// Kind is the resource kind, for example "Infrastructure", which is supported by the required ControllerRegistration.
+	Kind string
+	// Type is the resource type, for example "aws", which is supported by the required ControllerRegistration.
+	Type string
 }
 
 // ControllerResource is a combination of a kind (DNSProvider, Infrastructure, Generic, ...) and the actual type for this
@@ -71,3 +93,62 @@ type ControllerDeployment struct {
 	// ProviderConfig contains type-specific configuration.
 	ProviderConfig *ProviderConfig
 }
+
+// ControllerRolloutStrategy defines how changes of a ControllerRegistration are rolled out to the seeds.
+type ControllerRolloutStrategy struct {
+	// Waves is an ordered list of waves in which the seeds are updated. A seed belongs to the first wave whose seed
+	// selector matches it. Seeds which are not matched by any wave are updated in an implicit last wave. A wave is
+	// only started when all seeds of the previous waves have been updated successfully.
+	Waves []ControllerRolloutWave
+	// MaxUnavailable is the maximum number of seeds whose updated ControllerInstallations may not be healthy yet.
+	MaxUnavailable *int32
+	// Paused indicates that the rollout is paused, i.e., no further seeds are updated.
+	Paused bool
+}
+
+// ControllerRolloutWave is a wave of a rollout of a ControllerRegistration.
+type ControllerRolloutWave struct {
+	// Name is the name of the wave.
+	Name string
+	// SeedSelector is a label selector for the seeds of this wave.
+	SeedSelector *metav1.LabelSelector
+}
+
+// ControllerRegistrationStatus is the status of a ControllerRegistration.
+type ControllerRegistrationStatus struct {
+	// ObservedGeneration is the most recent generation observed for this ControllerRegistration.
+	ObservedGeneration int64
+	// Rollout contains information about the rollout of the current specification to the seeds.
+	Rollout *ControllerRolloutStatus
+}
+
+// ControllerRolloutStatus contains information about the rollout of a ControllerRegistration.
+type ControllerRolloutStatus struct {
+	// Phase is the phase of the rollout.
+	Phase ControllerRolloutPhase
+	// CurrentWave is the name of the wave which is currently rolled out.
+	CurrentWave *string
+	// TotalSeeds is the number of seeds to which the registration is rolled out.
+	TotalSeeds int32
+	// UpdatedSeeds is the number of seeds whose ControllerInstallations have been updated.
+	UpdatedSeeds int32
+	// AvailableSeeds is the number of seeds whose updated ControllerInstallations are healthy.
+	AvailableSeeds int32
+	// Message is a human-readable message about the rollout, e.g. the reason why it is paused.
+	Message string
+	// LastUpdateTime is the last time the rollout status was updated.
+	LastUpdateTime metav1.Time
+}
+
+// ControllerRolloutPhase is the phase of a rollout of a ControllerRegistration.
+type ControllerRolloutPhase string
+
+const (
+	// ControllerRolloutProgressing indicates that the rollout is in progress.
+	ControllerRolloutProgressing ControllerRolloutPhase = "Progressing"
+	// ControllerRolloutPaused indicates that the rollout is paused, either explicitly or because updated
+	// ControllerInstallations are unhealthy.
+	ControllerRolloutPaused ControllerRolloutPhase = "Paused"
+	// ControllerRolloutCompleted indicates that the rollout is completed.
+	ControllerRolloutCompleted ControllerRolloutPhase = "Completed"
+)
@@ -49,6 +49,18 @@ const (
 	PlantEveryNodeReady ConditionType = "EveryNodeReady"
 	// PlantAPIServerAvailable is a constant for a condition type indicating that the Plant cluster API server is available.
 	PlantAPIServerAvailable ConditionType = "APIServerAvailable"
+	// PlantSystemComponentsHealthy is a constant for a condition type indicating the health of the deployments and
+	// daemon sets in the kube-system namespace of the Plant cluster.
+	PlantSystemComponentsHealthy ConditionType = "SystemComponentsHealthy"
+	// PlantKubernetesVersionSupported is a constant for a condition type indicating whether the Kubernetes version
+	// of the Plant cluster is offered (and not expired) by the referenced CloudProfile.
+	PlantKubernetesVersionSupported ConditionType = "KubernetesVersionSupported"
+	// PlantCertificatesValid is a constant for a condition type indicating whether the certificates contained in the
+	// kubeconfig of the Plant cluster are valid and not about to expire.
+	PlantCertificatesValid ConditionType = "CertificatesValid"
+	// PlantEndpointsReachable is a constant for a condition type indicating whether the endpoints listed in the
+	// Plant specification are reachable.
+	PlantEndpointsReachable ConditionType = "EndpointsReachable"
 )
 
 // PlantSpec is the specification of a Plant.
@@ -58,6 +70,8 @@ type PlantSpec struct {
 	SecretRef corev1.LocalObjectReference
 	// Endpoints is the configuration plant endpoints
 	Endpoints []Endpoint
+	// CloudProfileName is the name of a CloudProfile the Kubernetes version of the Plant cluster is compared to.
+	CloudProfileName *string
 }
 
 // Endpoint is an endpoint for monitoring, logging and other services around the plant.
@@ -87,6 +101,8 @@ type ClusterInfo struct {
 	Cloud CloudInfo
 	// Kubernetes describes kubernetes meta information (e.g., version)
 	Kubernetes KubernetesInfo
+	// NodePools describes the groups of nodes detected in the Plant cluster.
+	NodePools []NodePoolInfo
 }
 
 // CloudInfo contains information about the cloud
@@ -102,3 +118,20 @@ type KubernetesInfo struct {
 	// Version is the semantic Kubernetes version to use for the Plant cluster.
 	Version string
 }
+
+// NodePoolInfo contains information about a group of nodes of the Plant cluster that share the same pool and
+// machine type.
+type NodePoolInfo struct {
+	// Name is the name of the node pool as detected from well-known node labels.
+	Name string
+	// MachineType is the machine type of the nodes in this pool.
+	MachineType string
+	// OSImage is the operating system image reported by the nodes in this pool.
+	OSImage string
+	// KubeletVersion is the kubelet version reported by the nodes in this pool.
+	KubeletVersion string
+	// Zones is the list of availability zones the nodes of this pool are spread across.
+	Zones []string
+	// Count is the number of nodes in this pool.
+	Count int32
+}
@@ -229,6 +229,9 @@ const (
 	// For example, if the shoot is annotated with <AnnotationShootCustom>key=value,
 	// then the namespace in the seed will be annotated with <AnnotationShootCustom>key=value, as well.
 	AnnotationShootCustom = "custom.shoot.sapcloud.io/"
+	// AnnotationPlantExportShootTemplate is the key for an annotation of a Plant resource indicating that a Shoot
+	// manifest template derived from the detected cluster information shall be exported into a ConfigMap.
+	AnnotationPlantExportShootTemplate = "plant.gardener.cloud/export-shoot-template"
 
 	// OperatingSystemConfigUnitNameKubeletService is a constant for a unit in the operating system config that contains the kubelet service.
 	OperatingSystemConfigUnitNameKubeletService = "kubelet.service"
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	"github.com/gardener/gardener/pkg/apis/garden"
 	"github.com/gardener/gardener/pkg/utils"
@@ -45,7 +46,47 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 	if err := scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("Shoot"),
 		func(label, value string) (string, string, error) {
 			switch label {
-			case "metadata.name", "metadata.namespace", garden.ShootSeedName, garden.ShootCloudProfileName:
+			case "metadata.name", "metadata.namespace", garden.ShootSeedName, garden.ShootCloudProfileName, garden.ShootRegion,
+				garden.ShootProviderType, garden.ShootSecretBindingName, garden.ShootStatusLastOperationState:
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("BackupBucket"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "metadata.name", core.BackupBucketSeedName:
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("BackupEntry"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "metadata.name", "metadata.namespace", core.BackupEntrySeedName:
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("ControllerInstallation"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "metadata.name", core.ControllerInstallationSeedRefName:
 				return label, value, nil
 			default:
 				return "", "", fmt.Errorf("field label not supported: %s", label)
@@ -134,6 +175,7 @@ func Convert_v1alpha1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out *ga
 		out.ProjectMembers = append(out.ProjectMembers, garden.ProjectMember{
 			Subject: member.Subject,
 			Role:    member.Role,
+			Roles:   member.Roles,
 		})
 	}
 
@@ -149,6 +191,7 @@ func Convert_garden_ProjectSpec_To_v1alpha1_ProjectSpec(in *garden.ProjectSpec,
 		out.Members = append(out.Members, ProjectMember{
 			Subject: member.Subject,
 			Role:    member.Role,
+			Roles:   member.Roles,
 		})
 	}
 
@@ -109,43 +109,11 @@ func SetDefaults_Shoot(obj *Shoot) {
 		obj.Spec.Kubernetes.KubeProxy.Mode = &defaultProxyMode
 	}
 
+	// The addons are required for the conversion, the kubernetes-dashboard addon is defaulted by the Shoot strategy
+	// after the mutating admission plugins (e.g. the Shoot presets) ran.
 	if obj.Spec.Addons == nil {
 		obj.Spec.Addons = &Addons{}
 	}
-	if obj.Spec.Addons.KubernetesDashboard == nil {
-		obj.Spec.Addons.KubernetesDashboard = &KubernetesDashboard{}
-	}
-	if obj.Spec.Addons.KubernetesDashboard.AuthenticationMode == nil {
-		var defaultAuthMode string
-		if k8sVersionLessThan116 {
-			defaultAuthMode = KubernetesDashboardAuthModeBasic
-		} else {
-			defaultAuthMode = KubernetesDashboardAuthModeToken
-		}
-		obj.Spec.Addons.KubernetesDashboard.AuthenticationMode = &defaultAuthMode
-	}
-}
-
-// SetDefaults_Maintenance sets default values for Maintenance objects.
-func SetDefaults_Maintenance(obj *Maintenance) {
-	if obj == nil {
-		obj = &Maintenance{}
-	}
-
-	if obj.AutoUpdate == nil {
-		obj.AutoUpdate = &MaintenanceAutoUpdate{
-			KubernetesVersion:   true,
-			MachineImageVersion: true,
-		}
-	}
-
-	if obj.TimeWindow == nil {
-		mt := utils.RandomMaintenanceTimeWindow()
-		obj.TimeWindow = &MaintenanceTimeWindow{
-			Begin: mt.Begin().Formatted(),
-			End:   mt.End().Formatted(),
-		}
-	}
 }
 
 // SetDefaults_Worker sets default values for Worker objects.
@@ -158,6 +126,14 @@ func SetDefaults_Worker(obj *Worker) {
 	}
 }
 
+// SetDefaults_ControllerRolloutStrategy sets default values for ControllerRolloutStrategy objects.
+func SetDefaults_ControllerRolloutStrategy(obj *ControllerRolloutStrategy) {
+	if obj.MaxUnavailable == nil {
+		maxUnavailable := int32(1)
+		obj.MaxUnavailable = &maxUnavailable
+	}
+}
+
 // Helper functions
 
 func calculateDefaultNodeCIDRMaskSize(kubelet *KubeletConfig, workers []Worker) *int32 {
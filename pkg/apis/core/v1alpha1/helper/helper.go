@@ -15,6 +15,7 @@
 package helper
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/gardener/gardener/pkg/logger"
 	"sort"
@@ -149,6 +150,26 @@ func IsResourceSupported(resources []gardencorev1alpha1.ControllerResource, reso
 	return false
 }
 
+// ComputeControllerRegistrationSpecHash computes the hash of the given ControllerRegistration specification which is
+// stored in the registration spec hash label of ControllerInstallations. The rollout strategy and the seed selector are
+// not part of the hash because changing them must not roll out the registration again.
+func ComputeControllerRegistrationSpecHash(spec gardencorev1alpha1.ControllerRegistrationSpec) (string, error) {
+	spec.RolloutStrategy = nil
+	spec.SeedSelector = nil
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+
+	var specMap map[string]interface{}
+	if err := json.Unmarshal(data, &specMap); err != nil {
+		return "", err
+	}
+
+	return utils.HashForMap(specMap)[:16], nil
+}
+
 // IsControllerInstallationSuccessful returns true if a ControllerInstallation has been marked as "successfully"
 // installed.
 func IsControllerInstallationSuccessful(controllerInstallation gardencorev1alpha1.ControllerInstallation) bool {
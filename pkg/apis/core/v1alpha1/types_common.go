@@ -28,6 +28,9 @@ const (
 	ErrorInfraQuotaExceeded ErrorCode = "ERR_INFRA_QUOTA_EXCEEDED"
 	// ErrorInfraDependencies indicates that the last error occurred due to dependent objects on the cloud provider level.
 	ErrorInfraDependencies ErrorCode = "ERR_INFRA_DEPENDENCIES"
+	// ErrorExtensionStuck indicates that the last error occurred due to an extension resource whose reconciliation did
+	// not make progress within its reconcile timeout.
+	ErrorExtensionStuck ErrorCode = "ERR_EXTENSION_STUCK"
 )
 
 // LastError indicates the last occurred error for an operation on a resource.
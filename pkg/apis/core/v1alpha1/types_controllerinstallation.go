@@ -62,6 +62,9 @@ type ControllerInstallationStatus struct {
 	// ProviderStatus contains type-specific status.
 	// +optional
 	ProviderStatus *ProviderConfig `json:"providerStatus,omitempty"`
+	// ObservedGeneration is the most recent generation observed for this ControllerInstallation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 const (
@@ -70,4 +73,8 @@ const (
 
 	// ControllerInstallationInstalled is a condition type for indicating whether the controller has been installed.
 	ControllerInstallationInstalled ConditionType = "Installed"
+
+	// ControllerInstallationHealthy is a condition type for indicating whether the resources deployed for the
+	// controller are healthy.
+	ControllerInstallationHealthy ConditionType = "Healthy"
 )
@@ -29,6 +29,9 @@ type ControllerRegistration struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Spec contains the specification of this registration.
 	Spec ControllerRegistrationSpec `json:"spec,omitempty"`
+	// Status contains the status of this registration.
+	// +optional
+	Status ControllerRegistrationStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -51,6 +54,32 @@ type ControllerRegistrationSpec struct {
 	// Deployment contains information for how this controller is deployed.
 	// +optional
 	Deployment *ControllerDeployment `json:"deployment,omitempty"`
+	// RolloutStrategy defines how changes of this registration are rolled out to the seeds. If it is not specified
+	// then all ControllerInstallations are updated at once.
+	// +optional
+	RolloutStrategy *ControllerRolloutStrategy `json:"rolloutStrategy,omitempty"`
+	// SeedSelector is a label selector for the seeds to which the controller is installed. If it is not specified
+	// then the controller is installed to all seeds.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty"`
+	// Dependencies is a list of other ControllerRegistrations which must be installed to a seed before this
+	// controller is installed. They are not uninstalled as long as this controller is installed.
+	// +optional
+	Dependencies []ControllerDependency `json:"dependencies,omitempty"`
+}
+
+// ControllerDependency is a dependency on another ControllerRegistration. It either references the registration by
+// its name or by a resource kind/type which is supported by the registration.
+type ControllerDependency struct {
+	// Name is the name of the required ControllerRegistration.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Kind is the resource kind, for example "Infrastructure", which is supported by the required ControllerRegistration.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Type is the resource type, for example "aws", which is supported by the required ControllerRegistration.
+	// +optional
+	Type string `json:"type,omitempty"`
 }
 
 // ControllerResource is a combination of a kind (DNSProvider, Infrastructure, Generic, ...) and the actual type for this
@@ -76,3 +105,72 @@ type ControllerDeployment struct {
 	// +optional
 	ProviderConfig *ProviderConfig `json:"providerConfig,omitempty"`
 }
+
+// ControllerRolloutStrategy defines how changes of a ControllerRegistration are rolled out to the seeds.
+type ControllerRolloutStrategy struct {
+	// Waves is an ordered list of waves in which the seeds are updated. A seed belongs to the first wave whose seed
+	// selector matches it. Seeds which are not matched by any wave are updated in an implicit last wave. A wave is
+	// only started when all seeds of the previous waves have been updated successfully.
+	// +optional
+	Waves []ControllerRolloutWave `json:"waves,omitempty"`
+	// MaxUnavailable is the maximum number of seeds whose updated ControllerInstallations may not be healthy yet.
+	// Defaults to 1.
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+	// Paused indicates that the rollout is paused, i.e., no further seeds are updated.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ControllerRolloutWave is a wave of a rollout of a ControllerRegistration.
+type ControllerRolloutWave struct {
+	// Name is the name of the wave.
+	Name string `json:"name"`
+	// SeedSelector is a label selector for the seeds of this wave.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty"`
+}
+
+// ControllerRegistrationStatus is the status of a ControllerRegistration.
+type ControllerRegistrationStatus struct {
+	// ObservedGeneration is the most recent generation observed for this ControllerRegistration.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Rollout contains information about the rollout of the current specification to the seeds.
+	// +optional
+	Rollout *ControllerRolloutStatus `json:"rollout,omitempty"`
+}
+
+// ControllerRolloutStatus contains information about the rollout of a ControllerRegistration.
+type ControllerRolloutStatus struct {
+	// Phase is the phase of the rollout.
+	Phase ControllerRolloutPhase `json:"phase"`
+	// CurrentWave is the name of the wave which is currently rolled out.
+	// +optional
+	CurrentWave *string `json:"currentWave,omitempty"`
+	// TotalSeeds is the number of seeds to which the registration is rolled out.
+	TotalSeeds int32 `json:"totalSeeds"`
+	// UpdatedSeeds is the number of seeds whose ControllerInstallations have been updated.
+	UpdatedSeeds int32 `json:"updatedSeeds"`
+	// AvailableSeeds is the number of seeds whose updated ControllerInstallations are healthy.
+	AvailableSeeds int32 `json:"availableSeeds"`
+	// Message is a human-readable message about the rollout, e.g. the reason why it is paused.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastUpdateTime is the last time the rollout status was updated.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// ControllerRolloutPhase is the phase of a rollout of a ControllerRegistration.
+type ControllerRolloutPhase string
+
+const (
+	// ControllerRolloutProgressing indicates that the rollout is in progress.
+	ControllerRolloutProgressing ControllerRolloutPhase = "Progressing"
+	// ControllerRolloutPaused indicates that the rollout is paused, either explicitly or because updated
+	// ControllerInstallations are unhealthy.
+	ControllerRolloutPaused ControllerRolloutPhase = "Paused"
+	// ControllerRolloutCompleted indicates that the rollout is completed.
+	ControllerRolloutCompleted ControllerRolloutPhase = "Completed"
+)
@@ -50,6 +50,18 @@ const (
 	PlantEveryNodeReady ConditionType = "EveryNodeReady"
 	// PlantAPIServerAvailable is a constant for a condition type indicating that the Plant cluster API server is available.
 	PlantAPIServerAvailable ConditionType = "APIServerAvailable"
+	// PlantSystemComponentsHealthy is a constant for a condition type indicating the health of the deployments and
+	// daemon sets in the kube-system namespace of the Plant cluster.
+	PlantSystemComponentsHealthy ConditionType = "SystemComponentsHealthy"
+	// PlantKubernetesVersionSupported is a constant for a condition type indicating whether the Kubernetes version
+	// of the Plant cluster is offered (and not expired) by the referenced CloudProfile.
+	PlantKubernetesVersionSupported ConditionType = "KubernetesVersionSupported"
+	// PlantCertificatesValid is a constant for a condition type indicating whether the certificates contained in the
+	// kubeconfig of the Plant cluster are valid and not about to expire.
+	PlantCertificatesValid ConditionType = "CertificatesValid"
+	// PlantEndpointsReachable is a constant for a condition type indicating whether the endpoints listed in the
+	// Plant specification are reachable.
+	PlantEndpointsReachable ConditionType = "EndpointsReachable"
 )
 
 // PlantSpec is the specification of a Plant.
@@ -60,6 +72,9 @@ type PlantSpec struct {
 	// Endpoints is the configuration plant endpoints
 	// +optional
 	Endpoints []Endpoint `json:"endpoints,omitempty"`
+	// CloudProfileName is the name of a CloudProfile the Kubernetes version of the Plant cluster is compared to.
+	// +optional
+	CloudProfileName *string `json:"cloudProfileName,omitempty"`
 }
 
 // PlantStatus is the status of a Plant.
@@ -91,6 +106,9 @@ type ClusterInfo struct {
 	Cloud CloudInfo `json:"cloud"`
 	// Kubernetes describes kubernetes meta information (e.g., version)
 	Kubernetes KubernetesInfo `json:"kubernetes"`
+	// NodePools describes the groups of nodes detected in the Plant cluster.
+	// +optional
+	NodePools []NodePoolInfo `json:"nodePools,omitempty"`
 }
 
 // CloudInfo contains information about the cloud
@@ -106,3 +124,24 @@ type KubernetesInfo struct {
 	// Version is the semantic Kubernetes version to use for the Plant cluster.
 	Version string `json:"version"`
 }
+
+// NodePoolInfo contains information about a group of nodes of the Plant cluster that share the same pool and
+// machine type.
+type NodePoolInfo struct {
+	// Name is the name of the node pool as detected from well-known node labels.
+	Name string `json:"name"`
+	// MachineType is the machine type of the nodes in this pool.
+	// +optional
+	MachineType string `json:"machineType,omitempty"`
+	// OSImage is the operating system image reported by the nodes in this pool.
+	// +optional
+	OSImage string `json:"osImage,omitempty"`
+	// KubeletVersion is the kubelet version reported by the nodes in this pool.
+	// +optional
+	KubeletVersion string `json:"kubeletVersion,omitempty"`
+	// Zones is the list of availability zones the nodes of this pool are spread across.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+	// Count is the number of nodes in this pool.
+	Count int32 `json:"count"`
+}
@@ -73,6 +73,10 @@ type ProjectSpec struct {
 	// A nil value means that Gardener will determine the name of the namespace.
 	// +optional
 	Namespace *string `json:"namespace,omitempty"`
+	// ServiceAccounts is a list of service accounts which are created in the project namespace and bound to the
+	// given roles. They can be used by automation, e.g. CI pipelines, to access the project.
+	// +optional
+	ServiceAccounts []ProjectServiceAccount `json:"serviceAccounts,omitempty"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -82,6 +86,17 @@ type ProjectStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Phase is the current phase of the project.
 	Phase ProjectPhase `json:"phase,omitempty"`
+	// LastActivityTimestamp contains the timestamp of the last observed activity in the project, e.g. an operation
+	// on one of its Shoots or a change of its members.
+	// +optional
+	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty"`
+	// StaleSinceTimestamp contains the timestamp when the project was first discovered to be stale/unused.
+	// +optional
+	StaleSinceTimestamp *metav1.Time `json:"staleSinceTimestamp,omitempty"`
+	// StaleAutoDeleteTimestamp contains the timestamp when the project will be garbage-collected/automatically
+	// deleted because it's stale/unused.
+	// +optional
+	StaleAutoDeleteTimestamp *metav1.Time `json:"staleAutoDeleteTimestamp,omitempty"`
 }
 
 // ProjectMember is a member of a project.
@@ -91,6 +106,21 @@ type ProjectMember struct {
 	rbacv1.Subject `json:",inline"`
 	// Role represents the role of this member.
 	Role string `json:"role"`
+	// Roles represents additional roles of this member. Besides the built-in roles, extension roles of the form
+	// "extension:<name>" can be specified.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+}
+
+// ProjectServiceAccount is a service account which is managed by the project.
+type ProjectServiceAccount struct {
+	// Name is the name of the service account in the project namespace.
+	Name string `json:"name"`
+	// Role represents the role of this service account.
+	Role string `json:"role"`
+	// Roles represents additional roles of this service account.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
 }
 
 const (
@@ -98,6 +128,15 @@ const (
 	ProjectMemberAdmin = "admin"
 	// ProjectMemberViewer is a const for a role that provides limited permissions to only view some resources.
 	ProjectMemberViewer = "viewer"
+	// ProjectMemberUserAccessManager is a const for a role that provides permissions to manage the members of a
+	// project.
+	ProjectMemberUserAccessManager = "uam"
+	// ProjectMemberServiceAccountManager is a const for a role that provides permissions to manage the service
+	// accounts in the project namespace.
+	ProjectMemberServiceAccountManager = "serviceaccountmanager"
+	// ProjectMemberExtensionPrefix is the prefix of roles which are provided by extensions. The ClusterRoles of the
+	// extensions are aggregated into a ClusterRole which is bound in the project namespace.
+	ProjectMemberExtensionPrefix = "extension:"
 )
 
 // ProjectPhase is a label for the condition of a project at the current time.
@@ -121,4 +160,12 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventStale indicates that the project has been detected to be stale.
+	ProjectEventStale = "ProjectStale"
+	// ProjectEventNotStale indicates that a project which was stale before is in use again.
+	ProjectEventNotStale = "ProjectNotStale"
+	// ProjectEventAutoDeleted indicates that a stale project has been deleted automatically.
+	ProjectEventAutoDeleted = "ProjectAutoDeleted"
+	// ProjectEventAutoDeletionFailed indicates that the automatic deletion of a stale project failed.
+	ProjectEventAutoDeletionFailed = "ProjectAutoDeletionFailed"
 )
@@ -61,6 +61,10 @@ type SeedSpec struct {
 	BlockCIDRs []string `json:"blockCIDRs,omitempty"`
 	// DNS contains DNS-relevant information about this seed cluster.
 	DNS SeedDNS `json:"dns"`
+	// ExcessCapacityReservation contains the policy for reserving excess capacity in the seed cluster for new shoot
+	// control planes. If it is not specified, then the default policy is used.
+	// +optional
+	ExcessCapacityReservation *SeedExcessCapacityReservation `json:"excessCapacityReservation,omitempty"`
 	// Networks defines the pod, service and worker network of the Seed cluster.
 	Networks SeedNetworks `json:"networks"`
 	// Provider defines the provider type and region for this Seed cluster.
@@ -88,6 +92,18 @@ type SeedStatus struct {
 	// Seed's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Allocatable represents the resources of the Seed cluster that are available for scheduling, i.e. the number of
+	// ready and schedulable nodes as well as the sum of their allocatable CPU and memory.
+	// +optional
+	Allocatable corev1.ResourceList `json:"allocatable,omitempty"`
+	// Used represents the resources of the Seed cluster that are in use, i.e. the sum of the CPU and memory requests of
+	// all active pods as well as the number of Shoot control planes hosted by the Seed.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
+	// ReservedExcessCapacity represents the resources of the Seed cluster which are currently reserved for new Shoot
+	// control planes.
+	// +optional
+	ReservedExcessCapacity corev1.ResourceList `json:"reservedExcessCapacity,omitempty"`
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -158,6 +174,26 @@ const (
 	SeedTaintInvisible = "seed.gardener.cloud/invisible"
 )
 
+// SeedExcessCapacityReservation contains the policy for reserving excess capacity in the seed cluster for new shoot
+// control planes. The capacity reserved for a single shoot control plane is derived from the resources requested by
+// the control planes which are already hosted by the seed.
+type SeedExcessCapacityReservation struct {
+	// Resources are the resources (CPU and memory) which are reserved in addition to the capacity reserved for new
+	// shoot control planes.
+	// +optional
+	Resources corev1.ResourceList `json:"resources,omitempty"`
+	// ShootPercentage is the percentage of the number of shoots hosted by the seed for which capacity is reserved.
+	// Defaults to 3.
+	// +optional
+	ShootPercentage *int32 `json:"shootPercentage,omitempty"`
+	// MinShoots is the minimum number of shoot control planes for which capacity is reserved. Defaults to 2.
+	// +optional
+	MinShoots *int32 `json:"minShoots,omitempty"`
+	// MaxShoots is the maximum number of shoot control planes for which capacity is reserved.
+	// +optional
+	MaxShoots *int32 `json:"maxShoots,omitempty"`
+}
+
 // SeedVolume contains settings for persistentvolumes created in the seed cluster.
 type SeedVolume struct {
 	// MinimumSize defines the minimum size that should be used for PVCs in the seed.
@@ -179,4 +215,21 @@ type SeedVolumeProvider struct {
 const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable ConditionType = "Available"
+	// SeedAPIServerAvailable is a constant for a condition type indicating that the Seed cluster's API server is available.
+	SeedAPIServerAvailable ConditionType = "APIServerAvailable"
+	// SeedBootstrapComponentsHealthy is a constant for a condition type indicating the health of the components deployed while bootstrapping
+	// the Seed cluster.
+	SeedBootstrapComponentsHealthy ConditionType = "BootstrapComponentsHealthy"
+	// SeedDNSProviderReady is a constant for a condition type indicating that the DNS providers in the Seed cluster are ready.
+	SeedDNSProviderReady ConditionType = "DNSProviderReady"
+	// SeedExtensionsReady is a constant for a condition type indicating that the extension controllers required by the Seed
+	// cluster are installed and healthy.
+	SeedExtensionsReady ConditionType = "ExtensionsReady"
+)
+
+const (
+	// ResourceNodes is a constant for the resource name of the number of nodes of a Seed cluster.
+	ResourceNodes corev1.ResourceName = "nodes"
+	// ResourceShoots is a constant for the resource name of the number of Shoot control planes hosted by a Seed cluster.
+	ResourceShoots corev1.ResourceName = "shoots"
 )
@@ -26,6 +26,7 @@ import (
 )
 
 // +genclient
+// +genclient:method=CreateAdminKubeconfigRequest,verb=create,subresource=adminkubeconfig,input=AdminKubeconfigRequest,result=AdminKubeconfigRequest
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type Shoot struct {
@@ -53,6 +54,38 @@ type ShootList struct {
 	Items []Shoot `json:"items"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AdminKubeconfigRequest can be used to request a kubeconfig with administrator privileges for a Shoot.
+type AdminKubeconfigRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the specification of the AdminKubeconfigRequest.
+	Spec AdminKubeconfigRequestSpec `json:"spec"`
+	// Status is the status of the AdminKubeconfigRequest.
+	// +optional
+	Status AdminKubeconfigRequestStatus `json:"status"`
+}
+
+// AdminKubeconfigRequestSpec contains the expiration time of the kubeconfig.
+type AdminKubeconfigRequestSpec struct {
+	// ExpirationSeconds is the requested validity duration of the credential. The credential issuer may return
+	// a credential with a different validity duration. Defaults to 1 hour.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+// AdminKubeconfigRequestStatus is the status of the AdminKubeconfigRequest containing the kubeconfig and expiration
+// of the credential.
+type AdminKubeconfigRequestStatus struct {
+	// Kubeconfig contains the kubeconfig with administrator privileges for the Shoot.
+	Kubeconfig []byte `json:"kubeconfig"`
+	// ExpirationTimestamp is the expiration timestamp of the returned credential.
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp"`
+}
+
 // ShootSpec is the specification of a Shoot.
 type ShootSpec struct {
 	// Addons contains information about enabled/disabled addons and their configuration.
@@ -97,6 +130,9 @@ type ShootStatus struct {
 	// Conditions represents the latest available observations of a Shoots's current state.
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+	// Extensions contains the status of the extension resources of the Shoot in the Seed.
+	// +optional
+	Extensions []ShootExtensionStatus `json:"extensions,omitempty"`
 	// Gardener holds information about the Gardener which last acted on the Shoot.
 	Gardener Gardener `json:"gardener"`
 	// IsHibernated indicates whether the Shoot is currently hibernated.
@@ -127,6 +163,25 @@ type ShootStatus struct {
 	UID types.UID `json:"uid"`
 }
 
+// ShootExtensionStatus contains the status of an extension resource of a Shoot.
+type ShootExtensionStatus struct {
+	// Kind is the kind of the extension resource, e.g. Infrastructure or Worker.
+	Kind string `json:"kind"`
+	// Name is the name of the extension resource in the Seed namespace of the Shoot.
+	Name string `json:"name"`
+	// Type is the type of the extension resource.
+	Type string `json:"type"`
+	// Purpose is the purpose of the extension resource, if it has one.
+	// +optional
+	Purpose *string `json:"purpose,omitempty"`
+	// LastOperation holds information about the last operation on the extension resource.
+	// +optional
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
+	// LastError holds information about the last occurred error during an operation on the extension resource.
+	// +optional
+	LastError *LastError `json:"lastError,omitempty"`
+}
+
 //////////////////////////////////////////////////////////////////////////////////////////////////
 // Addons relevant types                                                                        //
 //////////////////////////////////////////////////////////////////////////////////////////////////
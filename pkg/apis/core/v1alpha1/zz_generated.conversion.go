@@ -62,6 +62,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AdminKubeconfigRequest)(nil), (*garden.AdminKubeconfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdminKubeconfigRequest_To_garden_AdminKubeconfigRequest(a.(*AdminKubeconfigRequest), b.(*garden.AdminKubeconfigRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AdminKubeconfigRequest)(nil), (*AdminKubeconfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AdminKubeconfigRequest_To_v1alpha1_AdminKubeconfigRequest(a.(*garden.AdminKubeconfigRequest), b.(*AdminKubeconfigRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AdminKubeconfigRequestSpec)(nil), (*garden.AdminKubeconfigRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec(a.(*AdminKubeconfigRequestSpec), b.(*garden.AdminKubeconfigRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AdminKubeconfigRequestSpec)(nil), (*AdminKubeconfigRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AdminKubeconfigRequestSpec_To_v1alpha1_AdminKubeconfigRequestSpec(a.(*garden.AdminKubeconfigRequestSpec), b.(*AdminKubeconfigRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AdminKubeconfigRequestStatus)(nil), (*garden.AdminKubeconfigRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus(a.(*AdminKubeconfigRequestStatus), b.(*garden.AdminKubeconfigRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AdminKubeconfigRequestStatus)(nil), (*AdminKubeconfigRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AdminKubeconfigRequestStatus_To_v1alpha1_AdminKubeconfigRequestStatus(a.(*garden.AdminKubeconfigRequestStatus), b.(*AdminKubeconfigRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AdmissionPlugin)(nil), (*garden.AdmissionPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AdmissionPlugin_To_garden_AdmissionPlugin(a.(*AdmissionPlugin), b.(*garden.AdmissionPlugin), scope)
 	}); err != nil {
@@ -272,6 +302,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerDependency)(nil), (*core.ControllerDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerDependency_To_core_ControllerDependency(a.(*ControllerDependency), b.(*core.ControllerDependency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerDependency)(nil), (*ControllerDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerDependency_To_v1alpha1_ControllerDependency(a.(*core.ControllerDependency), b.(*ControllerDependency), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerDeployment)(nil), (*core.ControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(a.(*ControllerDeployment), b.(*core.ControllerDeployment), scope)
 	}); err != nil {
@@ -352,6 +392,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerRegistrationStatus)(nil), (*core.ControllerRegistrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(a.(*ControllerRegistrationStatus), b.(*core.ControllerRegistrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerRegistrationStatus)(nil), (*ControllerRegistrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus(a.(*core.ControllerRegistrationStatus), b.(*ControllerRegistrationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerResource)(nil), (*core.ControllerResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerResource_To_core_ControllerResource(a.(*ControllerResource), b.(*core.ControllerResource), scope)
 	}); err != nil {
@@ -362,6 +412,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerRolloutStatus)(nil), (*core.ControllerRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerRolloutStatus_To_core_ControllerRolloutStatus(a.(*ControllerRolloutStatus), b.(*core.ControllerRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerRolloutStatus)(nil), (*ControllerRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerRolloutStatus_To_v1alpha1_ControllerRolloutStatus(a.(*core.ControllerRolloutStatus), b.(*ControllerRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControllerRolloutStrategy)(nil), (*core.ControllerRolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerRolloutStrategy_To_core_ControllerRolloutStrategy(a.(*ControllerRolloutStrategy), b.(*core.ControllerRolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerRolloutStrategy)(nil), (*ControllerRolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerRolloutStrategy_To_v1alpha1_ControllerRolloutStrategy(a.(*core.ControllerRolloutStrategy), b.(*ControllerRolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControllerRolloutWave)(nil), (*core.ControllerRolloutWave)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerRolloutWave_To_core_ControllerRolloutWave(a.(*ControllerRolloutWave), b.(*core.ControllerRolloutWave), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerRolloutWave)(nil), (*ControllerRolloutWave)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerRolloutWave_To_v1alpha1_ControllerRolloutWave(a.(*core.ControllerRolloutWave), b.(*ControllerRolloutWave), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNS)(nil), (*garden.DNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DNS_To_garden_DNS(a.(*DNS), b.(*garden.DNS), scope)
 	}); err != nil {
@@ -712,6 +792,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodePoolInfo)(nil), (*core.NodePoolInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodePoolInfo_To_core_NodePoolInfo(a.(*NodePoolInfo), b.(*core.NodePoolInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NodePoolInfo)(nil), (*NodePoolInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NodePoolInfo_To_v1alpha1_NodePoolInfo(a.(*core.NodePoolInfo), b.(*NodePoolInfo), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OIDCConfig)(nil), (*garden.OIDCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_OIDCConfig_To_garden_OIDCConfig(a.(*OIDCConfig), b.(*garden.OIDCConfig), scope)
 	}); err != nil {
@@ -802,6 +892,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectServiceAccount)(nil), (*garden.ProjectServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProjectServiceAccount_To_garden_ProjectServiceAccount(a.(*ProjectServiceAccount), b.(*garden.ProjectServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ProjectServiceAccount)(nil), (*ProjectServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ProjectServiceAccount_To_v1alpha1_ProjectServiceAccount(a.(*garden.ProjectServiceAccount), b.(*ProjectServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectSpec)(nil), (*garden.ProjectSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProjectSpec_To_garden_ProjectSpec(a.(*ProjectSpec), b.(*garden.ProjectSpec), scope)
 	}); err != nil {
@@ -922,6 +1022,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedExcessCapacityReservation)(nil), (*garden.SeedExcessCapacityReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedExcessCapacityReservation_To_garden_SeedExcessCapacityReservation(a.(*SeedExcessCapacityReservation), b.(*garden.SeedExcessCapacityReservation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedExcessCapacityReservation)(nil), (*SeedExcessCapacityReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedExcessCapacityReservation_To_v1alpha1_SeedExcessCapacityReservation(a.(*garden.SeedExcessCapacityReservation), b.(*SeedExcessCapacityReservation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedList)(nil), (*garden.SeedList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedList_To_garden_SeedList(a.(*SeedList), b.(*garden.SeedList), scope)
 	}); err != nil {
@@ -1022,6 +1132,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootExtensionStatus)(nil), (*garden.ShootExtensionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootExtensionStatus_To_garden_ShootExtensionStatus(a.(*ShootExtensionStatus), b.(*garden.ShootExtensionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootExtensionStatus)(nil), (*ShootExtensionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootExtensionStatus_To_v1alpha1_ShootExtensionStatus(a.(*garden.ShootExtensionStatus), b.(*ShootExtensionStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootList)(nil), (*garden.ShootList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootList_To_garden_ShootList(a.(*ShootList), b.(*garden.ShootList), scope)
 	}); err != nil {
@@ -1252,6 +1372,80 @@ func autoConvert_garden_Addons_To_v1alpha1_Addons(in *garden.Addons, out *Addons
 	return nil
 }
 
+func autoConvert_v1alpha1_AdminKubeconfigRequest_To_garden_AdminKubeconfigRequest(in *AdminKubeconfigRequest, out *garden.AdminKubeconfigRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_AdminKubeconfigRequest_To_garden_AdminKubeconfigRequest is an autogenerated conversion function.
+func Convert_v1alpha1_AdminKubeconfigRequest_To_garden_AdminKubeconfigRequest(in *AdminKubeconfigRequest, out *garden.AdminKubeconfigRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdminKubeconfigRequest_To_garden_AdminKubeconfigRequest(in, out, s)
+}
+
+func autoConvert_garden_AdminKubeconfigRequest_To_v1alpha1_AdminKubeconfigRequest(in *garden.AdminKubeconfigRequest, out *AdminKubeconfigRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_garden_AdminKubeconfigRequestSpec_To_v1alpha1_AdminKubeconfigRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_garden_AdminKubeconfigRequestStatus_To_v1alpha1_AdminKubeconfigRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_AdminKubeconfigRequest_To_v1alpha1_AdminKubeconfigRequest is an autogenerated conversion function.
+func Convert_garden_AdminKubeconfigRequest_To_v1alpha1_AdminKubeconfigRequest(in *garden.AdminKubeconfigRequest, out *AdminKubeconfigRequest, s conversion.Scope) error {
+	return autoConvert_garden_AdminKubeconfigRequest_To_v1alpha1_AdminKubeconfigRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec(in *AdminKubeconfigRequestSpec, out *garden.AdminKubeconfigRequestSpec, s conversion.Scope) error {
+	out.ExpirationSeconds = (*int64)(unsafe.Pointer(in.ExpirationSeconds))
+	return nil
+}
+
+// Convert_v1alpha1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec is an autogenerated conversion function.
+func Convert_v1alpha1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec(in *AdminKubeconfigRequestSpec, out *garden.AdminKubeconfigRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec(in, out, s)
+}
+
+func autoConvert_garden_AdminKubeconfigRequestSpec_To_v1alpha1_AdminKubeconfigRequestSpec(in *garden.AdminKubeconfigRequestSpec, out *AdminKubeconfigRequestSpec, s conversion.Scope) error {
+	out.ExpirationSeconds = (*int64)(unsafe.Pointer(in.ExpirationSeconds))
+	return nil
+}
+
+// Convert_garden_AdminKubeconfigRequestSpec_To_v1alpha1_AdminKubeconfigRequestSpec is an autogenerated conversion function.
+func Convert_garden_AdminKubeconfigRequestSpec_To_v1alpha1_AdminKubeconfigRequestSpec(in *garden.AdminKubeconfigRequestSpec, out *AdminKubeconfigRequestSpec, s conversion.Scope) error {
+	return autoConvert_garden_AdminKubeconfigRequestSpec_To_v1alpha1_AdminKubeconfigRequestSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus(in *AdminKubeconfigRequestStatus, out *garden.AdminKubeconfigRequestStatus, s conversion.Scope) error {
+	out.Kubeconfig = *(*[]byte)(unsafe.Pointer(&in.Kubeconfig))
+	out.ExpirationTimestamp = in.ExpirationTimestamp
+	return nil
+}
+
+// Convert_v1alpha1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus is an autogenerated conversion function.
+func Convert_v1alpha1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus(in *AdminKubeconfigRequestStatus, out *garden.AdminKubeconfigRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus(in, out, s)
+}
+
+func autoConvert_garden_AdminKubeconfigRequestStatus_To_v1alpha1_AdminKubeconfigRequestStatus(in *garden.AdminKubeconfigRequestStatus, out *AdminKubeconfigRequestStatus, s conversion.Scope) error {
+	out.Kubeconfig = *(*[]byte)(unsafe.Pointer(&in.Kubeconfig))
+	out.ExpirationTimestamp = in.ExpirationTimestamp
+	return nil
+}
+
+// Convert_garden_AdminKubeconfigRequestStatus_To_v1alpha1_AdminKubeconfigRequestStatus is an autogenerated conversion function.
+func Convert_garden_AdminKubeconfigRequestStatus_To_v1alpha1_AdminKubeconfigRequestStatus(in *garden.AdminKubeconfigRequestStatus, out *AdminKubeconfigRequestStatus, s conversion.Scope) error {
+	return autoConvert_garden_AdminKubeconfigRequestStatus_To_v1alpha1_AdminKubeconfigRequestStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_AdmissionPlugin_To_garden_AdmissionPlugin(in *AdmissionPlugin, out *garden.AdmissionPlugin, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Config = (*garden.ProviderConfig)(unsafe.Pointer(in.Config))
@@ -1781,6 +1975,7 @@ func autoConvert_v1alpha1_ClusterInfo_To_core_ClusterInfo(in *ClusterInfo, out *
 	if err := Convert_v1alpha1_KubernetesInfo_To_core_KubernetesInfo(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
 	}
+	out.NodePools = *(*[]core.NodePoolInfo)(unsafe.Pointer(&in.NodePools))
 	return nil
 }
 
@@ -1796,6 +1991,7 @@ func autoConvert_core_ClusterInfo_To_v1alpha1_ClusterInfo(in *core.ClusterInfo,
 	if err := Convert_core_KubernetesInfo_To_v1alpha1_KubernetesInfo(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
 	}
+	out.NodePools = *(*[]NodePoolInfo)(unsafe.Pointer(&in.NodePools))
 	return nil
 }
 
@@ -1834,6 +2030,30 @@ func Convert_core_Condition_To_v1alpha1_Condition(in *core.Condition, out *Condi
 	return autoConvert_core_Condition_To_v1alpha1_Condition(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControllerDependency_To_core_ControllerDependency(in *ControllerDependency, out *core.ControllerDependency, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Type = in.Type
+	return nil
+}
+
+// Convert_v1alpha1_ControllerDependency_To_core_ControllerDependency is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerDependency_To_core_ControllerDependency(in *ControllerDependency, out *core.ControllerDependency, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerDependency_To_core_ControllerDependency(in, out, s)
+}
+
+func autoConvert_core_ControllerDependency_To_v1alpha1_ControllerDependency(in *core.ControllerDependency, out *ControllerDependency, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Type = in.Type
+	return nil
+}
+
+// Convert_core_ControllerDependency_To_v1alpha1_ControllerDependency is an autogenerated conversion function.
+func Convert_core_ControllerDependency_To_v1alpha1_ControllerDependency(in *core.ControllerDependency, out *ControllerDependency, s conversion.Scope) error {
+	return autoConvert_core_ControllerDependency_To_v1alpha1_ControllerDependency(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*core.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
@@ -1935,6 +2155,7 @@ func Convert_core_ControllerInstallationSpec_To_v1alpha1_ControllerInstallationS
 func autoConvert_v1alpha1_ControllerInstallationStatus_To_core_ControllerInstallationStatus(in *ControllerInstallationStatus, out *core.ControllerInstallationStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	out.ProviderStatus = (*core.ProviderConfig)(unsafe.Pointer(in.ProviderStatus))
+	out.ObservedGeneration = in.ObservedGeneration
 	return nil
 }
 
@@ -1946,6 +2167,7 @@ func Convert_v1alpha1_ControllerInstallationStatus_To_core_ControllerInstallatio
 func autoConvert_core_ControllerInstallationStatus_To_v1alpha1_ControllerInstallationStatus(in *core.ControllerInstallationStatus, out *ControllerInstallationStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
 	out.ProviderStatus = (*ProviderConfig)(unsafe.Pointer(in.ProviderStatus))
+	out.ObservedGeneration = in.ObservedGeneration
 	return nil
 }
 
@@ -1959,6 +2181,9 @@ func autoConvert_v1alpha1_ControllerRegistration_To_core_ControllerRegistration(
 	if err := Convert_v1alpha1_ControllerRegistrationSpec_To_core_ControllerRegistrationSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1972,6 +2197,9 @@ func autoConvert_core_ControllerRegistration_To_v1alpha1_ControllerRegistration(
 	if err := Convert_core_ControllerRegistrationSpec_To_v1alpha1_ControllerRegistrationSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2005,6 +2233,9 @@ func Convert_core_ControllerRegistrationList_To_v1alpha1_ControllerRegistrationL
 func autoConvert_v1alpha1_ControllerRegistrationSpec_To_core_ControllerRegistrationSpec(in *ControllerRegistrationSpec, out *core.ControllerRegistrationSpec, s conversion.Scope) error {
 	out.Resources = *(*[]core.ControllerResource)(unsafe.Pointer(&in.Resources))
 	out.Deployment = (*core.ControllerDeployment)(unsafe.Pointer(in.Deployment))
+	out.RolloutStrategy = (*core.ControllerRolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.Dependencies = *(*[]core.ControllerDependency)(unsafe.Pointer(&in.Dependencies))
 	return nil
 }
 
@@ -2016,6 +2247,9 @@ func Convert_v1alpha1_ControllerRegistrationSpec_To_core_ControllerRegistrationS
 func autoConvert_core_ControllerRegistrationSpec_To_v1alpha1_ControllerRegistrationSpec(in *core.ControllerRegistrationSpec, out *ControllerRegistrationSpec, s conversion.Scope) error {
 	out.Resources = *(*[]ControllerResource)(unsafe.Pointer(&in.Resources))
 	out.Deployment = (*ControllerDeployment)(unsafe.Pointer(in.Deployment))
+	out.RolloutStrategy = (*ControllerRolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.Dependencies = *(*[]ControllerDependency)(unsafe.Pointer(&in.Dependencies))
 	return nil
 }
 
@@ -2024,6 +2258,28 @@ func Convert_core_ControllerRegistrationSpec_To_v1alpha1_ControllerRegistrationS
 	return autoConvert_core_ControllerRegistrationSpec_To_v1alpha1_ControllerRegistrationSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(in *ControllerRegistrationStatus, out *core.ControllerRegistrationStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Rollout = (*core.ControllerRolloutStatus)(unsafe.Pointer(in.Rollout))
+	return nil
+}
+
+// Convert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(in *ControllerRegistrationStatus, out *core.ControllerRegistrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(in, out, s)
+}
+
+func autoConvert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus(in *core.ControllerRegistrationStatus, out *ControllerRegistrationStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Rollout = (*ControllerRolloutStatus)(unsafe.Pointer(in.Rollout))
+	return nil
+}
+
+// Convert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus is an autogenerated conversion function.
+func Convert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus(in *core.ControllerRegistrationStatus, out *ControllerRegistrationStatus, s conversion.Scope) error {
+	return autoConvert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerResource_To_core_ControllerResource(in *ControllerResource, out *core.ControllerResource, s conversion.Scope) error {
 	out.Kind = in.Kind
 	out.Type = in.Type
@@ -2050,6 +2306,84 @@ func Convert_core_ControllerResource_To_v1alpha1_ControllerResource(in *core.Con
 	return autoConvert_core_ControllerResource_To_v1alpha1_ControllerResource(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControllerRolloutStatus_To_core_ControllerRolloutStatus(in *ControllerRolloutStatus, out *core.ControllerRolloutStatus, s conversion.Scope) error {
+	out.Phase = core.ControllerRolloutPhase(in.Phase)
+	out.CurrentWave = (*string)(unsafe.Pointer(in.CurrentWave))
+	out.TotalSeeds = in.TotalSeeds
+	out.UpdatedSeeds = in.UpdatedSeeds
+	out.AvailableSeeds = in.AvailableSeeds
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1alpha1_ControllerRolloutStatus_To_core_ControllerRolloutStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerRolloutStatus_To_core_ControllerRolloutStatus(in *ControllerRolloutStatus, out *core.ControllerRolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerRolloutStatus_To_core_ControllerRolloutStatus(in, out, s)
+}
+
+func autoConvert_core_ControllerRolloutStatus_To_v1alpha1_ControllerRolloutStatus(in *core.ControllerRolloutStatus, out *ControllerRolloutStatus, s conversion.Scope) error {
+	out.Phase = ControllerRolloutPhase(in.Phase)
+	out.CurrentWave = (*string)(unsafe.Pointer(in.CurrentWave))
+	out.TotalSeeds = in.TotalSeeds
+	out.UpdatedSeeds = in.UpdatedSeeds
+	out.AvailableSeeds = in.AvailableSeeds
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_ControllerRolloutStatus_To_v1alpha1_ControllerRolloutStatus is an autogenerated conversion function.
+func Convert_core_ControllerRolloutStatus_To_v1alpha1_ControllerRolloutStatus(in *core.ControllerRolloutStatus, out *ControllerRolloutStatus, s conversion.Scope) error {
+	return autoConvert_core_ControllerRolloutStatus_To_v1alpha1_ControllerRolloutStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControllerRolloutStrategy_To_core_ControllerRolloutStrategy(in *ControllerRolloutStrategy, out *core.ControllerRolloutStrategy, s conversion.Scope) error {
+	out.Waves = *(*[]core.ControllerRolloutWave)(unsafe.Pointer(&in.Waves))
+	out.MaxUnavailable = (*int32)(unsafe.Pointer(in.MaxUnavailable))
+	out.Paused = in.Paused
+	return nil
+}
+
+// Convert_v1alpha1_ControllerRolloutStrategy_To_core_ControllerRolloutStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerRolloutStrategy_To_core_ControllerRolloutStrategy(in *ControllerRolloutStrategy, out *core.ControllerRolloutStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerRolloutStrategy_To_core_ControllerRolloutStrategy(in, out, s)
+}
+
+func autoConvert_core_ControllerRolloutStrategy_To_v1alpha1_ControllerRolloutStrategy(in *core.ControllerRolloutStrategy, out *ControllerRolloutStrategy, s conversion.Scope) error {
+	out.Waves = *(*[]ControllerRolloutWave)(unsafe.Pointer(&in.Waves))
+	out.MaxUnavailable = (*int32)(unsafe.Pointer(in.MaxUnavailable))
+	out.Paused = in.Paused
+	return nil
+}
+
+// Convert_core_ControllerRolloutStrategy_To_v1alpha1_ControllerRolloutStrategy is an autogenerated conversion function.
+func Convert_core_ControllerRolloutStrategy_To_v1alpha1_ControllerRolloutStrategy(in *core.ControllerRolloutStrategy, out *ControllerRolloutStrategy, s conversion.Scope) error {
+	return autoConvert_core_ControllerRolloutStrategy_To_v1alpha1_ControllerRolloutStrategy(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControllerRolloutWave_To_core_ControllerRolloutWave(in *ControllerRolloutWave, out *core.ControllerRolloutWave, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	return nil
+}
+
+// Convert_v1alpha1_ControllerRolloutWave_To_core_ControllerRolloutWave is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerRolloutWave_To_core_ControllerRolloutWave(in *ControllerRolloutWave, out *core.ControllerRolloutWave, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerRolloutWave_To_core_ControllerRolloutWave(in, out, s)
+}
+
+func autoConvert_core_ControllerRolloutWave_To_v1alpha1_ControllerRolloutWave(in *core.ControllerRolloutWave, out *ControllerRolloutWave, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	return nil
+}
+
+// Convert_core_ControllerRolloutWave_To_v1alpha1_ControllerRolloutWave is an autogenerated conversion function.
+func Convert_core_ControllerRolloutWave_To_v1alpha1_ControllerRolloutWave(in *core.ControllerRolloutWave, out *ControllerRolloutWave, s conversion.Scope) error {
+	return autoConvert_core_ControllerRolloutWave_To_v1alpha1_ControllerRolloutWave(in, out, s)
+}
+
 func autoConvert_v1alpha1_DNS_To_garden_DNS(in *DNS, out *garden.DNS, s conversion.Scope) error {
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.Providers = *(*[]garden.DNSProvider)(unsafe.Pointer(&in.Providers))
@@ -3065,6 +3399,36 @@ func Convert_garden_NginxIngress_To_v1alpha1_NginxIngress(in *garden.NginxIngres
 	return autoConvert_garden_NginxIngress_To_v1alpha1_NginxIngress(in, out, s)
 }
 
+func autoConvert_v1alpha1_NodePoolInfo_To_core_NodePoolInfo(in *NodePoolInfo, out *core.NodePoolInfo, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MachineType = in.MachineType
+	out.OSImage = in.OSImage
+	out.KubeletVersion = in.KubeletVersion
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1alpha1_NodePoolInfo_To_core_NodePoolInfo is an autogenerated conversion function.
+func Convert_v1alpha1_NodePoolInfo_To_core_NodePoolInfo(in *NodePoolInfo, out *core.NodePoolInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodePoolInfo_To_core_NodePoolInfo(in, out, s)
+}
+
+func autoConvert_core_NodePoolInfo_To_v1alpha1_NodePoolInfo(in *core.NodePoolInfo, out *NodePoolInfo, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MachineType = in.MachineType
+	out.OSImage = in.OSImage
+	out.KubeletVersion = in.KubeletVersion
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_core_NodePoolInfo_To_v1alpha1_NodePoolInfo is an autogenerated conversion function.
+func Convert_core_NodePoolInfo_To_v1alpha1_NodePoolInfo(in *core.NodePoolInfo, out *NodePoolInfo, s conversion.Scope) error {
+	return autoConvert_core_NodePoolInfo_To_v1alpha1_NodePoolInfo(in, out, s)
+}
+
 func autoConvert_v1alpha1_OIDCConfig_To_garden_OIDCConfig(in *OIDCConfig, out *garden.OIDCConfig, s conversion.Scope) error {
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	if in.ClientAuthentication != nil {
@@ -3198,6 +3562,7 @@ func Convert_core_PlantList_To_v1alpha1_PlantList(in *core.PlantList, out *Plant
 func autoConvert_v1alpha1_PlantSpec_To_core_PlantSpec(in *PlantSpec, out *core.PlantSpec, s conversion.Scope) error {
 	out.SecretRef = in.SecretRef
 	out.Endpoints = *(*[]core.Endpoint)(unsafe.Pointer(&in.Endpoints))
+	out.CloudProfileName = (*string)(unsafe.Pointer(in.CloudProfileName))
 	return nil
 }
 
@@ -3209,6 +3574,7 @@ func Convert_v1alpha1_PlantSpec_To_core_PlantSpec(in *PlantSpec, out *core.Plant
 func autoConvert_core_PlantSpec_To_v1alpha1_PlantSpec(in *core.PlantSpec, out *PlantSpec, s conversion.Scope) error {
 	out.SecretRef = in.SecretRef
 	out.Endpoints = *(*[]Endpoint)(unsafe.Pointer(&in.Endpoints))
+	out.CloudProfileName = (*string)(unsafe.Pointer(in.CloudProfileName))
 	return nil
 }
 
@@ -3318,6 +3684,7 @@ func Convert_garden_ProjectList_To_v1alpha1_ProjectList(in *garden.ProjectList,
 func autoConvert_v1alpha1_ProjectMember_To_garden_ProjectMember(in *ProjectMember, out *garden.ProjectMember, s conversion.Scope) error {
 	out.Subject = in.Subject
 	out.Role = in.Role
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
 	return nil
 }
 
@@ -3329,6 +3696,7 @@ func Convert_v1alpha1_ProjectMember_To_garden_ProjectMember(in *ProjectMember, o
 func autoConvert_garden_ProjectMember_To_v1alpha1_ProjectMember(in *garden.ProjectMember, out *ProjectMember, s conversion.Scope) error {
 	out.Subject = in.Subject
 	out.Role = in.Role
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
 	return nil
 }
 
@@ -3337,6 +3705,30 @@ func Convert_garden_ProjectMember_To_v1alpha1_ProjectMember(in *garden.ProjectMe
 	return autoConvert_garden_ProjectMember_To_v1alpha1_ProjectMember(in, out, s)
 }
 
+func autoConvert_v1alpha1_ProjectServiceAccount_To_garden_ProjectServiceAccount(in *ProjectServiceAccount, out *garden.ProjectServiceAccount, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Role = in.Role
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_v1alpha1_ProjectServiceAccount_To_garden_ProjectServiceAccount is an autogenerated conversion function.
+func Convert_v1alpha1_ProjectServiceAccount_To_garden_ProjectServiceAccount(in *ProjectServiceAccount, out *garden.ProjectServiceAccount, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProjectServiceAccount_To_garden_ProjectServiceAccount(in, out, s)
+}
+
+func autoConvert_garden_ProjectServiceAccount_To_v1alpha1_ProjectServiceAccount(in *garden.ProjectServiceAccount, out *ProjectServiceAccount, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Role = in.Role
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_garden_ProjectServiceAccount_To_v1alpha1_ProjectServiceAccount is an autogenerated conversion function.
+func Convert_garden_ProjectServiceAccount_To_v1alpha1_ProjectServiceAccount(in *garden.ProjectServiceAccount, out *ProjectServiceAccount, s conversion.Scope) error {
+	return autoConvert_garden_ProjectServiceAccount_To_v1alpha1_ProjectServiceAccount(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out *garden.ProjectSpec, s conversion.Scope) error {
 	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
 	out.Description = (*string)(unsafe.Pointer(in.Description))
@@ -3344,6 +3736,7 @@ func autoConvert_v1alpha1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
 	// WARNING: in.Members requires manual conversion: does not exist in peer-type
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.ServiceAccounts = *(*[]garden.ProjectServiceAccount)(unsafe.Pointer(&in.ServiceAccounts))
 	return nil
 }
 
@@ -3354,12 +3747,16 @@ func autoConvert_garden_ProjectSpec_To_v1alpha1_ProjectSpec(in *garden.ProjectSp
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
 	// WARNING: in.ProjectMembers requires manual conversion: does not exist in peer-type
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.ServiceAccounts = *(*[]ProjectServiceAccount)(unsafe.Pointer(&in.ServiceAccounts))
 	return nil
 }
 
 func autoConvert_v1alpha1_ProjectStatus_To_garden_ProjectStatus(in *ProjectStatus, out *garden.ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = garden.ProjectPhase(in.Phase)
+	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
+	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	return nil
 }
 
@@ -3371,6 +3768,9 @@ func Convert_v1alpha1_ProjectStatus_To_garden_ProjectStatus(in *ProjectStatus, o
 func autoConvert_garden_ProjectStatus_To_v1alpha1_ProjectStatus(in *garden.ProjectStatus, out *ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = ProjectPhase(in.Phase)
+	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
+	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	return nil
 }
 
@@ -3631,6 +4031,32 @@ func Convert_garden_SeedBackup_To_v1alpha1_SeedBackup(in *garden.SeedBackup, out
 	return autoConvert_garden_SeedBackup_To_v1alpha1_SeedBackup(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedExcessCapacityReservation_To_garden_SeedExcessCapacityReservation(in *SeedExcessCapacityReservation, out *garden.SeedExcessCapacityReservation, s conversion.Scope) error {
+	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.ShootPercentage = (*int32)(unsafe.Pointer(in.ShootPercentage))
+	out.MinShoots = (*int32)(unsafe.Pointer(in.MinShoots))
+	out.MaxShoots = (*int32)(unsafe.Pointer(in.MaxShoots))
+	return nil
+}
+
+// Convert_v1alpha1_SeedExcessCapacityReservation_To_garden_SeedExcessCapacityReservation is an autogenerated conversion function.
+func Convert_v1alpha1_SeedExcessCapacityReservation_To_garden_SeedExcessCapacityReservation(in *SeedExcessCapacityReservation, out *garden.SeedExcessCapacityReservation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedExcessCapacityReservation_To_garden_SeedExcessCapacityReservation(in, out, s)
+}
+
+func autoConvert_garden_SeedExcessCapacityReservation_To_v1alpha1_SeedExcessCapacityReservation(in *garden.SeedExcessCapacityReservation, out *SeedExcessCapacityReservation, s conversion.Scope) error {
+	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.ShootPercentage = (*int32)(unsafe.Pointer(in.ShootPercentage))
+	out.MinShoots = (*int32)(unsafe.Pointer(in.MinShoots))
+	out.MaxShoots = (*int32)(unsafe.Pointer(in.MaxShoots))
+	return nil
+}
+
+// Convert_garden_SeedExcessCapacityReservation_To_v1alpha1_SeedExcessCapacityReservation is an autogenerated conversion function.
+func Convert_garden_SeedExcessCapacityReservation_To_v1alpha1_SeedExcessCapacityReservation(in *garden.SeedExcessCapacityReservation, out *SeedExcessCapacityReservation, s conversion.Scope) error {
+	return autoConvert_garden_SeedExcessCapacityReservation_To_v1alpha1_SeedExcessCapacityReservation(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedList_To_garden_SeedList(in *SeedList, out *garden.SeedList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -3725,6 +4151,7 @@ func autoConvert_v1alpha1_SeedSpec_To_garden_SeedSpec(in *SeedSpec, out *garden.
 	out.Backup = (*garden.SeedBackup)(unsafe.Pointer(in.Backup))
 	out.BlockCIDRs = *(*[]string)(unsafe.Pointer(&in.BlockCIDRs))
 	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
+	out.ExcessCapacityReservation = (*garden.SeedExcessCapacityReservation)(unsafe.Pointer(in.ExcessCapacityReservation))
 	if err := Convert_v1alpha1_SeedNetworks_To_garden_SeedNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
@@ -3751,6 +4178,7 @@ func autoConvert_garden_SeedSpec_To_v1alpha1_SeedSpec(in *garden.SeedSpec, out *
 	out.Taints = *(*[]SeedTaint)(unsafe.Pointer(&in.Taints))
 	out.Backup = (*SeedBackup)(unsafe.Pointer(in.Backup))
 	out.Volume = (*SeedVolume)(unsafe.Pointer(in.Volume))
+	out.ExcessCapacityReservation = (*SeedExcessCapacityReservation)(unsafe.Pointer(in.ExcessCapacityReservation))
 	return nil
 }
 
@@ -3760,6 +4188,9 @@ func autoConvert_v1alpha1_SeedStatus_To_garden_SeedStatus(in *SeedStatus, out *g
 	}
 	out.Conditions = *(*[]garden.Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = in.ObservedGeneration
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.ReservedExcessCapacity = *(*v1.ResourceList)(unsafe.Pointer(&in.ReservedExcessCapacity))
 	return nil
 }
 
@@ -3774,6 +4205,9 @@ func autoConvert_garden_SeedStatus_To_v1alpha1_SeedStatus(in *garden.SeedStatus,
 		return err
 	}
 	out.ObservedGeneration = in.ObservedGeneration
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.ReservedExcessCapacity = *(*v1.ResourceList)(unsafe.Pointer(&in.ReservedExcessCapacity))
 	return nil
 }
 
@@ -3892,6 +4326,36 @@ func autoConvert_garden_Shoot_To_v1alpha1_Shoot(in *garden.Shoot, out *Shoot, s
 	return nil
 }
 
+func autoConvert_v1alpha1_ShootExtensionStatus_To_garden_ShootExtensionStatus(in *ShootExtensionStatus, out *garden.ShootExtensionStatus, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
+	out.LastOperation = (*garden.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.LastError = (*garden.LastError)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_v1alpha1_ShootExtensionStatus_To_garden_ShootExtensionStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ShootExtensionStatus_To_garden_ShootExtensionStatus(in *ShootExtensionStatus, out *garden.ShootExtensionStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootExtensionStatus_To_garden_ShootExtensionStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootExtensionStatus_To_v1alpha1_ShootExtensionStatus(in *garden.ShootExtensionStatus, out *ShootExtensionStatus, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
+	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.LastError = (*LastError)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_garden_ShootExtensionStatus_To_v1alpha1_ShootExtensionStatus is an autogenerated conversion function.
+func Convert_garden_ShootExtensionStatus_To_v1alpha1_ShootExtensionStatus(in *garden.ShootExtensionStatus, out *ShootExtensionStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootExtensionStatus_To_v1alpha1_ShootExtensionStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootList_To_garden_ShootList(in *ShootList, out *garden.ShootList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4061,6 +4525,7 @@ func autoConvert_garden_ShootSpec_To_v1alpha1_ShootSpec(in *garden.ShootSpec, ou
 
 func autoConvert_v1alpha1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out *garden.ShootStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]garden.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Extensions = *(*[]garden.ShootExtensionStatus)(unsafe.Pointer(&in.Extensions))
 	if err := Convert_v1alpha1_Gardener_To_garden_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
@@ -4079,6 +4544,7 @@ func autoConvert_v1alpha1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out
 
 func autoConvert_garden_ShootStatus_To_v1alpha1_ShootStatus(in *garden.ShootStatus, out *ShootStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
+	out.Extensions = *(*[]ShootExtensionStatus)(unsafe.Pointer(&in.Extensions))
 	if err := Convert_garden_Gardener_To_v1alpha1_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
@@ -16,21 +16,48 @@ package validation
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+const (
+	// MinReconcileTimeout is the minimum reconcile timeout of a resource of a ControllerRegistration.
+	MinReconcileTimeout = 30 * time.Second
+	// MaxReconcileTimeout is the maximum reconcile timeout of a resource of a ControllerRegistration.
+	MaxReconcileTimeout = time.Hour
+)
+
 // ValidateControllerRegistration validates a ControllerRegistration object.
 func ValidateControllerRegistration(controllerRegistration *core.ControllerRegistration) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	allErrs = append(allErrs, validateControllerRegistration(controllerRegistration)...)
+	allErrs = append(allErrs, validateReconcileTimeouts(controllerRegistration.Spec.Resources, nil, field.NewPath("spec", "resources"))...)
+
+	return allErrs
+}
+
+func validateControllerRegistration(controllerRegistration *core.ControllerRegistration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&controllerRegistration.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateControllerRegistrationSpec(&controllerRegistration.Spec, field.NewPath("spec"))...)
 
+	for i, dependency := range controllerRegistration.Spec.Dependencies {
+		if len(dependency.Name) > 0 && dependency.Name == controllerRegistration.Name {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "dependencies").Index(i).Child("name"), "a ControllerRegistration must not depend on itself"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -62,6 +89,119 @@ func ValidateControllerRegistrationSpec(spec *core.ControllerRegistrationSpec, f
 		resources[resource.Kind] = resource.Type
 	}
 
+	if spec.RolloutStrategy != nil {
+		allErrs = append(allErrs, validateControllerRolloutStrategy(spec.RolloutStrategy, fldPath.Child("rolloutStrategy"))...)
+	}
+
+	if spec.SeedSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.SeedSelector, fldPath.Child("seedSelector"))...)
+	}
+
+	allErrs = append(allErrs, validateControllerDependencies(spec.Dependencies, spec.Resources, fldPath.Child("dependencies"))...)
+
+	return allErrs
+}
+
+// validateReconcileTimeouts validates that the reconcile timeouts of the given resources are within the bounds. Only
+// timeouts which differ from the ones of the same resources in <oldResources> are checked, i.e. existing objects
+// with timeouts out of the bounds can still be updated as long as they don't change them.
+func validateReconcileTimeouts(resources, oldResources []core.ControllerResource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	oldTimeouts := make(map[string]*metav1.Duration, len(oldResources))
+	for _, resource := range oldResources {
+		oldTimeouts[fmt.Sprintf("%s/%s", resource.Kind, resource.Type)] = resource.ReconcileTimeout
+	}
+
+	for i, resource := range resources {
+		timeout := resource.ReconcileTimeout
+		if timeout == nil {
+			continue
+		}
+		if oldTimeout, ok := oldTimeouts[fmt.Sprintf("%s/%s", resource.Kind, resource.Type)]; ok && apiequality.Semantic.DeepEqual(timeout, oldTimeout) {
+			continue
+		}
+		if timeout.Duration < MinReconcileTimeout || timeout.Duration > MaxReconcileTimeout {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("reconcileTimeout"), timeout.Duration.String(), fmt.Sprintf("must be between %s and %s", MinReconcileTimeout, MaxReconcileTimeout)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateControllerDependencies(dependencies []core.ControllerDependency, resources []core.ControllerResource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	ownResources := sets.NewString()
+	for _, resource := range resources {
+		ownResources.Insert(fmt.Sprintf("%s/%s", resource.Kind, resource.Type))
+	}
+
+	dependencyKeys := sets.NewString()
+	for i, dependency := range dependencies {
+		var (
+			idxPath = fldPath.Index(i)
+			key     string
+		)
+
+		switch {
+		case len(dependency.Name) > 0 && (len(dependency.Kind) > 0 || len(dependency.Type) > 0):
+			allErrs = append(allErrs, field.Forbidden(idxPath, "either name or kind and type must be specified, not both"))
+			continue
+		case len(dependency.Name) > 0:
+			for _, msg := range validation.IsDNS1123Label(dependency.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), dependency.Name, msg))
+			}
+			key = dependency.Name
+		case len(dependency.Kind) > 0 && len(dependency.Type) > 0:
+			key = fmt.Sprintf("%s/%s", dependency.Kind, dependency.Type)
+			if ownResources.Has(key) {
+				allErrs = append(allErrs, field.Forbidden(idxPath, "a ControllerRegistration must not depend on its own resources"))
+			}
+		default:
+			allErrs = append(allErrs, field.Required(idxPath, "either name or kind and type must be specified"))
+			continue
+		}
+
+		if dependencyKeys.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		}
+		dependencyKeys.Insert(key)
+	}
+
+	return allErrs
+}
+
+func validateControllerRolloutStrategy(strategy *core.ControllerRolloutStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	waveNames := sets.NewString()
+	for i, wave := range strategy.Waves {
+		idxPath := fldPath.Child("waves").Index(i)
+
+		if len(wave.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field is required"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(wave.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), wave.Name, msg))
+			}
+			if waveNames.Has(wave.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), wave.Name))
+			}
+			waveNames.Insert(wave.Name)
+		}
+
+		if wave.SeedSelector == nil {
+			allErrs = append(allErrs, field.Required(idxPath.Child("seedSelector"), "field is required"))
+		} else {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(wave.SeedSelector, idxPath.Child("seedSelector"))...)
+		}
+	}
+
+	if strategy.MaxUnavailable != nil && *strategy.MaxUnavailable < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), *strategy.MaxUnavailable, "must be at least 1"))
+	}
+
 	return allErrs
 }
 
@@ -71,7 +211,8 @@ func ValidateControllerRegistrationUpdate(new, old *core.ControllerRegistration)
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateControllerRegistrationSpecUpdate(&new.Spec, &old.Spec, new.DeletionTimestamp != nil, field.NewPath("spec"))...)
-	allErrs = append(allErrs, ValidateControllerRegistration(new)...)
+	allErrs = append(allErrs, validateControllerRegistration(new)...)
+	allErrs = append(allErrs, validateReconcileTimeouts(new.Spec.Resources, old.Spec.Resources, field.NewPath("spec", "resources"))...)
 
 	return allErrs
 }
@@ -86,3 +227,10 @@ func ValidateControllerRegistrationSpecUpdate(new, old *core.ControllerRegistrat
 
 	return allErrs
 }
+
+// ValidateControllerRegistrationStatusUpdate validates the status field of a ControllerRegistration object.
+func ValidateControllerRegistrationStatusUpdate(newStatus, oldStatus core.ControllerRegistrationStatus) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	return allErrs
+}
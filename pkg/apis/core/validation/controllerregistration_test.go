@@ -15,6 +15,8 @@
 package validation_test
 
 import (
+	"time"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/test"
@@ -120,6 +122,161 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.resources[0].globallyEnabled"),
 			}))))
 		})
+
+		It("should allow reconcile timeouts within the bounds", func() {
+			controllerRegistration.Spec.Resources[0].ReconcileTimeout = &metav1.Duration{Duration: MinReconcileTimeout}
+			controllerRegistration.Spec.Resources = append(controllerRegistration.Spec.Resources, core.ControllerResource{
+				Kind:             "Infrastructure",
+				Type:             "my-infra",
+				ReconcileTimeout: &metav1.Duration{Duration: MaxReconcileTimeout},
+			})
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid reconcile timeouts out of the bounds", func() {
+			controllerRegistration.Spec.Resources[0].ReconcileTimeout = &metav1.Duration{Duration: time.Second}
+			controllerRegistration.Spec.Resources = append(controllerRegistration.Spec.Resources, core.ControllerResource{
+				Kind:             "Infrastructure",
+				Type:             "my-infra",
+				ReconcileTimeout: &metav1.Duration{Duration: 2 * time.Hour},
+			})
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.resources[0].reconcileTimeout"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.resources[1].reconcileTimeout"),
+				})),
+			))
+		})
+
+		It("should allow valid rollout strategies", func() {
+			maxUnavailable := int32(2)
+			controllerRegistration.Spec.RolloutStrategy = &core.ControllerRolloutStrategy{
+				Waves: []core.ControllerRolloutWave{
+					{Name: "canary", SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+					{Name: "eu", SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}}},
+				},
+				MaxUnavailable: &maxUnavailable,
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid rollout strategies", func() {
+			maxUnavailable := int32(0)
+			controllerRegistration.Spec.RolloutStrategy = &core.ControllerRolloutStrategy{
+				Waves: []core.ControllerRolloutWave{
+					{Name: "canary", SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+					{Name: "canary", SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}}},
+					{Name: "Invalid_Name", SeedSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}}}},
+					{},
+				},
+				MaxUnavailable: &maxUnavailable,
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.rolloutStrategy.waves[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.rolloutStrategy.waves[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.rolloutStrategy.waves[2].seedSelector.matchExpressions[0].operator"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.rolloutStrategy.waves[3].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.rolloutStrategy.waves[3].seedSelector"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.rolloutStrategy.maxUnavailable"),
+				})),
+			))
+		})
+
+		It("should forbid invalid seed selectors", func() {
+			controllerRegistration.Spec.SeedSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}}}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.seedSelector.matchExpressions[0].operator"),
+			}))))
+		})
+
+		It("should allow valid dependencies", func() {
+			controllerRegistration.Spec.Dependencies = []core.ControllerDependency{
+				{Name: "provider-aws"},
+				{Kind: "Network", Type: "calico"},
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid dependencies", func() {
+			controllerRegistration.Spec.Dependencies = []core.ControllerDependency{
+				{Name: "provider-aws"},
+				{Name: "provider-aws"},
+				{Name: "extension-abc"},
+				{Name: "provider-gcp", Kind: "Infrastructure", Type: "gcp"},
+				{Kind: "Infrastructure"},
+				{Kind: ctrlResource.Kind, Type: ctrlResource.Type},
+				{},
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.dependencies[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.dependencies[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.dependencies[3]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.dependencies[4]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.dependencies[5]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.dependencies[6]"),
+				})),
+			))
+		})
 	})
 
 	Describe("#ValidateControllerRegistrationUpdate", func() {
@@ -138,6 +295,29 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec"),
 			}))))
 		})
+
+		It("should allow updating objects with unchanged reconcile timeouts out of the bounds", func() {
+			controllerRegistration.Spec.Resources[0].ReconcileTimeout = &metav1.Duration{Duration: time.Second}
+			newControllerRegistration := prepareControllerRegistrationForUpdate(controllerRegistration)
+			newControllerRegistration.Finalizers = []string{"gardener"}
+
+			errorList := ValidateControllerRegistrationUpdate(newControllerRegistration, controllerRegistration)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid changing reconcile timeouts to values out of the bounds", func() {
+			controllerRegistration.Spec.Resources[0].ReconcileTimeout = &metav1.Duration{Duration: time.Second}
+			newControllerRegistration := prepareControllerRegistrationForUpdate(controllerRegistration)
+			newControllerRegistration.Spec.Resources[0].ReconcileTimeout = &metav1.Duration{Duration: 2 * time.Hour}
+
+			errorList := ValidateControllerRegistrationUpdate(newControllerRegistration, controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.resources[0].reconcileTimeout"),
+			}))))
+		})
 	})
 })
 
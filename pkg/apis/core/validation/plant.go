@@ -15,6 +15,8 @@
 package validation
 
 import (
+	"net/url"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -51,6 +53,26 @@ func ValidatePlantSpec(spec *core.PlantSpec, fldPath *field.Path) field.ErrorLis
 		allErrs = append(allErrs, field.Required(registrationRefPath.Child("name"), "field is required"))
 	}
 
+	if spec.CloudProfileName != nil && len(*spec.CloudProfileName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("cloudProfileName"), "must not be empty if set"))
+	}
+
+	for i, endpoint := range spec.Endpoints {
+		urlPath := fldPath.Child("endpoints").Index(i).Child("url")
+
+		endpointURL, err := url.Parse(endpoint.URL)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(urlPath, endpoint.URL, err.Error()))
+			continue
+		}
+		if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+			allErrs = append(allErrs, field.NotSupported(urlPath.Child("scheme"), endpointURL.Scheme, []string{"http", "https"}))
+		}
+		if len(endpointURL.Hostname()) == 0 {
+			allErrs = append(allErrs, field.Required(urlPath.Child("host"), "endpoint url must contain a host"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -62,7 +62,40 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should forbid an empty cloud profile name", func() {
+			plant.Spec.CloudProfileName = new(string)
+
+			errorList := ValidatePlant(plant)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.cloudProfileName"),
+			}))))
+		})
+
+		It("should forbid endpoints which are not http(s) URLs", func() {
+			plant.Spec.Endpoints = []core.Endpoint{
+				{Name: "file", URL: "file:///etc/passwd"},
+				{Name: "no-host", URL: "https://"},
+			}
+
+			errorList := ValidatePlant(plant)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.endpoints[0].url.scheme"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.endpoints[0].url.host"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.endpoints[1].url.host"),
+			}))))
+		})
+
 		It("should allow valid plant resources", func() {
+			plant.Spec.Endpoints = []core.Endpoint{{Name: "dashboard", URL: "https://dashboard.example.com", Purpose: "management"}}
+
 			errorList := ValidatePlant(plant)
 
 			Expect(errorList).To(BeEmpty())
@@ -30,4 +30,30 @@ const (
 	// ShootCloudProfileName is the field selector path for finding
 	// the CloudProfile name of a core.gardener.cloud/v1alpha1 Shoot.
 	ShootCloudProfileName = "spec.cloudProfileName"
+
+	// ShootRegion is the field selector path for finding
+	// the region of a core.gardener.cloud/v1alpha1 Shoot.
+	ShootRegion = "spec.region"
+
+	// ShootRegionDeprecated is the field selector path for finding
+	// the region of a garden.sapcloud.io/v1beta1 Shoot.
+	// +deprecated
+	ShootRegionDeprecated = "spec.cloud.region"
+
+	// ShootProviderType is the field selector path for finding
+	// the provider type of a core.gardener.cloud/v1alpha1 Shoot.
+	ShootProviderType = "spec.provider.type"
+
+	// ShootSecretBindingName is the field selector path for finding
+	// the SecretBinding name of a core.gardener.cloud/v1alpha1 Shoot.
+	ShootSecretBindingName = "spec.secretBindingName"
+
+	// ShootSecretBindingNameDeprecated is the field selector path for finding
+	// the SecretBinding name of a garden.sapcloud.io/v1beta1 Shoot.
+	// +deprecated
+	ShootSecretBindingNameDeprecated = "spec.cloud.secretBindingRef.name"
+
+	// ShootStatusLastOperationState is the field selector path for finding
+	// the state of the last operation of a Shoot.
+	ShootStatusLastOperationState = "status.lastOperation.state"
 )
@@ -57,6 +57,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SecretBindingList{},
 		&Shoot{},
 		&ShootList{},
+		&AdminKubeconfigRequest{},
 	)
 	return nil
 }
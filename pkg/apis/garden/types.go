@@ -469,6 +469,9 @@ type ProjectSpec struct {
 	ProjectMembers []ProjectMember
 	// Namespace is the name of the namespace that has been created for the Project object.
 	Namespace *string
+	// ServiceAccounts is a list of service accounts which are created in the project namespace and bound to the
+	// given roles. They can be used by automation, e.g. CI pipelines, to access the project.
+	ServiceAccounts []ProjectServiceAccount
 }
 
 // ProjectMember is a member of a project.
@@ -478,6 +481,19 @@ type ProjectMember struct {
 	rbacv1.Subject
 	// Role represents the role of this member.
 	Role string
+	// Roles represents additional roles of this member. Besides the built-in roles, extension roles of the form
+	// "extension:<name>" can be specified.
+	Roles []string
+}
+
+// ProjectServiceAccount is a service account which is managed by the project.
+type ProjectServiceAccount struct {
+	// Name is the name of the service account in the project namespace.
+	Name string
+	// Role represents the role of this service account.
+	Role string
+	// Roles represents additional roles of this service account.
+	Roles []string
 }
 
 const (
@@ -485,6 +501,15 @@ const (
 	ProjectMemberAdmin = "admin"
 	// ProjectMemberViewer is a const for a role that provides limited permissions to only view some resources.
 	ProjectMemberViewer = "viewer"
+	// ProjectMemberUserAccessManager is a const for a role that provides permissions to manage the members of a
+	// project.
+	ProjectMemberUserAccessManager = "uam"
+	// ProjectMemberServiceAccountManager is a const for a role that provides permissions to manage the service
+	// accounts in the project namespace.
+	ProjectMemberServiceAccountManager = "serviceaccountmanager"
+	// ProjectMemberExtensionPrefix is the prefix of roles which are provided by extensions. The ClusterRoles of the
+	// extensions are aggregated into a ClusterRole which is bound in the project namespace.
+	ProjectMemberExtensionPrefix = "extension:"
 )
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -493,6 +518,14 @@ type ProjectStatus struct {
 	ObservedGeneration int64
 	// Phase is the current phase of the project.
 	Phase ProjectPhase
+	// LastActivityTimestamp contains the timestamp of the last observed activity in the project, e.g. an operation
+	// on one of its Shoots or a change of its members.
+	LastActivityTimestamp *metav1.Time
+	// StaleSinceTimestamp contains the timestamp when the project was first discovered to be stale/unused.
+	StaleSinceTimestamp *metav1.Time
+	// StaleAutoDeleteTimestamp contains the timestamp when the project will be garbage-collected/automatically
+	// deleted because it's stale/unused.
+	StaleAutoDeleteTimestamp *metav1.Time
 }
 
 // ProjectPhase is a label for the condition of a project at the current time.
@@ -565,6 +598,9 @@ type SeedSpec struct {
 	Backup *SeedBackup
 	// Volume contains settings for persistentvolumes created in the seed cluster.
 	Volume *SeedVolume
+	// ExcessCapacityReservation contains the policy for reserving excess capacity in the seed cluster for new shoot
+	// control planes. If it is not specified, then the default policy is used.
+	ExcessCapacityReservation *SeedExcessCapacityReservation
 }
 
 const (
@@ -576,6 +612,8 @@ const (
 	MigrationSeedVolumeProviders   = "migration.seed.gardener.cloud/volumeProviders"
 	MigrationSeedTaints            = "migration.seed.gardener.cloud/taints"
 
+	MigrationSeedExcessCapacityReservation = "migration.seed.gardener.cloud/excessCapacityReservation"
+
 	MigrationCloudProfileType           = "migration.cloudprofile.gardener.cloud/type"
 	MigrationCloudProfileProviderConfig = "migration.cloudprofile.gardener.cloud/providerConfig"
 	MigrationCloudProfileSeedSelector   = "migration.cloudprofile.gardener.cloud/seedSelector"
@@ -585,6 +623,9 @@ const (
 	MigrationCloudProfileKubernetes     = "migration.cloudprofile.gardener.cloud/kubernetes"
 	MigrationCloudProfileMachineImages  = "migration.cloudprofile.gardener.cloud/machineImages"
 	MigrationCloudProfileMachineTypes   = "migration.cloudprofile.gardener.cloud/machineTypes"
+
+	MigrationProjectMembers         = "migration.project.gardener.cloud/members"
+	MigrationProjectServiceAccounts = "migration.project.gardener.cloud/serviceAccounts"
 )
 
 // SeedStatus holds the most recently observed status of the Seed cluster.
@@ -596,6 +637,15 @@ type SeedStatus struct {
 	// ObservedGeneration is the most recent generation observed for this Seed. It corresponds to the
 	// Seed's generation, which is updated on mutation by the API Server.
 	ObservedGeneration int64
+	// Allocatable represents the resources of the Seed cluster that are available for scheduling, i.e. the number of
+	// ready and schedulable nodes as well as the sum of their allocatable CPU and memory.
+	Allocatable corev1.ResourceList
+	// Used represents the resources of the Seed cluster that are in use, i.e. the sum of the CPU and memory requests of
+	// all active pods as well as the number of Shoot control planes hosted by the Seed.
+	Used corev1.ResourceList
+	// ReservedExcessCapacity represents the resources of the Seed cluster which are currently reserved for new Shoot
+	// control planes.
+	ReservedExcessCapacity corev1.ResourceList
 }
 
 // SeedCloud defines the cloud profile and the region this Seed cluster belongs to.
@@ -614,6 +664,21 @@ type SeedProvider struct {
 	Region string
 }
 
+// SeedExcessCapacityReservation contains the policy for reserving excess capacity in the seed cluster for new shoot
+// control planes. The capacity reserved for a single shoot control plane is derived from the resources requested by
+// the control planes which are already hosted by the seed.
+type SeedExcessCapacityReservation struct {
+	// Resources are the resources (CPU and memory) which are reserved in addition to the capacity reserved for new
+	// shoot control planes.
+	Resources corev1.ResourceList
+	// ShootPercentage is the percentage of the number of shoots hosted by the seed for which capacity is reserved.
+	ShootPercentage *int32
+	// MinShoots is the minimum number of shoot control planes for which capacity is reserved.
+	MinShoots *int32
+	// MaxShoots is the maximum number of shoot control planes for which capacity is reserved.
+	MaxShoots *int32
+}
+
 // Volume contains settings for persistentvolumes created in the seed cluster.
 type SeedVolume struct {
 	// MinimumSize defines the minimum size that should be used for PVCs in the seed.
@@ -765,6 +830,7 @@ type SecretBindingList struct {
 ////////////////////////////////////////////////////
 
 // +genclient
+// +genclient:method=CreateAdminKubeconfigRequest,verb=create,subresource=adminkubeconfig,input=AdminKubeconfigRequest,result=AdminKubeconfigRequest
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type Shoot struct {
@@ -788,6 +854,35 @@ type ShootList struct {
 	Items []Shoot
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AdminKubeconfigRequest can be used to request a kubeconfig with administrator privileges for a Shoot.
+type AdminKubeconfigRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec is the specification of the AdminKubeconfigRequest.
+	Spec AdminKubeconfigRequestSpec
+	// Status is the status of the AdminKubeconfigRequest.
+	Status AdminKubeconfigRequestStatus
+}
+
+// AdminKubeconfigRequestSpec contains the expiration time of the kubeconfig.
+type AdminKubeconfigRequestSpec struct {
+	// ExpirationSeconds is the requested validity duration of the credential. The credential issuer may return
+	// a credential with a different validity duration.
+	ExpirationSeconds *int64
+}
+
+// AdminKubeconfigRequestStatus is the status of the AdminKubeconfigRequest containing the kubeconfig and expiration
+// of the credential.
+type AdminKubeconfigRequestStatus struct {
+	// Kubeconfig contains the kubeconfig with administrator privileges for the Shoot.
+	Kubeconfig []byte
+	// ExpirationTimestamp is the expiration timestamp of the returned credential.
+	ExpirationTimestamp metav1.Time
+}
+
 // ShootSpec is the specification of a Shoot.
 type ShootSpec struct {
 	// Addons contains information about enabled/disabled addons and their configuration.
@@ -839,6 +934,8 @@ const (
 type ShootStatus struct {
 	// Conditions represents the latest available observations of a Shoots's current state.
 	Conditions []Condition
+	// Extensions contains the status of the extension resources of the Shoot in the Seed.
+	Extensions []ShootExtensionStatus
 	// Gardener holds information about the Gardener which last acted on the Shoot.
 	Gardener Gardener
 	// LastOperation holds information about the last operation on the Shoot.
@@ -864,6 +961,22 @@ type ShootStatus struct {
 	UID types.UID
 }
 
+// ShootExtensionStatus contains the status of an extension resource of a Shoot.
+type ShootExtensionStatus struct {
+	// Kind is the kind of the extension resource, e.g. Infrastructure or Worker.
+	Kind string
+	// Name is the name of the extension resource in the Seed namespace of the Shoot.
+	Name string
+	// Type is the type of the extension resource.
+	Type string
+	// Purpose is the purpose of the extension resource, if it has one.
+	Purpose *string
+	// LastOperation holds information about the last operation on the extension resource.
+	LastOperation *LastOperation
+	// LastError holds information about the last occurred error during an operation on the extension resource.
+	LastError *LastError
+}
+
 ///////////////////////////////
 // Shoot Specification Types //
 ///////////////////////////////
@@ -1760,6 +1873,14 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventStale indicates that the project has been detected to be stale.
+	ProjectEventStale = "ProjectStale"
+	// ProjectEventNotStale indicates that a project which was stale before is in use again.
+	ProjectEventNotStale = "ProjectNotStale"
+	// ProjectEventAutoDeleted indicates that a stale project has been deleted automatically.
+	ProjectEventAutoDeleted = "ProjectAutoDeleted"
+	// ProjectEventAutoDeletionFailed indicates that the automatic deletion of a stale project failed.
+	ProjectEventAutoDeletionFailed = "ProjectAutoDeletionFailed"
 )
 
 const (
@@ -1778,6 +1899,16 @@ const (
 const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable ConditionType = "Available"
+	// SeedAPIServerAvailable is a constant for a condition type indicating that the Seed cluster's API server is available.
+	SeedAPIServerAvailable ConditionType = "APIServerAvailable"
+	// SeedBootstrapComponentsHealthy is a constant for a condition type indicating the health of the components deployed while bootstrapping
+	// the Seed cluster.
+	SeedBootstrapComponentsHealthy ConditionType = "BootstrapComponentsHealthy"
+	// SeedDNSProviderReady is a constant for a condition type indicating that the DNS providers in the Seed cluster are ready.
+	SeedDNSProviderReady ConditionType = "DNSProviderReady"
+	// SeedExtensionsReady is a constant for a condition type indicating that the extension controllers required by the Seed
+	// cluster are installed and healthy.
+	SeedExtensionsReady ConditionType = "ExtensionsReady"
 
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the control plane health.
 	ShootControlPlaneHealthy ConditionType = "ControlPlaneHealthy"
@@ -21,8 +21,10 @@ import (
 	"github.com/gardener/gardener/pkg/apis/garden"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -180,6 +182,7 @@ var _ = Describe("Machine Image Conversion", func() {
 			volumeProviderName1          = "flexvolume"
 			volumeProviderPurpose2       = "foo"
 			volumeProviderName2          = "bar"
+			minShoots                    = int32(4)
 
 			trueVar  = true
 			falseVar = false
@@ -195,6 +198,7 @@ var _ = Describe("Machine Image Conversion", func() {
 					"persistentvolume.garden.sapcloud.io/minimumSize": minimumVolumeSize,
 					"persistentvolume.garden.sapcloud.io/provider":    volumeProviderName1,
 					garden.MigrationSeedTaints:                        fmt.Sprintf("%s,%s,%s,%s", garden.SeedTaintProtected, garden.SeedTaintInvisible, taintKeyOtherOne, taintKeyOtherTwo),
+					garden.MigrationSeedExcessCapacityReservation:     `{"MinShoots":4}`,
 				}
 
 				out = &garden.Seed{}
@@ -269,6 +273,9 @@ var _ = Describe("Machine Image Conversion", func() {
 								},
 							},
 						},
+						ExcessCapacityReservation: &garden.SeedExcessCapacityReservation{
+							MinShoots: &minShoots,
+						},
 					},
 				}))
 			})
@@ -326,6 +333,9 @@ var _ = Describe("Machine Image Conversion", func() {
 								},
 							},
 						},
+						ExcessCapacityReservation: &garden.SeedExcessCapacityReservation{
+							MinShoots: &minShoots,
+						},
 					},
 				}
 			)
@@ -342,6 +352,7 @@ var _ = Describe("Machine Image Conversion", func() {
 							"persistentvolume.garden.sapcloud.io/minimumSize": minimumVolumeSize,
 							"persistentvolume.garden.sapcloud.io/provider":    volumeProviderName1,
 							garden.MigrationSeedTaints:                        fmt.Sprintf("%s,%s,%s", garden.SeedTaintProtected, taintKeyOtherOne, taintKeyOtherTwo),
+							garden.MigrationSeedExcessCapacityReservation:     `{"Resources":null,"ShootPercentage":null,"MinShoots":4,"MaxShoots":null}`,
 						},
 					},
 					Spec: SeedSpec{
@@ -474,6 +485,82 @@ var _ = Describe("Machine Image Conversion", func() {
 	})
 })
 
+var _ = Describe("Project Conversion", func() {
+	var (
+		scheme *runtime.Scheme
+
+		alice = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice"}
+		bob   = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "bob"}
+		carol = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "carol"}
+
+		internalProject *garden.Project
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(scheme.AddConversionFuncs(
+			Convert_v1beta1_Project_To_garden_Project,
+			Convert_garden_Project_To_v1beta1_Project,
+		)).To(Succeed())
+		Expect(AddToScheme(scheme)).To(Succeed())
+
+		internalProject = &garden.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "foo",
+				Annotations: map[string]string{"foo": "bar"},
+			},
+			Spec: garden.ProjectSpec{
+				ProjectMembers: []garden.ProjectMember{
+					{Subject: alice, Role: garden.ProjectMemberAdmin, Roles: []string{garden.ProjectMemberUserAccessManager}},
+					{Subject: bob, Role: garden.ProjectMemberViewer},
+					{Subject: carol, Role: garden.ProjectMemberServiceAccountManager},
+				},
+				ServiceAccounts: []garden.ProjectServiceAccount{
+					{Name: "ci", Role: garden.ProjectMemberAdmin},
+				},
+			},
+		}
+	})
+
+	It("should preserve the additional roles and service accounts in annotations", func() {
+		v1beta1Project := &Project{}
+		Expect(scheme.Convert(internalProject, v1beta1Project, nil)).To(Succeed())
+
+		Expect(v1beta1Project.Spec.Members).To(Equal([]rbacv1.Subject{alice}))
+		Expect(v1beta1Project.Spec.Viewers).To(Equal([]rbacv1.Subject{bob}))
+		Expect(v1beta1Project.Annotations).To(HaveKeyWithValue("foo", "bar"))
+		Expect(v1beta1Project.Annotations).To(HaveKey(garden.MigrationProjectMembers))
+		Expect(v1beta1Project.Annotations).To(HaveKey(garden.MigrationProjectServiceAccounts))
+
+		result := &garden.Project{}
+		Expect(scheme.Convert(v1beta1Project, result, nil)).To(Succeed())
+		Expect(result).To(Equal(internalProject))
+	})
+
+	It("should drop the additional roles of members removed in v1beta1", func() {
+		v1beta1Project := &Project{}
+		Expect(scheme.Convert(internalProject, v1beta1Project, nil)).To(Succeed())
+		v1beta1Project.Spec.Members = nil
+
+		result := &garden.Project{}
+		Expect(scheme.Convert(v1beta1Project, result, nil)).To(Succeed())
+		Expect(result.Spec.ProjectMembers).To(ConsistOf(
+			garden.ProjectMember{Subject: bob, Role: garden.ProjectMemberViewer},
+			garden.ProjectMember{Subject: carol, Role: garden.ProjectMemberServiceAccountManager},
+		))
+	})
+
+	It("should remove stale migration annotations", func() {
+		internalProject.Spec.ProjectMembers = []garden.ProjectMember{{Subject: alice, Role: garden.ProjectMemberAdmin}}
+		internalProject.Spec.ServiceAccounts = nil
+		internalProject.Annotations[garden.MigrationProjectServiceAccounts] = `[{"Name":"ci","Role":"admin"}]`
+
+		v1beta1Project := &Project{}
+		Expect(scheme.Convert(internalProject, v1beta1Project, nil)).To(Succeed())
+		Expect(v1beta1Project.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+	})
+})
+
 var _ = Describe("Kubernetes Constraint Conversion", func() {
 	var (
 		expirationDate             = &metav1.Time{Time: time.Now().Add(time.Second * 20)}
@@ -548,3 +635,31 @@ var _ = Describe("Kubernetes Constraint Conversion", func() {
 		})
 	})
 })
+
+var _ = Describe("Shoot Field Label Conversion", func() {
+	var scheme *runtime.Scheme
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(addConversionFuncs(scheme)).To(Succeed())
+	})
+
+	DescribeTable("#ConvertFieldLabel",
+		func(label, expectedLabel string) {
+			internalLabel, value, err := scheme.ConvertFieldLabel(SchemeGroupVersion.WithKind("Shoot"), label, "foo")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(internalLabel).To(Equal(expectedLabel))
+			Expect(value).To(Equal("foo"))
+		},
+		Entry("seed name", "spec.cloud.seed", garden.ShootSeedNameDeprecated),
+		Entry("region", "spec.cloud.region", garden.ShootRegion),
+		Entry("secret binding name", "spec.cloud.secretBindingRef.name", garden.ShootSecretBindingName),
+		Entry("last operation state", "status.lastOperation.state", garden.ShootStatusLastOperationState),
+	)
+
+	It("should not support other field labels", func() {
+		_, _, err := scheme.ConvertFieldLabel(SchemeGroupVersion.WithKind("Shoot"), "spec.region", "foo")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -136,8 +136,12 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("Shoot"),
 		func(label, value string) (string, string, error) {
 			switch label {
-			case "metadata.name", "metadata.namespace", garden.ShootSeedNameDeprecated:
+			case "metadata.name", "metadata.namespace", garden.ShootSeedNameDeprecated, garden.ShootStatusLastOperationState:
 				return label, value, nil
+			case garden.ShootRegionDeprecated:
+				return garden.ShootRegion, value, nil
+			case garden.ShootSecretBindingNameDeprecated:
+				return garden.ShootSecretBindingName, value, nil
 			default:
 				return "", "", fmt.Errorf("field label not supported: %s", label)
 			}
@@ -204,6 +208,14 @@ func Convert_v1beta1_Seed_To_garden_Seed(in *Seed, out *garden.Seed, s conversio
 
 			out.Spec.Volume.Providers = append(out.Spec.Volume.Providers, obj...)
 		}
+
+		if v, ok := a[garden.MigrationSeedExcessCapacityReservation]; ok {
+			obj := &garden.SeedExcessCapacityReservation{}
+			if err := json.Unmarshal([]byte(v), obj); err != nil {
+				return err
+			}
+			out.Spec.ExcessCapacityReservation = obj
+		}
 	}
 
 	out.Spec.Provider.Region = in.Spec.Cloud.Region
@@ -228,7 +240,7 @@ func Convert_garden_Seed_To_v1beta1_Seed(in *garden.Seed, out *Seed, s conversio
 		return err
 	}
 
-	if len(in.Spec.Provider.Type) > 0 || len(in.Spec.Provider.Region) > 0 || in.Spec.Volume != nil {
+	if len(in.Spec.Provider.Type) > 0 || len(in.Spec.Provider.Region) > 0 || in.Spec.Volume != nil || in.Spec.ExcessCapacityReservation != nil {
 		old := out.Annotations
 		out.Annotations = make(map[string]string, len(old)+3)
 		for k, v := range old {
@@ -270,6 +282,16 @@ func Convert_garden_Seed_To_v1beta1_Seed(in *garden.Seed, out *Seed, s conversio
 		}
 	}
 
+	if in.Spec.ExcessCapacityReservation != nil {
+		data, err := json.Marshal(in.Spec.ExcessCapacityReservation)
+		if err != nil {
+			return err
+		}
+		out.Annotations[garden.MigrationSeedExcessCapacityReservation] = string(data)
+	} else {
+		delete(out.Annotations, garden.MigrationSeedExcessCapacityReservation)
+	}
+
 	var (
 		trueVar   = true
 		falseVar  = false
@@ -338,6 +360,106 @@ func Convert_v1beta1_SeedSpec_To_garden_SeedSpec(in *SeedSpec, out *garden.SeedS
 	return autoConvert_v1beta1_SeedSpec_To_garden_SeedSpec(in, out, s)
 }
 
+func Convert_v1beta1_Project_To_garden_Project(in *Project, out *garden.Project, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_Project_To_garden_Project(in, out, s); err != nil {
+		return err
+	}
+
+	a := in.Annotations
+	if a == nil {
+		return nil
+	}
+
+	if v, ok := a[garden.MigrationProjectMembers]; ok {
+		var members []garden.ProjectMember
+		if err := json.Unmarshal([]byte(v), &members); err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			if member.Role != garden.ProjectMemberAdmin && member.Role != garden.ProjectMemberViewer {
+				out.Spec.ProjectMembers = append(out.Spec.ProjectMembers, member)
+				continue
+			}
+
+			// Members with a built-in role are part of the v1beta1 members/viewers lists. If they have been removed from
+			// there then their additional roles are dropped as well.
+			for i, m := range out.Spec.ProjectMembers {
+				if m.Subject == member.Subject && m.Role == member.Role {
+					out.Spec.ProjectMembers[i].Roles = member.Roles
+					break
+				}
+			}
+		}
+	}
+
+	if v, ok := a[garden.MigrationProjectServiceAccounts]; ok {
+		var serviceAccounts []garden.ProjectServiceAccount
+		if err := json.Unmarshal([]byte(v), &serviceAccounts); err != nil {
+			return err
+		}
+		out.Spec.ServiceAccounts = serviceAccounts
+	}
+
+	out.Annotations = withoutProjectMigrationAnnotations(a)
+	return nil
+}
+
+func Convert_garden_Project_To_v1beta1_Project(in *garden.Project, out *Project, s conversion.Scope) error {
+	if err := autoConvert_garden_Project_To_v1beta1_Project(in, out, s); err != nil {
+		return err
+	}
+
+	var extendedMembers []garden.ProjectMember
+	for _, member := range in.Spec.ProjectMembers {
+		if len(member.Roles) > 0 || (member.Role != garden.ProjectMemberAdmin && member.Role != garden.ProjectMemberViewer) {
+			extendedMembers = append(extendedMembers, member)
+		}
+	}
+
+	annotations := withoutProjectMigrationAnnotations(in.Annotations)
+	if len(extendedMembers) > 0 || len(in.Spec.ServiceAccounts) > 0 {
+		if annotations == nil {
+			annotations = make(map[string]string, 2)
+		}
+
+		if len(extendedMembers) > 0 {
+			data, err := json.Marshal(extendedMembers)
+			if err != nil {
+				return err
+			}
+			annotations[garden.MigrationProjectMembers] = string(data)
+		}
+
+		if len(in.Spec.ServiceAccounts) > 0 {
+			data, err := json.Marshal(in.Spec.ServiceAccounts)
+			if err != nil {
+				return err
+			}
+			annotations[garden.MigrationProjectServiceAccounts] = string(data)
+		}
+	}
+	out.Annotations = annotations
+
+	return nil
+}
+
+// withoutProjectMigrationAnnotations returns a copy of the given annotations without the migration annotations of
+// projects. It returns nil if no annotations remain.
+func withoutProjectMigrationAnnotations(annotations map[string]string) map[string]string {
+	var out map[string]string
+	for k, v := range annotations {
+		if k == garden.MigrationProjectMembers || k == garden.MigrationProjectServiceAccounts {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string, len(annotations))
+		}
+		out[k] = v
+	}
+	return out
+}
+
 func Convert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out *garden.ProjectSpec, s conversion.Scope) error {
 	if err := autoConvert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in, out, s); err != nil {
 		return err
@@ -192,56 +192,11 @@ func SetDefaults_Shoot(obj *Shoot) {
 		obj.Spec.Kubernetes.KubeProxy.Mode = &defaultProxyMode
 	}
 
-	if obj.Spec.Maintenance == nil {
-		mt := utils.RandomMaintenanceTimeWindow()
-
-		obj.Spec.Maintenance = &Maintenance{
-			AutoUpdate: &MaintenanceAutoUpdate{
-				KubernetesVersion:   true,
-				MachineImageVersion: &trueVar,
-			},
-			TimeWindow: &MaintenanceTimeWindow{
-				Begin: mt.Begin().Formatted(),
-				End:   mt.End().Formatted(),
-			},
-		}
-	} else {
-		if obj.Spec.Maintenance.AutoUpdate == nil {
-			obj.Spec.Maintenance.AutoUpdate = &MaintenanceAutoUpdate{
-				KubernetesVersion:   trueVar,
-				MachineImageVersion: &trueVar,
-			}
-		}
-
-		if obj.Spec.Maintenance.AutoUpdate.MachineImageVersion == nil {
-			obj.Spec.Maintenance.AutoUpdate.MachineImageVersion = &trueVar
-		}
-
-		if obj.Spec.Maintenance.TimeWindow == nil {
-			mt := utils.RandomMaintenanceTimeWindow()
-
-			obj.Spec.Maintenance.TimeWindow = &MaintenanceTimeWindow{
-				Begin: mt.Begin().Formatted(),
-				End:   mt.End().Formatted(),
-			}
-		}
-	}
-
+	// The addons are required for the conversion, the kubernetes-dashboard addon is defaulted by the Shoot strategy
+	// after the mutating admission plugins (e.g. the Shoot presets) ran.
 	if obj.Spec.Addons == nil {
 		obj.Spec.Addons = &Addons{}
 	}
-	if obj.Spec.Addons.KubernetesDashboard == nil {
-		obj.Spec.Addons.KubernetesDashboard = &KubernetesDashboard{}
-	}
-	if obj.Spec.Addons.KubernetesDashboard.AuthenticationMode == nil {
-		var defaultAuthMode string
-		if k8sVersionLessThan116 {
-			defaultAuthMode = KubernetesDashboardAuthModeBasic
-		} else {
-			defaultAuthMode = KubernetesDashboardAuthModeToken
-		}
-		obj.Spec.Addons.KubernetesDashboard.AuthenticationMode = &defaultAuthMode
-	}
 }
 
 // SetDefaults_Seed sets default values for Seed objects.
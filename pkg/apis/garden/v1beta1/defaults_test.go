@@ -20,7 +20,6 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1"
-	"github.com/gardener/gardener/pkg/utils"
 )
 
 var _ = Describe("#SetDefaults_Shoot", func() {
@@ -1089,44 +1088,5 @@ var _ = Describe("#SetDefaults_Shoot", func() {
 				})
 			})
 		})
-
-		Context("maintenance", func() {
-
-			Context("without provided maintenance", func() {
-				It("should automatically update the Kubernetes version", func() {
-					Expect(shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion).To(BeTrue())
-				})
-
-				It("should have a valid maintenance start time", func() {
-					Expect(utils.ParseMaintenanceTime(shoot.Spec.Maintenance.TimeWindow.Begin)).ShouldNot(PointTo(BeNil()))
-				})
-
-				It("should have a valid maintenance end time", func() {
-					Expect(utils.ParseMaintenanceTime(shoot.Spec.Maintenance.TimeWindow.End)).ShouldNot(PointTo(BeNil()))
-				})
-			})
-
-			Context("with provided maintenance", func() {
-				var maintenance *v1beta1.Maintenance
-
-				BeforeEach(func() {
-					maintenance = &v1beta1.Maintenance{}
-					shoot.Spec.Maintenance = maintenance
-				})
-
-				It("should automatically update the Kubernetes version", func() {
-					Expect(shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion).To(BeTrue())
-				})
-
-				It("should have a valid maintenance start time", func() {
-					Expect(utils.ParseMaintenanceTime(shoot.Spec.Maintenance.TimeWindow.Begin)).ShouldNot(PointTo(BeNil()))
-				})
-
-				It("should have a valid maintenance end time", func() {
-					Expect(utils.ParseMaintenanceTime(shoot.Spec.Maintenance.TimeWindow.End)).ShouldNot(PointTo(BeNil()))
-				})
-			})
-
-		})
 	})
 })
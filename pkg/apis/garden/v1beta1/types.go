@@ -467,6 +467,17 @@ type ProjectStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Phase is the current phase of the project.
 	Phase ProjectPhase `json:"phase,omitempty"`
+	// LastActivityTimestamp contains the timestamp of the last observed activity in the project, e.g. an operation
+	// on one of its Shoots or a change of its members.
+	// +optional
+	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty"`
+	// StaleSinceTimestamp contains the timestamp when the project was first discovered to be stale/unused.
+	// +optional
+	StaleSinceTimestamp *metav1.Time `json:"staleSinceTimestamp,omitempty"`
+	// StaleAutoDeleteTimestamp contains the timestamp when the project will be garbage-collected/automatically
+	// deleted because it's stale/unused.
+	// +optional
+	StaleAutoDeleteTimestamp *metav1.Time `json:"staleAutoDeleteTimestamp,omitempty"`
 }
 
 // ProjectPhase is a label for the condition of a project at the current time.
@@ -560,6 +571,18 @@ type SeedStatus struct {
 	// Seed's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Allocatable represents the resources of the Seed cluster that are available for scheduling, i.e. the number of
+	// ready and schedulable nodes as well as the sum of their allocatable CPU and memory.
+	// +optional
+	Allocatable corev1.ResourceList `json:"allocatable,omitempty"`
+	// Used represents the resources of the Seed cluster that are in use, i.e. the sum of the CPU and memory requests of
+	// all active pods as well as the number of Shoot control planes hosted by the Seed.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
+	// ReservedExcessCapacity represents the resources of the Seed cluster which are currently reserved for new Shoot
+	// control planes.
+	// +optional
+	ReservedExcessCapacity corev1.ResourceList `json:"reservedExcessCapacity,omitempty"`
 }
 
 // SeedCloud defines the cloud profile and the region this Seed cluster belongs to.
@@ -679,6 +702,7 @@ type SecretBindingList struct {
 ////////////////////////////////////////////////////
 
 // +genclient
+// +genclient:method=CreateAdminKubeconfigRequest,verb=create,subresource=adminkubeconfig,input=AdminKubeconfigRequest,result=AdminKubeconfigRequest
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // +k8s:openapi-gen=x-kubernetes-print-columns:custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SEED:.spec.cloud.seed,DOMAIN:.spec.dns.domain,VERSION:.spec.kubernetes.version,CONTROL:.status.conditions[?(@.type == 'ControlPlaneHealthy')].status,NODES:.status.conditions[?(@.type == 'EveryNodeReady')].status,SYSTEM:.status.conditions[?(@.type == 'SystemComponentsHealthy')].status,LATEST:.status.lastOperation.state
@@ -707,6 +731,38 @@ type ShootList struct {
 	Items []Shoot `json:"items"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AdminKubeconfigRequest can be used to request a kubeconfig with administrator privileges for a Shoot.
+type AdminKubeconfigRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the specification of the AdminKubeconfigRequest.
+	Spec AdminKubeconfigRequestSpec `json:"spec"`
+	// Status is the status of the AdminKubeconfigRequest.
+	// +optional
+	Status AdminKubeconfigRequestStatus `json:"status"`
+}
+
+// AdminKubeconfigRequestSpec contains the expiration time of the kubeconfig.
+type AdminKubeconfigRequestSpec struct {
+	// ExpirationSeconds is the requested validity duration of the credential. The credential issuer may return
+	// a credential with a different validity duration. Defaults to 1 hour.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+// AdminKubeconfigRequestStatus is the status of the AdminKubeconfigRequest containing the kubeconfig and expiration
+// of the credential.
+type AdminKubeconfigRequestStatus struct {
+	// Kubeconfig contains the kubeconfig with administrator privileges for the Shoot.
+	Kubeconfig []byte `json:"kubeconfig"`
+	// ExpirationTimestamp is the expiration timestamp of the returned credential.
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp"`
+}
+
 // ShootSpec is the specification of a Shoot.
 type ShootSpec struct {
 	// Addons contains information about enabled/disabled addons and their configuration.
@@ -740,6 +796,9 @@ type ShootStatus struct {
 	// Conditions represents the latest available observations of a Shoots's current state.
 	// +optional
 	Conditions []gardencorev1alpha1.Condition `json:"conditions,omitempty"`
+	// Extensions contains the status of the extension resources of the Shoot in the Seed.
+	// +optional
+	Extensions []ShootExtensionStatus `json:"extensions,omitempty"`
 	// Gardener holds information about the Gardener which last acted on the Shoot.
 	Gardener Gardener `json:"gardener"`
 	// LastOperation holds information about the last operation on the Shoot.
@@ -770,6 +829,25 @@ type ShootStatus struct {
 	UID types.UID `json:"uid"`
 }
 
+// ShootExtensionStatus contains the status of an extension resource of a Shoot.
+type ShootExtensionStatus struct {
+	// Kind is the kind of the extension resource, e.g. Infrastructure or Worker.
+	Kind string `json:"kind"`
+	// Name is the name of the extension resource in the Seed namespace of the Shoot.
+	Name string `json:"name"`
+	// Type is the type of the extension resource.
+	Type string `json:"type"`
+	// Purpose is the purpose of the extension resource, if it has one.
+	// +optional
+	Purpose *string `json:"purpose,omitempty"`
+	// LastOperation holds information about the last operation on the extension resource.
+	// +optional
+	LastOperation *gardencorev1alpha1.LastOperation `json:"lastOperation,omitempty"`
+	// LastError holds information about the last occurred error during an operation on the extension resource.
+	// +optional
+	LastError *gardencorev1alpha1.LastError `json:"lastError,omitempty"`
+}
+
 ///////////////////////////////
 // Shoot Specification Types //
 ///////////////////////////////
@@ -1855,6 +1933,14 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventStale indicates that the project has been detected to be stale.
+	ProjectEventStale = "ProjectStale"
+	// ProjectEventNotStale indicates that a project which was stale before is in use again.
+	ProjectEventNotStale = "ProjectNotStale"
+	// ProjectEventAutoDeleted indicates that a stale project has been deleted automatically.
+	ProjectEventAutoDeleted = "ProjectAutoDeleted"
+	// ProjectEventAutoDeletionFailed indicates that the automatic deletion of a stale project failed.
+	ProjectEventAutoDeletionFailed = "ProjectAutoDeletionFailed"
 
 	// ShootEventSchedulingSuccessful
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
@@ -1878,6 +1964,16 @@ const (
 const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable gardencorev1alpha1.ConditionType = "Available"
+	// SeedAPIServerAvailable is a constant for a condition type indicating that the Seed cluster's API server is available.
+	SeedAPIServerAvailable gardencorev1alpha1.ConditionType = "APIServerAvailable"
+	// SeedBootstrapComponentsHealthy is a constant for a condition type indicating the health of the components deployed while bootstrapping
+	// the Seed cluster.
+	SeedBootstrapComponentsHealthy gardencorev1alpha1.ConditionType = "BootstrapComponentsHealthy"
+	// SeedDNSProviderReady is a constant for a condition type indicating that the DNS providers in the Seed cluster are ready.
+	SeedDNSProviderReady gardencorev1alpha1.ConditionType = "DNSProviderReady"
+	// SeedExtensionsReady is a constant for a condition type indicating that the extension controllers required by the Seed
+	// cluster are installed and healthy.
+	SeedExtensionsReady gardencorev1alpha1.ConditionType = "ExtensionsReady"
 
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the control plane health.
 	ShootControlPlaneHealthy gardencorev1alpha1.ConditionType = "ControlPlaneHealthy"
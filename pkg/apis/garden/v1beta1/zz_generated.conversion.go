@@ -106,6 +106,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AdminKubeconfigRequest)(nil), (*garden.AdminKubeconfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AdminKubeconfigRequest_To_garden_AdminKubeconfigRequest(a.(*AdminKubeconfigRequest), b.(*garden.AdminKubeconfigRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AdminKubeconfigRequest)(nil), (*AdminKubeconfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AdminKubeconfigRequest_To_v1beta1_AdminKubeconfigRequest(a.(*garden.AdminKubeconfigRequest), b.(*AdminKubeconfigRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AdminKubeconfigRequestSpec)(nil), (*garden.AdminKubeconfigRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec(a.(*AdminKubeconfigRequestSpec), b.(*garden.AdminKubeconfigRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AdminKubeconfigRequestSpec)(nil), (*AdminKubeconfigRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AdminKubeconfigRequestSpec_To_v1beta1_AdminKubeconfigRequestSpec(a.(*garden.AdminKubeconfigRequestSpec), b.(*AdminKubeconfigRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AdminKubeconfigRequestStatus)(nil), (*garden.AdminKubeconfigRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus(a.(*AdminKubeconfigRequestStatus), b.(*garden.AdminKubeconfigRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AdminKubeconfigRequestStatus)(nil), (*AdminKubeconfigRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AdminKubeconfigRequestStatus_To_v1beta1_AdminKubeconfigRequestStatus(a.(*garden.AdminKubeconfigRequestStatus), b.(*AdminKubeconfigRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AdmissionPlugin)(nil), (*garden.AdmissionPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AdmissionPlugin_To_garden_AdmissionPlugin(a.(*AdmissionPlugin), b.(*garden.AdmissionPlugin), scope)
 	}); err != nil {
@@ -1086,6 +1116,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootExtensionStatus)(nil), (*garden.ShootExtensionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootExtensionStatus_To_garden_ShootExtensionStatus(a.(*ShootExtensionStatus), b.(*garden.ShootExtensionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootExtensionStatus)(nil), (*ShootExtensionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootExtensionStatus_To_v1beta1_ShootExtensionStatus(a.(*garden.ShootExtensionStatus), b.(*ShootExtensionStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootList)(nil), (*garden.ShootList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootList_To_garden_ShootList(a.(*ShootList), b.(*garden.ShootList), scope)
 	}); err != nil {
@@ -1201,6 +1241,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*garden.Project)(nil), (*Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_Project_To_v1beta1_Project(a.(*garden.Project), b.(*Project), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*garden.QuotaSpec)(nil), (*QuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_garden_QuotaSpec_To_v1beta1_QuotaSpec(a.(*garden.QuotaSpec), b.(*QuotaSpec), scope)
 	}); err != nil {
@@ -1331,6 +1376,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*Project)(nil), (*garden.Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Project_To_garden_Project(a.(*Project), b.(*garden.Project), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*QuotaSpec)(nil), (*garden.QuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_QuotaSpec_To_garden_QuotaSpec(a.(*QuotaSpec), b.(*garden.QuotaSpec), scope)
 	}); err != nil {
@@ -1647,6 +1697,80 @@ func Convert_garden_Addons_To_v1beta1_Addons(in *garden.Addons, out *Addons, s c
 	return autoConvert_garden_Addons_To_v1beta1_Addons(in, out, s)
 }
 
+func autoConvert_v1beta1_AdminKubeconfigRequest_To_garden_AdminKubeconfigRequest(in *AdminKubeconfigRequest, out *garden.AdminKubeconfigRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_AdminKubeconfigRequest_To_garden_AdminKubeconfigRequest is an autogenerated conversion function.
+func Convert_v1beta1_AdminKubeconfigRequest_To_garden_AdminKubeconfigRequest(in *AdminKubeconfigRequest, out *garden.AdminKubeconfigRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_AdminKubeconfigRequest_To_garden_AdminKubeconfigRequest(in, out, s)
+}
+
+func autoConvert_garden_AdminKubeconfigRequest_To_v1beta1_AdminKubeconfigRequest(in *garden.AdminKubeconfigRequest, out *AdminKubeconfigRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_garden_AdminKubeconfigRequestSpec_To_v1beta1_AdminKubeconfigRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_garden_AdminKubeconfigRequestStatus_To_v1beta1_AdminKubeconfigRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_AdminKubeconfigRequest_To_v1beta1_AdminKubeconfigRequest is an autogenerated conversion function.
+func Convert_garden_AdminKubeconfigRequest_To_v1beta1_AdminKubeconfigRequest(in *garden.AdminKubeconfigRequest, out *AdminKubeconfigRequest, s conversion.Scope) error {
+	return autoConvert_garden_AdminKubeconfigRequest_To_v1beta1_AdminKubeconfigRequest(in, out, s)
+}
+
+func autoConvert_v1beta1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec(in *AdminKubeconfigRequestSpec, out *garden.AdminKubeconfigRequestSpec, s conversion.Scope) error {
+	out.ExpirationSeconds = (*int64)(unsafe.Pointer(in.ExpirationSeconds))
+	return nil
+}
+
+// Convert_v1beta1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec is an autogenerated conversion function.
+func Convert_v1beta1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec(in *AdminKubeconfigRequestSpec, out *garden.AdminKubeconfigRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_AdminKubeconfigRequestSpec_To_garden_AdminKubeconfigRequestSpec(in, out, s)
+}
+
+func autoConvert_garden_AdminKubeconfigRequestSpec_To_v1beta1_AdminKubeconfigRequestSpec(in *garden.AdminKubeconfigRequestSpec, out *AdminKubeconfigRequestSpec, s conversion.Scope) error {
+	out.ExpirationSeconds = (*int64)(unsafe.Pointer(in.ExpirationSeconds))
+	return nil
+}
+
+// Convert_garden_AdminKubeconfigRequestSpec_To_v1beta1_AdminKubeconfigRequestSpec is an autogenerated conversion function.
+func Convert_garden_AdminKubeconfigRequestSpec_To_v1beta1_AdminKubeconfigRequestSpec(in *garden.AdminKubeconfigRequestSpec, out *AdminKubeconfigRequestSpec, s conversion.Scope) error {
+	return autoConvert_garden_AdminKubeconfigRequestSpec_To_v1beta1_AdminKubeconfigRequestSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus(in *AdminKubeconfigRequestStatus, out *garden.AdminKubeconfigRequestStatus, s conversion.Scope) error {
+	out.Kubeconfig = *(*[]byte)(unsafe.Pointer(&in.Kubeconfig))
+	out.ExpirationTimestamp = in.ExpirationTimestamp
+	return nil
+}
+
+// Convert_v1beta1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus is an autogenerated conversion function.
+func Convert_v1beta1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus(in *AdminKubeconfigRequestStatus, out *garden.AdminKubeconfigRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_AdminKubeconfigRequestStatus_To_garden_AdminKubeconfigRequestStatus(in, out, s)
+}
+
+func autoConvert_garden_AdminKubeconfigRequestStatus_To_v1beta1_AdminKubeconfigRequestStatus(in *garden.AdminKubeconfigRequestStatus, out *AdminKubeconfigRequestStatus, s conversion.Scope) error {
+	out.Kubeconfig = *(*[]byte)(unsafe.Pointer(&in.Kubeconfig))
+	out.ExpirationTimestamp = in.ExpirationTimestamp
+	return nil
+}
+
+// Convert_garden_AdminKubeconfigRequestStatus_To_v1beta1_AdminKubeconfigRequestStatus is an autogenerated conversion function.
+func Convert_garden_AdminKubeconfigRequestStatus_To_v1beta1_AdminKubeconfigRequestStatus(in *garden.AdminKubeconfigRequestStatus, out *AdminKubeconfigRequestStatus, s conversion.Scope) error {
+	return autoConvert_garden_AdminKubeconfigRequestStatus_To_v1beta1_AdminKubeconfigRequestStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_AdmissionPlugin_To_garden_AdmissionPlugin(in *AdmissionPlugin, out *garden.AdmissionPlugin, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Config = (*garden.ProviderConfig)(unsafe.Pointer(in.Config))
@@ -4249,11 +4373,6 @@ func autoConvert_v1beta1_Project_To_garden_Project(in *Project, out *garden.Proj
 	return nil
 }
 
-// Convert_v1beta1_Project_To_garden_Project is an autogenerated conversion function.
-func Convert_v1beta1_Project_To_garden_Project(in *Project, out *garden.Project, s conversion.Scope) error {
-	return autoConvert_v1beta1_Project_To_garden_Project(in, out, s)
-}
-
 func autoConvert_garden_Project_To_v1beta1_Project(in *garden.Project, out *Project, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_garden_ProjectSpec_To_v1beta1_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4265,11 +4384,6 @@ func autoConvert_garden_Project_To_v1beta1_Project(in *garden.Project, out *Proj
 	return nil
 }
 
-// Convert_garden_Project_To_v1beta1_Project is an autogenerated conversion function.
-func Convert_garden_Project_To_v1beta1_Project(in *garden.Project, out *Project, s conversion.Scope) error {
-	return autoConvert_garden_Project_To_v1beta1_Project(in, out, s)
-}
-
 func autoConvert_v1beta1_ProjectList_To_garden_ProjectList(in *ProjectList, out *garden.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4330,12 +4444,16 @@ func autoConvert_garden_ProjectSpec_To_v1beta1_ProjectSpec(in *garden.ProjectSpe
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
 	// WARNING: in.ProjectMembers requires manual conversion: does not exist in peer-type
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	// WARNING: in.ServiceAccounts requires manual conversion: does not exist in peer-type
 	return nil
 }
 
 func autoConvert_v1beta1_ProjectStatus_To_garden_ProjectStatus(in *ProjectStatus, out *garden.ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = garden.ProjectPhase(in.Phase)
+	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
+	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	return nil
 }
 
@@ -4347,6 +4465,9 @@ func Convert_v1beta1_ProjectStatus_To_garden_ProjectStatus(in *ProjectStatus, ou
 func autoConvert_garden_ProjectStatus_To_v1beta1_ProjectStatus(in *garden.ProjectStatus, out *ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = ProjectPhase(in.Phase)
+	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
+	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	return nil
 }
 
@@ -4625,6 +4746,7 @@ func autoConvert_garden_SeedSpec_To_v1beta1_SeedSpec(in *garden.SeedSpec, out *S
 	// WARNING: in.Taints requires manual conversion: does not exist in peer-type
 	out.Backup = (*BackupProfile)(unsafe.Pointer(in.Backup))
 	// WARNING: in.Volume requires manual conversion: does not exist in peer-type
+	// WARNING: in.ExcessCapacityReservation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -4634,6 +4756,9 @@ func autoConvert_v1beta1_SeedStatus_To_garden_SeedStatus(in *SeedStatus, out *ga
 	}
 	out.Conditions = *(*[]garden.Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = in.ObservedGeneration
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.ReservedExcessCapacity = *(*v1.ResourceList)(unsafe.Pointer(&in.ReservedExcessCapacity))
 	return nil
 }
 
@@ -4648,6 +4773,9 @@ func autoConvert_garden_SeedStatus_To_v1beta1_SeedStatus(in *garden.SeedStatus,
 		return err
 	}
 	out.ObservedGeneration = in.ObservedGeneration
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.ReservedExcessCapacity = *(*v1.ResourceList)(unsafe.Pointer(&in.ReservedExcessCapacity))
 	return nil
 }
 
@@ -4700,6 +4828,36 @@ func autoConvert_garden_Shoot_To_v1beta1_Shoot(in *garden.Shoot, out *Shoot, s c
 	return nil
 }
 
+func autoConvert_v1beta1_ShootExtensionStatus_To_garden_ShootExtensionStatus(in *ShootExtensionStatus, out *garden.ShootExtensionStatus, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
+	out.LastOperation = (*garden.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.LastError = (*garden.LastError)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_v1beta1_ShootExtensionStatus_To_garden_ShootExtensionStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootExtensionStatus_To_garden_ShootExtensionStatus(in *ShootExtensionStatus, out *garden.ShootExtensionStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootExtensionStatus_To_garden_ShootExtensionStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootExtensionStatus_To_v1beta1_ShootExtensionStatus(in *garden.ShootExtensionStatus, out *ShootExtensionStatus, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
+	out.LastOperation = (*v1alpha1.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.LastError = (*v1alpha1.LastError)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_garden_ShootExtensionStatus_To_v1beta1_ShootExtensionStatus is an autogenerated conversion function.
+func Convert_garden_ShootExtensionStatus_To_v1beta1_ShootExtensionStatus(in *garden.ShootExtensionStatus, out *ShootExtensionStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootExtensionStatus_To_v1beta1_ShootExtensionStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootList_To_garden_ShootList(in *ShootList, out *garden.ShootList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4829,6 +4987,7 @@ func autoConvert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out
 
 func autoConvert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out *garden.ShootStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]garden.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Extensions = *(*[]garden.ShootExtensionStatus)(unsafe.Pointer(&in.Extensions))
 	if err := Convert_v1beta1_Gardener_To_garden_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
@@ -4847,6 +5006,7 @@ func autoConvert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out
 
 func autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatus, out *ShootStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1alpha1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Extensions = *(*[]ShootExtensionStatus)(unsafe.Pointer(&in.Extensions))
 	if err := Convert_garden_Gardener_To_v1beta1_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
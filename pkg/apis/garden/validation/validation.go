@@ -54,6 +54,12 @@ var (
 		garden.KubernetesDashboardAuthModeBasic,
 		garden.KubernetesDashboardAuthModeToken,
 	)
+	availableProjectMemberRoles = sets.NewString(
+		garden.ProjectMemberAdmin,
+		garden.ProjectMemberViewer,
+		garden.ProjectMemberUserAccessManager,
+		garden.ProjectMemberServiceAccountManager,
+	)
 )
 
 // ValidateName is a helper function for validating that a name is a DNS sub domain.
@@ -727,7 +733,26 @@ func ValidateProjectSpec(projectSpec *garden.ProjectSpec, fldPath *field.Path) f
 	allErrs := field.ErrorList{}
 
 	for i, member := range projectSpec.ProjectMembers {
-		allErrs = append(allErrs, ValidateSubject(member.Subject, fldPath.Child("members").Index(i))...)
+		idxPath := fldPath.Child("members").Index(i)
+		allErrs = append(allErrs, ValidateSubject(member.Subject, idxPath)...)
+		allErrs = append(allErrs, ValidateProjectMemberRoles(member, idxPath)...)
+	}
+
+	serviceAccountNames := sets.NewString()
+	for i, serviceAccount := range projectSpec.ServiceAccounts {
+		idxPath := fldPath.Child("serviceAccounts").Index(i)
+		if len(serviceAccount.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range apivalidation.ValidateServiceAccountName(serviceAccount.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), serviceAccount.Name, msg))
+			}
+			if serviceAccountNames.Has(serviceAccount.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), serviceAccount.Name))
+			}
+			serviceAccountNames.Insert(serviceAccount.Name)
+		}
+		allErrs = append(allErrs, validateProjectRoles(serviceAccount.Role, serviceAccount.Roles, idxPath)...)
 	}
 	if createdBy := projectSpec.CreatedBy; createdBy != nil {
 		allErrs = append(allErrs, ValidateSubject(*createdBy, fldPath.Child("createdBy"))...)
@@ -745,6 +770,58 @@ func ValidateProjectSpec(projectSpec *garden.ProjectSpec, fldPath *field.Path) f
 	return allErrs
 }
 
+// maxExtensionRoleNameLength is the maximum length of the name of an extension role.
+const maxExtensionRoleNameLength = 20
+
+// ValidateProjectMemberRoles validates the roles of a project member.
+func ValidateProjectMemberRoles(member garden.ProjectMember, fldPath *field.Path) field.ErrorList {
+	return validateProjectRoles(member.Role, member.Roles, fldPath)
+}
+
+func validateProjectRoles(primaryRole string, additionalRoles []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(primaryRole) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("role"), "must provide a role"))
+	} else {
+		allErrs = append(allErrs, validateProjectMemberRole(primaryRole, fldPath.Child("role"))...)
+	}
+
+	foundRoles := sets.NewString(primaryRole)
+	for i, role := range additionalRoles {
+		idxPath := fldPath.Child("roles").Index(i)
+		if foundRoles.Has(role) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, role))
+			continue
+		}
+		foundRoles.Insert(role)
+		allErrs = append(allErrs, validateProjectMemberRole(role, idxPath)...)
+	}
+
+	return allErrs
+}
+
+func validateProjectMemberRole(role string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if strings.HasPrefix(role, garden.ProjectMemberExtensionPrefix) {
+		extensionRoleName := strings.TrimPrefix(role, garden.ProjectMemberExtensionPrefix)
+		if len(extensionRoleName) > maxExtensionRoleNameLength {
+			allErrs = append(allErrs, field.TooLong(fldPath, extensionRoleName, maxExtensionRoleNameLength))
+		}
+		for _, msg := range validation.IsDNS1123Label(extensionRoleName) {
+			allErrs = append(allErrs, field.Invalid(fldPath, role, msg))
+		}
+		return allErrs
+	}
+
+	if !availableProjectMemberRoles.Has(role) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, role, append(availableProjectMemberRoles.List(), garden.ProjectMemberExtensionPrefix+"*")))
+	}
+
+	return allErrs
+}
+
 // ValidateSubject validates the subject representing the owner.
 func ValidateSubject(subject rbacv1.Subject, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -925,6 +1002,38 @@ func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.Erro
 		}
 	}
 
+	if seedSpec.ExcessCapacityReservation != nil {
+		allErrs = append(allErrs, validateSeedExcessCapacityReservation(seedSpec.ExcessCapacityReservation, fldPath.Child("excessCapacityReservation"))...)
+	}
+
+	return allErrs
+}
+
+func validateSeedExcessCapacityReservation(reservation *garden.SeedExcessCapacityReservation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	supportedResources := sets.NewString(string(corev1.ResourceCPU), string(corev1.ResourceMemory))
+	for name, value := range reservation.Resources {
+		if !supportedResources.Has(string(name)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("resources").Key(string(name)), string(name), supportedResources.List()))
+			continue
+		}
+		allErrs = append(allErrs, validateResourceQuantityValue(string(name), value, fldPath.Child("resources").Key(string(name)))...)
+	}
+
+	if p := reservation.ShootPercentage; p != nil && (*p < 0 || *p > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("shootPercentage"), *p, "must be between 0 and 100"))
+	}
+	if m := reservation.MinShoots; m != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*m), fldPath.Child("minShoots"))...)
+	}
+	if m := reservation.MaxShoots; m != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*m), fldPath.Child("maxShoots"))...)
+		if reservation.MinShoots != nil && *m < *reservation.MinShoots {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxShoots"), *m, "must not be less than minShoots"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -1146,11 +1255,11 @@ func ValidateShootSpec(spec *garden.ShootSpec, fldPath *field.Path) field.ErrorL
 	allErrs = append(allErrs, validateAddons(spec.Addons, spec.Kubernetes.KubeAPIServer, fldPath.Child("addons"))...)
 	allErrs = append(allErrs, validateCloud(spec.Cloud, spec.Kubernetes, fldPath.Child("cloud"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
-	allErrs = append(allErrs, validateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
+	allErrs = append(allErrs, ValidateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateNetworking(spec.Networking, fldPath.Child("networking"))...)
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"))...)
-	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
+	allErrs = append(allErrs, ValidateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
 	allErrs = append(allErrs, ValidateHibernation(spec.Hibernation, fldPath.Child("hibernation"))...)
 	allErrs = append(allErrs, validateProvider(spec.Provider, fldPath.Child("provider"))...)
 
@@ -1974,7 +2083,8 @@ func validateDNS(dns *garden.DNS, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
-func validateExtensions(extensions []garden.Extension, fldPath *field.Path) field.ErrorList {
+// ValidateExtensions validates the given list of extensions.
+func ValidateExtensions(extensions []garden.Extension, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for i, extension := range extensions {
 		if extension.Type == "" {
@@ -2210,7 +2320,8 @@ func validateKubeProxy(kp *garden.KubeProxyConfig, fldPath *field.Path) field.Er
 	return allErrs
 }
 
-func validateMonitoring(monitoring *garden.Monitoring, fldPath *field.Path) field.ErrorList {
+// ValidateMonitoring validates the given monitoring configuration.
+func ValidateMonitoring(monitoring *garden.Monitoring, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if monitoring != nil && monitoring.Alerting != nil {
 		allErrs = append(allErrs, validateAlerting(monitoring.Alerting, fldPath.Child("alerting"))...)
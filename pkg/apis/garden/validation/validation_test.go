@@ -2703,6 +2703,83 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should allow built-in and extension roles for project members", func() {
+			project.Spec.ProjectMembers[0].Roles = []string{
+				garden.ProjectMemberUserAccessManager,
+				garden.ProjectMemberServiceAccountManager,
+				garden.ProjectMemberExtensionPrefix + "foo",
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid project members with missing, unknown or duplicate roles", func() {
+			project.Spec.ProjectMembers[0].Role = ""
+			project.Spec.ProjectMembers[1].Roles = []string{
+				"unknown",
+				garden.ProjectMemberViewer,
+				garden.ProjectMemberExtensionPrefix + "Invalid_Name",
+				garden.ProjectMemberExtensionPrefix + "this-name-is-way-too-long",
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.members[0].role"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.members[1].roles[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.members[1].roles[1]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.members[1].roles[2]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeTooLong),
+				"Field": Equal("spec.members[1].roles[3]"),
+			}))))
+		})
+
+		It("should allow valid project service accounts", func() {
+			project.Spec.ServiceAccounts = []garden.ProjectServiceAccount{
+				{Name: "ci", Role: garden.ProjectMemberAdmin},
+				{Name: "robot", Role: garden.ProjectMemberViewer, Roles: []string{garden.ProjectMemberServiceAccountManager}},
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid project service accounts", func() {
+			project.Spec.ServiceAccounts = []garden.ProjectServiceAccount{
+				{Name: "", Role: garden.ProjectMemberAdmin},
+				{Name: "Invalid_Name", Role: garden.ProjectMemberAdmin},
+				{Name: "ci", Role: "unknown"},
+				{Name: "ci", Role: garden.ProjectMemberViewer},
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.serviceAccounts[0].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.serviceAccounts[1].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.serviceAccounts[2].role"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.serviceAccounts[3].name"),
+			}))))
+		})
+
 		DescribeTable("owner validation",
 			func(apiGroup, kind, name, namespace string, expectType field.ErrorType, field string) {
 				subject := rbacv1.Subject{
@@ -3015,6 +3092,60 @@ var _ = Describe("validation", func() {
 			))
 		})
 
+		It("should allow a valid excess capacity reservation policy", func() {
+			var (
+				percentage = int32(5)
+				minShoots  = int32(1)
+				maxShoots  = int32(10)
+			)
+			seed.Spec.ExcessCapacityReservation = &garden.SeedExcessCapacityReservation{
+				Resources: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+				ShootPercentage: &percentage,
+				MinShoots:       &minShoots,
+				MaxShoots:       &maxShoots,
+			}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid an invalid excess capacity reservation policy", func() {
+			var (
+				percentage = int32(101)
+				minShoots  = int32(3)
+				maxShoots  = int32(2)
+			)
+			seed.Spec.ExcessCapacityReservation = &garden.SeedExcessCapacityReservation{
+				Resources: corev1.ResourceList{
+					corev1.ResourceCPU:     resource.MustParse("-1"),
+					corev1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+				ShootPercentage: &percentage,
+				MinShoots:       &minShoots,
+				MaxShoots:       &maxShoots,
+			}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.excessCapacityReservation.resources[cpu]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.excessCapacityReservation.resources[storage]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.excessCapacityReservation.shootPercentage"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.excessCapacityReservation.maxShoots"),
+			}))
+		})
+
 		It("should forbid Seed with overlapping networks", func() {
 			shootDefaultPodCIDR := "10.0.1.128/28"     // 10.0.1.128 -> 10.0.1.13
 			shootDefaultServiceCIDR := "10.0.1.144/30" // 10.0.1.144 -> 10.0.1.17
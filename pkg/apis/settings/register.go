@@ -47,8 +47,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&ClusterOpenIDConnectPreset{},
 		&ClusterOpenIDConnectPresetList{},
+		&ClusterShootPolicy{},
+		&ClusterShootPolicyList{},
+		&ClusterShootPreset{},
+		&ClusterShootPresetList{},
 		&OpenIDConnectPreset{},
 		&OpenIDConnectPresetList{},
+		&ShootPreset{},
+		&ShootPresetList{},
 	)
 	return nil
 }
@@ -0,0 +1,79 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterShootPolicy contains constraints which are enforced cluster-wide
+// for Shoot objects when they are created or updated.
+type ClusterShootPolicy struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec is the specification of this Shoot policy.
+	Spec ClusterShootPolicySpec
+}
+
+// ClusterShootPolicySpec contains the constraints for Shoots and the
+// project selector matching Shoots in Projects.
+type ClusterShootPolicySpec struct {
+	// ProjectSelector decides whether to enforce the policy if the
+	// Shoot is in a specific Project matching the label selector.
+	// Default to the empty LabelSelector, which matches everything.
+	ProjectSelector *metav1.LabelSelector
+	// KubernetesVersionConstraint is a semantic version constraint, e.g. ">= 1.15, < 1.17",
+	// which the Kubernetes version of the Shoots must satisfy.
+	KubernetesVersionConstraint *string
+	// Regions is the list of allowed regions. All regions are allowed if it is empty.
+	Regions []string
+	// MachineTypes is the list of allowed machine types of the worker pools. All machine
+	// types are allowed if it is empty.
+	MachineTypes []string
+	// MaxWorkers is the maximum number of worker nodes of a Shoot, i.e., the sum of the
+	// maximum sizes of all its worker pools.
+	MaxWorkers *int32
+	// AllowPrivilegedContainers indicates whether Shoots may allow privileged containers.
+	// If it is false then `spec.kubernetes.allowPrivilegedContainers` must be false.
+	AllowPrivilegedContainers *bool
+	// RequiredAddons is the list of addons (kubernetes-dashboard, nginx-ingress) which
+	// must be enabled.
+	RequiredAddons []string
+	// RequiredExtensions is the list of extension types which must be configured.
+	RequiredExtensions []string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterShootPolicyList is a collection of ClusterShootPolicies.
+type ClusterShootPolicyList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of ClusterShootPolicies.
+	Items []ClusterShootPolicy
+}
+
+const (
+	// ShootPolicyAddonKubernetesDashboard is the name of the kubernetes-dashboard addon which can be required by a policy.
+	ShootPolicyAddonKubernetesDashboard = "kubernetes-dashboard"
+	// ShootPolicyAddonNginxIngress is the name of the nginx-ingress addon which can be required by a policy.
+	ShootPolicyAddonNginxIngress = "nginx-ingress"
+)
@@ -43,6 +43,31 @@ func SetDefaults_ClusterOpenIDConnectPreset(obj *ClusterOpenIDConnectPreset) {
 	setDefaultServerSpec(&obj.Spec.Server)
 }
 
+// SetDefaults_ShootPreset sets default values for ShootPreset objects.
+func SetDefaults_ShootPreset(obj *ShootPreset) {
+	if obj.Spec.ShootSelector == nil {
+		obj.Spec.ShootSelector = &metav1.LabelSelector{}
+	}
+}
+
+// SetDefaults_ClusterShootPreset sets default values for ClusterShootPreset objects.
+func SetDefaults_ClusterShootPreset(obj *ClusterShootPreset) {
+	if obj.Spec.ShootSelector == nil {
+		obj.Spec.ShootSelector = &metav1.LabelSelector{}
+	}
+
+	if obj.Spec.ProjectSelector == nil {
+		obj.Spec.ProjectSelector = &metav1.LabelSelector{}
+	}
+}
+
+// SetDefaults_ClusterShootPolicy sets default values for ClusterShootPolicy objects.
+func SetDefaults_ClusterShootPolicy(obj *ClusterShootPolicy) {
+	if obj.Spec.ProjectSelector == nil {
+		obj.Spec.ProjectSelector = &metav1.LabelSelector{}
+	}
+}
+
 func setDefaultServerSpec(spec *KubeAPIServerOpenIDConnect) {
 	if len(spec.SigningAlgs) == 0 {
 		spec.SigningAlgs = []string{DefaultSignAlg}
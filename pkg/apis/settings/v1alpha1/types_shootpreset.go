@@ -0,0 +1,90 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPreset contains default values that are applied to Shoots in a namespace
+// when they are created.
+type ShootPreset struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the specification of this Shoot preset.
+	Spec ShootPresetSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPresetList is a collection of ShootPresets.
+type ShootPresetList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is the list of ShootPresets.
+	Items []ShootPreset `json:"items"`
+}
+
+// ShootPresetSpec contains the Shoot selector for which the default values
+// of the template are applied.
+type ShootPresetSpec struct {
+	// Template contains the values which are defaulted in the specification
+	// of matching Shoots. Values which are already set on the Shoot object
+	// are never overwritten.
+	Template ShootPresetTemplate `json:"template"`
+
+	// ShootSelector decides whether to apply the template if the
+	// Shoot has matching labels.
+	// Default to the empty LabelSelector, which matches everything.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
+
+	// Weight associated with matching the corresponding preset,
+	// in the range 1-100.
+	// Required.
+	Weight int32 `json:"weight"`
+}
+
+// ShootPresetTemplate contains the parts of a Shoot specification which can be defaulted by a preset.
+type ShootPresetTemplate struct {
+	// Addons contains information about enabled/disabled addons and their configuration.
+	// +optional
+	Addons *gardencorev1alpha1.Addons `json:"addons,omitempty"`
+	// Extensions contain type and provider information for Shoot extensions. Extensions whose type is
+	// already configured for the Shoot are not added.
+	// +optional
+	Extensions []gardencorev1alpha1.Extension `json:"extensions,omitempty"`
+	// Hibernation contains information whether the Shoot is suspended or not.
+	// +optional
+	Hibernation *gardencorev1alpha1.Hibernation `json:"hibernation,omitempty"`
+	// Kubelet contains configuration settings for the kubelet.
+	// +optional
+	Kubelet *gardencorev1alpha1.KubeletConfig `json:"kubelet,omitempty"`
+	// Maintenance contains information about the time window for maintenance operations and which
+	// operations should be performed.
+	// +optional
+	Maintenance *gardencorev1alpha1.Maintenance `json:"maintenance,omitempty"`
+	// Monitoring contains information about custom monitoring configurations for the Shoot.
+	// +optional
+	Monitoring *gardencorev1alpha1.Monitoring `json:"monitoring,omitempty"`
+}
@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	unsafe "unsafe"
 
+	corev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	settings "github.com/gardener/gardener/pkg/apis/settings"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
@@ -50,6 +51,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPolicy)(nil), (*settings.ClusterShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(a.(*ClusterShootPolicy), b.(*settings.ClusterShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPolicy)(nil), (*ClusterShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(a.(*settings.ClusterShootPolicy), b.(*ClusterShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPolicyList)(nil), (*settings.ClusterShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(a.(*ClusterShootPolicyList), b.(*settings.ClusterShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPolicyList)(nil), (*ClusterShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(a.(*settings.ClusterShootPolicyList), b.(*ClusterShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPolicySpec)(nil), (*settings.ClusterShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(a.(*ClusterShootPolicySpec), b.(*settings.ClusterShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPolicySpec)(nil), (*ClusterShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(a.(*settings.ClusterShootPolicySpec), b.(*ClusterShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPreset)(nil), (*settings.ClusterShootPreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPreset_To_settings_ClusterShootPreset(a.(*ClusterShootPreset), b.(*settings.ClusterShootPreset), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPreset)(nil), (*ClusterShootPreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPreset_To_v1alpha1_ClusterShootPreset(a.(*settings.ClusterShootPreset), b.(*ClusterShootPreset), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPresetList)(nil), (*settings.ClusterShootPresetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPresetList_To_settings_ClusterShootPresetList(a.(*ClusterShootPresetList), b.(*settings.ClusterShootPresetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPresetList)(nil), (*ClusterShootPresetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPresetList_To_v1alpha1_ClusterShootPresetList(a.(*settings.ClusterShootPresetList), b.(*ClusterShootPresetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPresetSpec)(nil), (*settings.ClusterShootPresetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec(a.(*ClusterShootPresetSpec), b.(*settings.ClusterShootPresetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPresetSpec)(nil), (*ClusterShootPresetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec(a.(*settings.ClusterShootPresetSpec), b.(*ClusterShootPresetSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeAPIServerOpenIDConnect)(nil), (*settings.KubeAPIServerOpenIDConnect)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubeAPIServerOpenIDConnect_To_settings_KubeAPIServerOpenIDConnect(a.(*KubeAPIServerOpenIDConnect), b.(*settings.KubeAPIServerOpenIDConnect), scope)
 	}); err != nil {
@@ -100,6 +161,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootPreset)(nil), (*settings.ShootPreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPreset_To_settings_ShootPreset(a.(*ShootPreset), b.(*settings.ShootPreset), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPreset)(nil), (*ShootPreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPreset_To_v1alpha1_ShootPreset(a.(*settings.ShootPreset), b.(*ShootPreset), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPresetList)(nil), (*settings.ShootPresetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPresetList_To_settings_ShootPresetList(a.(*ShootPresetList), b.(*settings.ShootPresetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPresetList)(nil), (*ShootPresetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPresetList_To_v1alpha1_ShootPresetList(a.(*settings.ShootPresetList), b.(*ShootPresetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPresetSpec)(nil), (*settings.ShootPresetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(a.(*ShootPresetSpec), b.(*settings.ShootPresetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPresetSpec)(nil), (*ShootPresetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(a.(*settings.ShootPresetSpec), b.(*ShootPresetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPresetTemplate)(nil), (*settings.ShootPresetTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPresetTemplate_To_settings_ShootPresetTemplate(a.(*ShootPresetTemplate), b.(*settings.ShootPresetTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPresetTemplate)(nil), (*ShootPresetTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPresetTemplate_To_v1alpha1_ShootPresetTemplate(a.(*settings.ShootPresetTemplate), b.(*ShootPresetTemplate), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -177,6 +278,162 @@ func Convert_settings_ClusterOpenIDConnectPresetSpec_To_v1alpha1_ClusterOpenIDCo
 	return autoConvert_settings_ClusterOpenIDConnectPresetSpec_To_v1alpha1_ClusterOpenIDConnectPresetSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(in *ClusterShootPolicy, out *settings.ClusterShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(in *ClusterShootPolicy, out *settings.ClusterShootPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(in *settings.ClusterShootPolicy, out *ClusterShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy is an autogenerated conversion function.
+func Convert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(in *settings.ClusterShootPolicy, out *ClusterShootPolicy, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(in *ClusterShootPolicyList, out *settings.ClusterShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]settings.ClusterShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(in *ClusterShootPolicyList, out *settings.ClusterShootPolicyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(in *settings.ClusterShootPolicyList, out *ClusterShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList is an autogenerated conversion function.
+func Convert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(in *settings.ClusterShootPolicyList, out *ClusterShootPolicyList, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(in *ClusterShootPolicySpec, out *settings.ClusterShootPolicySpec, s conversion.Scope) error {
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.KubernetesVersionConstraint = (*string)(unsafe.Pointer(in.KubernetesVersionConstraint))
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	out.MachineTypes = *(*[]string)(unsafe.Pointer(&in.MachineTypes))
+	out.MaxWorkers = (*int32)(unsafe.Pointer(in.MaxWorkers))
+	out.AllowPrivilegedContainers = (*bool)(unsafe.Pointer(in.AllowPrivilegedContainers))
+	out.RequiredAddons = *(*[]string)(unsafe.Pointer(&in.RequiredAddons))
+	out.RequiredExtensions = *(*[]string)(unsafe.Pointer(&in.RequiredExtensions))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(in *ClusterShootPolicySpec, out *settings.ClusterShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(in *settings.ClusterShootPolicySpec, out *ClusterShootPolicySpec, s conversion.Scope) error {
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.KubernetesVersionConstraint = (*string)(unsafe.Pointer(in.KubernetesVersionConstraint))
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	out.MachineTypes = *(*[]string)(unsafe.Pointer(&in.MachineTypes))
+	out.MaxWorkers = (*int32)(unsafe.Pointer(in.MaxWorkers))
+	out.AllowPrivilegedContainers = (*bool)(unsafe.Pointer(in.AllowPrivilegedContainers))
+	out.RequiredAddons = *(*[]string)(unsafe.Pointer(&in.RequiredAddons))
+	out.RequiredExtensions = *(*[]string)(unsafe.Pointer(&in.RequiredExtensions))
+	return nil
+}
+
+// Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec is an autogenerated conversion function.
+func Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(in *settings.ClusterShootPolicySpec, out *ClusterShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPreset_To_settings_ClusterShootPreset(in *ClusterShootPreset, out *settings.ClusterShootPreset, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPreset_To_settings_ClusterShootPreset is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPreset_To_settings_ClusterShootPreset(in *ClusterShootPreset, out *settings.ClusterShootPreset, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPreset_To_settings_ClusterShootPreset(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPreset_To_v1alpha1_ClusterShootPreset(in *settings.ClusterShootPreset, out *ClusterShootPreset, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ClusterShootPreset_To_v1alpha1_ClusterShootPreset is an autogenerated conversion function.
+func Convert_settings_ClusterShootPreset_To_v1alpha1_ClusterShootPreset(in *settings.ClusterShootPreset, out *ClusterShootPreset, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPreset_To_v1alpha1_ClusterShootPreset(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPresetList_To_settings_ClusterShootPresetList(in *ClusterShootPresetList, out *settings.ClusterShootPresetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]settings.ClusterShootPreset)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPresetList_To_settings_ClusterShootPresetList is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPresetList_To_settings_ClusterShootPresetList(in *ClusterShootPresetList, out *settings.ClusterShootPresetList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPresetList_To_settings_ClusterShootPresetList(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPresetList_To_v1alpha1_ClusterShootPresetList(in *settings.ClusterShootPresetList, out *ClusterShootPresetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterShootPreset)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_settings_ClusterShootPresetList_To_v1alpha1_ClusterShootPresetList is an autogenerated conversion function.
+func Convert_settings_ClusterShootPresetList_To_v1alpha1_ClusterShootPresetList(in *settings.ClusterShootPresetList, out *ClusterShootPresetList, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPresetList_To_v1alpha1_ClusterShootPresetList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec(in *ClusterShootPresetSpec, out *settings.ClusterShootPresetSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(&in.ShootPresetSpec, &out.ShootPresetSpec, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec(in *ClusterShootPresetSpec, out *settings.ClusterShootPresetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec(in *settings.ClusterShootPresetSpec, out *ClusterShootPresetSpec, s conversion.Scope) error {
+	if err := Convert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(&in.ShootPresetSpec, &out.ShootPresetSpec, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec is an autogenerated conversion function.
+func Convert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec(in *settings.ClusterShootPresetSpec, out *ClusterShootPresetSpec, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubeAPIServerOpenIDConnect_To_settings_KubeAPIServerOpenIDConnect(in *KubeAPIServerOpenIDConnect, out *settings.KubeAPIServerOpenIDConnect, s conversion.Scope) error {
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	out.ClientID = in.ClientID
@@ -312,3 +569,109 @@ func autoConvert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPrese
 func Convert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPresetSpec(in *settings.OpenIDConnectPresetSpec, out *OpenIDConnectPresetSpec, s conversion.Scope) error {
 	return autoConvert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPresetSpec(in, out, s)
 }
+
+func autoConvert_v1alpha1_ShootPreset_To_settings_ShootPreset(in *ShootPreset, out *settings.ShootPreset, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootPreset_To_settings_ShootPreset is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPreset_To_settings_ShootPreset(in *ShootPreset, out *settings.ShootPreset, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPreset_To_settings_ShootPreset(in, out, s)
+}
+
+func autoConvert_settings_ShootPreset_To_v1alpha1_ShootPreset(in *settings.ShootPreset, out *ShootPreset, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ShootPreset_To_v1alpha1_ShootPreset is an autogenerated conversion function.
+func Convert_settings_ShootPreset_To_v1alpha1_ShootPreset(in *settings.ShootPreset, out *ShootPreset, s conversion.Scope) error {
+	return autoConvert_settings_ShootPreset_To_v1alpha1_ShootPreset(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPresetList_To_settings_ShootPresetList(in *ShootPresetList, out *settings.ShootPresetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]settings.ShootPreset)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPresetList_To_settings_ShootPresetList is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPresetList_To_settings_ShootPresetList(in *ShootPresetList, out *settings.ShootPresetList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPresetList_To_settings_ShootPresetList(in, out, s)
+}
+
+func autoConvert_settings_ShootPresetList_To_v1alpha1_ShootPresetList(in *settings.ShootPresetList, out *ShootPresetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ShootPreset)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_settings_ShootPresetList_To_v1alpha1_ShootPresetList is an autogenerated conversion function.
+func Convert_settings_ShootPresetList_To_v1alpha1_ShootPresetList(in *settings.ShootPresetList, out *ShootPresetList, s conversion.Scope) error {
+	return autoConvert_settings_ShootPresetList_To_v1alpha1_ShootPresetList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(in *ShootPresetSpec, out *settings.ShootPresetSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ShootPresetTemplate_To_settings_ShootPresetTemplate(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(in *ShootPresetSpec, out *settings.ShootPresetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(in, out, s)
+}
+
+func autoConvert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(in *settings.ShootPresetSpec, out *ShootPresetSpec, s conversion.Scope) error {
+	if err := Convert_settings_ShootPresetTemplate_To_v1alpha1_ShootPresetTemplate(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec is an autogenerated conversion function.
+func Convert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(in *settings.ShootPresetSpec, out *ShootPresetSpec, s conversion.Scope) error {
+	return autoConvert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPresetTemplate_To_settings_ShootPresetTemplate(in *ShootPresetTemplate, out *settings.ShootPresetTemplate, s conversion.Scope) error {
+	out.Addons = (*corev1alpha1.Addons)(unsafe.Pointer(in.Addons))
+	out.Extensions = *(*[]corev1alpha1.Extension)(unsafe.Pointer(&in.Extensions))
+	out.Hibernation = (*corev1alpha1.Hibernation)(unsafe.Pointer(in.Hibernation))
+	out.Kubelet = (*corev1alpha1.KubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.Maintenance = (*corev1alpha1.Maintenance)(unsafe.Pointer(in.Maintenance))
+	out.Monitoring = (*corev1alpha1.Monitoring)(unsafe.Pointer(in.Monitoring))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPresetTemplate_To_settings_ShootPresetTemplate is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPresetTemplate_To_settings_ShootPresetTemplate(in *ShootPresetTemplate, out *settings.ShootPresetTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPresetTemplate_To_settings_ShootPresetTemplate(in, out, s)
+}
+
+func autoConvert_settings_ShootPresetTemplate_To_v1alpha1_ShootPresetTemplate(in *settings.ShootPresetTemplate, out *ShootPresetTemplate, s conversion.Scope) error {
+	out.Addons = (*corev1alpha1.Addons)(unsafe.Pointer(in.Addons))
+	out.Extensions = *(*[]corev1alpha1.Extension)(unsafe.Pointer(&in.Extensions))
+	out.Hibernation = (*corev1alpha1.Hibernation)(unsafe.Pointer(in.Hibernation))
+	out.Kubelet = (*corev1alpha1.KubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.Maintenance = (*corev1alpha1.Maintenance)(unsafe.Pointer(in.Maintenance))
+	out.Monitoring = (*corev1alpha1.Monitoring)(unsafe.Pointer(in.Monitoring))
+	return nil
+}
+
+// Convert_settings_ShootPresetTemplate_To_v1alpha1_ShootPresetTemplate is an autogenerated conversion function.
+func Convert_settings_ShootPresetTemplate_To_v1alpha1_ShootPresetTemplate(in *settings.ShootPresetTemplate, out *ShootPresetTemplate, s conversion.Scope) error {
+	return autoConvert_settings_ShootPresetTemplate_To_v1alpha1_ShootPresetTemplate(in, out, s)
+}
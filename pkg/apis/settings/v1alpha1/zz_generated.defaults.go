@@ -16,8 +16,14 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&ClusterOpenIDConnectPresetList{}, func(obj interface{}) {
 		SetObjectDefaults_ClusterOpenIDConnectPresetList(obj.(*ClusterOpenIDConnectPresetList))
 	})
+	scheme.AddTypeDefaultingFunc(&ClusterShootPolicy{}, func(obj interface{}) { SetObjectDefaults_ClusterShootPolicy(obj.(*ClusterShootPolicy)) })
+	scheme.AddTypeDefaultingFunc(&ClusterShootPolicyList{}, func(obj interface{}) { SetObjectDefaults_ClusterShootPolicyList(obj.(*ClusterShootPolicyList)) })
+	scheme.AddTypeDefaultingFunc(&ClusterShootPreset{}, func(obj interface{}) { SetObjectDefaults_ClusterShootPreset(obj.(*ClusterShootPreset)) })
+	scheme.AddTypeDefaultingFunc(&ClusterShootPresetList{}, func(obj interface{}) { SetObjectDefaults_ClusterShootPresetList(obj.(*ClusterShootPresetList)) })
 	scheme.AddTypeDefaultingFunc(&OpenIDConnectPreset{}, func(obj interface{}) { SetObjectDefaults_OpenIDConnectPreset(obj.(*OpenIDConnectPreset)) })
 	scheme.AddTypeDefaultingFunc(&OpenIDConnectPresetList{}, func(obj interface{}) { SetObjectDefaults_OpenIDConnectPresetList(obj.(*OpenIDConnectPresetList)) })
+	scheme.AddTypeDefaultingFunc(&ShootPreset{}, func(obj interface{}) { SetObjectDefaults_ShootPreset(obj.(*ShootPreset)) })
+	scheme.AddTypeDefaultingFunc(&ShootPresetList{}, func(obj interface{}) { SetObjectDefaults_ShootPresetList(obj.(*ShootPresetList)) })
 	return nil
 }
 
@@ -32,6 +38,28 @@ func SetObjectDefaults_ClusterOpenIDConnectPresetList(in *ClusterOpenIDConnectPr
 	}
 }
 
+func SetObjectDefaults_ClusterShootPolicy(in *ClusterShootPolicy) {
+	SetDefaults_ClusterShootPolicy(in)
+}
+
+func SetObjectDefaults_ClusterShootPolicyList(in *ClusterShootPolicyList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ClusterShootPolicy(a)
+	}
+}
+
+func SetObjectDefaults_ClusterShootPreset(in *ClusterShootPreset) {
+	SetDefaults_ClusterShootPreset(in)
+}
+
+func SetObjectDefaults_ClusterShootPresetList(in *ClusterShootPresetList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ClusterShootPreset(a)
+	}
+}
+
 func SetObjectDefaults_OpenIDConnectPreset(in *OpenIDConnectPreset) {
 	SetDefaults_OpenIDConnectPreset(in)
 }
@@ -42,3 +70,14 @@ func SetObjectDefaults_OpenIDConnectPresetList(in *OpenIDConnectPresetList) {
 		SetObjectDefaults_OpenIDConnectPreset(a)
 	}
 }
+
+func SetObjectDefaults_ShootPreset(in *ShootPreset) {
+	SetDefaults_ShootPreset(in)
+}
+
+func SetObjectDefaults_ShootPresetList(in *ShootPresetList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ShootPreset(a)
+	}
+}
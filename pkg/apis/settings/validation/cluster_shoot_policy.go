@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"github.com/gardener/gardener/pkg/apis/settings"
+
+	"github.com/Masterminds/semver"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var availableShootPolicyAddons = sets.NewString(
+	settings.ShootPolicyAddonKubernetesDashboard,
+	settings.ShootPolicyAddonNginxIngress,
+)
+
+// ValidateClusterShootPolicy validates a ClusterShootPolicy object.
+func ValidateClusterShootPolicy(policy *settings.ClusterShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&policy.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateClusterShootPolicySpec(&policy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateClusterShootPolicyUpdate validates a ClusterShootPolicy object before an update.
+func ValidateClusterShootPolicyUpdate(new, old *settings.ClusterShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateClusterShootPolicySpec(&new.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateClusterShootPolicySpec(spec *settings.ClusterShootPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ProjectSelector, fldPath.Child("projectSelector"))...)
+
+	if constraint := spec.KubernetesVersionConstraint; constraint != nil {
+		if _, err := semver.NewConstraint(*constraint); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("kubernetesVersionConstraint"), *constraint, err.Error()))
+		}
+	}
+
+	allErrs = append(allErrs, validateUniqueNonEmptyStrings(spec.Regions, fldPath.Child("regions"))...)
+	allErrs = append(allErrs, validateUniqueNonEmptyStrings(spec.MachineTypes, fldPath.Child("machineTypes"))...)
+
+	if spec.MaxWorkers != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*spec.MaxWorkers), fldPath.Child("maxWorkers"))...)
+	}
+
+	allErrs = append(allErrs, validateUniqueNonEmptyStrings(spec.RequiredAddons, fldPath.Child("requiredAddons"))...)
+	for i, addon := range spec.RequiredAddons {
+		if len(addon) > 0 && !availableShootPolicyAddons.Has(addon) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("requiredAddons").Index(i), addon, availableShootPolicyAddons.List()))
+		}
+	}
+
+	allErrs = append(allErrs, validateUniqueNonEmptyStrings(spec.RequiredExtensions, fldPath.Child("requiredExtensions"))...)
+
+	return allErrs
+}
+
+func validateUniqueNonEmptyStrings(values []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.NewString()
+	for i, value := range values {
+		if len(value) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), "must not be empty"))
+			continue
+		}
+		if seen.Has(value) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), value))
+		}
+		seen.Insert(value)
+	}
+
+	return allErrs
+}
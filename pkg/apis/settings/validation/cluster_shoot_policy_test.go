@@ -0,0 +1,128 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/settings"
+	settings_validation "github.com/gardener/gardener/pkg/apis/settings/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("ClusterShootPolicy", func() {
+
+	var policy *settings.ClusterShootPolicy
+
+	BeforeEach(func() {
+		policy = &settings.ClusterShootPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: settings.ClusterShootPolicySpec{
+				ProjectSelector: &metav1.LabelSelector{},
+			},
+		}
+	})
+
+	Describe("#ValidateClusterShootPolicy", func() {
+		It("should allow a policy without constraints", func() {
+			Expect(settings_validation.ValidateClusterShootPolicy(policy)).To(BeEmpty())
+		})
+
+		It("should allow a valid policy", func() {
+			constraint, maxWorkers, allowPrivilegedContainers := ">= 1.14, < 1.16", int32(10), false
+			policy.Spec = settings.ClusterShootPolicySpec{
+				ProjectSelector:             &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "production"}},
+				KubernetesVersionConstraint: &constraint,
+				Regions:                     []string{"eu-west-1", "eu-central-1"},
+				MachineTypes:                []string{"m5.large"},
+				MaxWorkers:                  &maxWorkers,
+				AllowPrivilegedContainers:   &allowPrivilegedContainers,
+				RequiredAddons:              []string{settings.ShootPolicyAddonNginxIngress},
+				RequiredExtensions:          []string{"foo"},
+			}
+
+			Expect(settings_validation.ValidateClusterShootPolicy(policy)).To(BeEmpty())
+		})
+
+		It("should forbid a namespaced policy without name", func() {
+			policy.ObjectMeta = metav1.ObjectMeta{Namespace: "garden-dev"}
+
+			errorList := settings_validation.ValidateClusterShootPolicy(policy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("metadata.namespace"),
+			}))))
+		})
+
+		It("should forbid invalid constraints", func() {
+			constraint, maxWorkers := "foo", int32(-1)
+			policy.Spec = settings.ClusterShootPolicySpec{
+				KubernetesVersionConstraint: &constraint,
+				Regions:                     []string{"eu-west-1", "eu-west-1"},
+				MachineTypes:                []string{""},
+				MaxWorkers:                  &maxWorkers,
+				RequiredAddons:              []string{"heapster"},
+				RequiredExtensions:          []string{"foo", "foo"},
+			}
+
+			errorList := settings_validation.ValidateClusterShootPolicy(policy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetesVersionConstraint"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.regions[1]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.machineTypes[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.maxWorkers"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.requiredAddons[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.requiredExtensions[1]"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateClusterShootPolicyUpdate", func() {
+		It("should forbid invalid constraints on update", func() {
+			newPolicy := policy.DeepCopy()
+			newPolicy.ResourceVersion = "1"
+			policy.ResourceVersion = "1"
+			newPolicy.Spec.Regions = []string{""}
+
+			errorList := settings_validation.ValidateClusterShootPolicyUpdate(newPolicy, policy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.regions[0]"),
+			}))))
+		})
+	})
+})
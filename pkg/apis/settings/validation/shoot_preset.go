@@ -0,0 +1,120 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenvalidation "github.com/gardener/gardener/pkg/apis/garden/validation"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	"github.com/gardener/gardener/pkg/utils"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateShootPreset validates a ShootPreset object.
+func ValidateShootPreset(preset *settings.ShootPreset) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&preset.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPresetSpec(&preset.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootPresetUpdate validates a ShootPreset object before an update.
+func ValidateShootPresetUpdate(new, old *settings.ShootPreset) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPresetSpec(&new.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateShootPresetSpec(spec *settings.ShootPresetSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ShootSelector, fldPath.Child("shootSelector"))...)
+	if spec.Weight <= 0 || spec.Weight > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("weight"), spec.Weight, "must be in the range 1-100"))
+	}
+	allErrs = append(allErrs, validateShootPresetTemplate(&spec.Template, fldPath.Child("template"))...)
+
+	return allErrs
+}
+
+// validateShootPresetTemplate validates the template by converting its parts into the internal garden
+// representation, so that the same rules apply as for the Shoot object itself.
+func validateShootPresetTemplate(template *settings.ShootPresetTemplate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if template.Extensions != nil {
+		extensions := make([]garden.Extension, len(template.Extensions))
+		for i := range template.Extensions {
+			if err := gardencorev1alpha1.Convert_v1alpha1_Extension_To_garden_Extension(&template.Extensions[i], &extensions[i], nil); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("extensions").Index(i), template.Extensions[i], err.Error()))
+			}
+		}
+		allErrs = append(allErrs, gardenvalidation.ValidateExtensions(extensions, fldPath.Child("extensions"))...)
+
+		types := sets.NewString()
+		for i, extension := range template.Extensions {
+			if types.Has(extension.Type) {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Child("extensions").Index(i).Child("type"), extension.Type))
+			}
+			types.Insert(extension.Type)
+		}
+	}
+
+	if template.Hibernation != nil {
+		hibernation := &garden.Hibernation{}
+		if err := gardencorev1alpha1.Convert_v1alpha1_Hibernation_To_garden_Hibernation(template.Hibernation, hibernation, nil); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("hibernation"), template.Hibernation, err.Error()))
+		} else {
+			allErrs = append(allErrs, gardenvalidation.ValidateHibernation(hibernation, fldPath.Child("hibernation"))...)
+		}
+	}
+
+	if template.Kubelet != nil {
+		kubelet := &garden.KubeletConfig{}
+		if err := gardencorev1alpha1.Convert_v1alpha1_KubeletConfig_To_garden_KubeletConfig(template.Kubelet, kubelet, nil); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("kubelet"), template.Kubelet, err.Error()))
+		} else {
+			allErrs = append(allErrs, gardenvalidation.ValidateKubeletConfig(*kubelet, fldPath.Child("kubelet"))...)
+		}
+	}
+
+	if template.Maintenance != nil && template.Maintenance.TimeWindow != nil {
+		timeWindow := template.Maintenance.TimeWindow
+		if _, err := utils.ParseMaintenanceTimeWindow(timeWindow.Begin, timeWindow.End); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maintenance", "timeWindow", "begin/end"), timeWindow, err.Error()))
+		}
+	}
+
+	if template.Monitoring != nil {
+		monitoring := &garden.Monitoring{}
+		if err := gardencorev1alpha1.Convert_v1alpha1_Monitoring_To_garden_Monitoring(template.Monitoring, monitoring, nil); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("monitoring"), template.Monitoring, err.Error()))
+		} else {
+			allErrs = append(allErrs, gardenvalidation.ValidateMonitoring(monitoring, fldPath.Child("monitoring"))...)
+		}
+	}
+
+	return allErrs
+}
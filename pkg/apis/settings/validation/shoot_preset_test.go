@@ -0,0 +1,198 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	settings_validation "github.com/gardener/gardener/pkg/apis/settings/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("ShootPreset", func() {
+
+	var preset *settings.ShootPreset
+
+	BeforeEach(func() {
+		preset = &settings.ShootPreset{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "test-namespace",
+			},
+			Spec: settings.ShootPresetSpec{
+				Weight: 1,
+			},
+		}
+	})
+
+	Describe("#ValidateShootPreset", func() {
+		It("should allow a preset with an empty template", func() {
+			Expect(settings_validation.ValidateShootPreset(preset)).To(BeEmpty())
+		})
+
+		It("should allow a valid template", func() {
+			start, location := "0 20 * * *", "Europe/Berlin"
+			preset.Spec.Template = settings.ShootPresetTemplate{
+				Extensions: []gardencorev1alpha1.Extension{{Type: "foo"}},
+				Hibernation: &gardencorev1alpha1.Hibernation{
+					Schedules: []gardencorev1alpha1.HibernationSchedule{{Start: &start, Location: &location}},
+				},
+				Maintenance: &gardencorev1alpha1.Maintenance{
+					TimeWindow: &gardencorev1alpha1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+				},
+				Monitoring: &gardencorev1alpha1.Monitoring{
+					Alerting: &gardencorev1alpha1.Alerting{EmailReceivers: []string{"ops@example.com"}},
+				},
+			}
+
+			Expect(settings_validation.ValidateShootPreset(preset)).To(BeEmpty())
+		})
+
+		It("should forbid empty object", func() {
+			preset.ObjectMeta.Name = ""
+			preset.Spec = settings.ShootPresetSpec{}
+
+			errorList := settings_validation.ValidateShootPreset(preset)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.weight"),
+			})),
+			))
+		})
+
+		It("should forbid an invalid shoot selector", func() {
+			preset.Spec.ShootSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: metav1.LabelSelectorOpExists, Values: []string{"bar"}}},
+			}
+
+			errorList := settings_validation.ValidateShootPreset(preset)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.shootSelector.matchExpressions[0].values"),
+			})),
+			))
+		})
+
+		It("should forbid an invalid template", func() {
+			start := "foo"
+			preset.Spec.Template = settings.ShootPresetTemplate{
+				Extensions: []gardencorev1alpha1.Extension{{Type: "foo"}, {Type: "foo"}, {}},
+				Hibernation: &gardencorev1alpha1.Hibernation{
+					Schedules: []gardencorev1alpha1.HibernationSchedule{{Start: &start}},
+				},
+				Maintenance: &gardencorev1alpha1.Maintenance{
+					TimeWindow: &gardencorev1alpha1.MaintenanceTimeWindow{Begin: "foo", End: "bar"},
+				},
+				Monitoring: &gardencorev1alpha1.Monitoring{
+					Alerting: &gardencorev1alpha1.Alerting{EmailReceivers: []string{"not-an-email"}},
+				},
+			}
+
+			errorList := settings_validation.ValidateShootPreset(preset)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.template.extensions[2].type"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.template.extensions[1].type"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.template.hibernation.schedules[0].start"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.template.maintenance.timeWindow.begin/end"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.template.monitoring.alerting.emailReceivers[0]"),
+			})),
+			))
+		})
+	})
+
+	Describe("#ValidateShootPresetUpdate", func() {
+		It("should forbid update with mutation of objectmeta fields", func() {
+			old := preset.DeepCopy()
+			old.ObjectMeta.ResourceVersion = "2"
+
+			preset.ObjectMeta.Name = "changed-name"
+
+			errorList := settings_validation.ValidateShootPresetUpdate(preset, old)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("metadata.name"),
+				"Detail": Equal("field is immutable"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("metadata.resourceVersion"),
+				"Detail": Equal("must be specified for an update"),
+			})),
+			))
+		})
+	})
+})
+
+var _ = Describe("ClusterShootPreset", func() {
+
+	var preset *settings.ClusterShootPreset
+
+	BeforeEach(func() {
+		preset = &settings.ClusterShootPreset{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: settings.ClusterShootPresetSpec{
+				ShootPresetSpec: settings.ShootPresetSpec{
+					Weight: 1,
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateClusterShootPreset", func() {
+		It("should allow a valid preset", func() {
+			Expect(settings_validation.ValidateClusterShootPreset(preset)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid project selector and weight", func() {
+			preset.Spec.Weight = 101
+			preset.Spec.ProjectSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: metav1.LabelSelectorOpExists, Values: []string{"bar"}}},
+			}
+
+			errorList := settings_validation.ValidateClusterShootPreset(preset)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.projectSelector.matchExpressions[0].values"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.weight"),
+			})),
+			))
+		})
+	})
+})
@@ -20,10 +20,12 @@ import (
 	settingsrest "github.com/gardener/gardener/pkg/registry/settings/rest"
 
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/kubernetes"
 )
 
 type ExtraConfig struct {
-	// Place you custom config here.
+	// KubeClient is a client for the Kubernetes API server hosting the Gardener resources.
+	KubeClient kubernetes.Interface
 }
 
 type Config struct {
@@ -65,8 +67,8 @@ func (c completedConfig) New() (*GardenerServer, error) {
 	var (
 		s = &GardenerServer{GenericAPIServer: genericServer}
 
-		coreAPIGroupInfo     = (corerest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
-		gardenAPIGroupInfo   = (gardenrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
+		coreAPIGroupInfo     = (corerest.StorageProvider{KubeClient: c.ExtraConfig.KubeClient}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
+		gardenAPIGroupInfo   = (gardenrest.StorageProvider{KubeClient: c.ExtraConfig.KubeClient}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		settingsAPIGroupInfo = (settingsrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 	)
 
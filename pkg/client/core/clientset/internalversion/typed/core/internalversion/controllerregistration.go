@@ -23,6 +23,7 @@ type ControllerRegistrationsGetter interface {
 type ControllerRegistrationInterface interface {
 	Create(*core.ControllerRegistration) (*core.ControllerRegistration, error)
 	Update(*core.ControllerRegistration) (*core.ControllerRegistration, error)
+	UpdateStatus(*core.ControllerRegistration) (*core.ControllerRegistration, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*core.ControllerRegistration, error)
@@ -109,6 +110,21 @@ func (c *controllerRegistrations) Update(controllerRegistration *core.Controller
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *controllerRegistrations) UpdateStatus(controllerRegistration *core.ControllerRegistration) (result *core.ControllerRegistration, err error) {
+	result = &core.ControllerRegistration{}
+	err = c.client.Put().
+		Resource("controllerregistrations").
+		Name(controllerRegistration.Name).
+		SubResource("status").
+		Body(controllerRegistration).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the controllerRegistration and deletes it. Returns an error if one occurs.
 func (c *controllerRegistrations) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -30,6 +30,8 @@ type ShootInterface interface {
 	List(opts v1.ListOptions) (*garden.ShootList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
 	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *garden.Shoot, err error)
+	CreateAdminKubeconfigRequest(shootName string, adminKubeconfigRequest *garden.AdminKubeconfigRequest) (*garden.AdminKubeconfigRequest, error)
+
 	ShootExpansion
 }
 
@@ -173,3 +175,17 @@ func (c *shoots) Patch(name string, pt types.PatchType, data []byte, subresource
 		Into(result)
 	return
 }
+
+// CreateAdminKubeconfigRequest takes the representation of a adminKubeconfigRequest and creates it.  Returns the server's representation of the adminKubeconfigRequest, and an error, if there is any.
+func (c *shoots) CreateAdminKubeconfigRequest(shootName string, adminKubeconfigRequest *garden.AdminKubeconfigRequest) (result *garden.AdminKubeconfigRequest, err error) {
+	result = &garden.AdminKubeconfigRequest{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("shoots").
+		Name(shootName).
+		SubResource("adminkubeconfig").
+		Body(adminKubeconfigRequest).
+		Do().
+		Into(result)
+	return
+}
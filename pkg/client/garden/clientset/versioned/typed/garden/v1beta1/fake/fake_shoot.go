@@ -122,3 +122,14 @@ func (c *FakeShoots) Patch(name string, pt types.PatchType, data []byte, subreso
 	}
 	return obj.(*v1beta1.Shoot), err
 }
+
+// CreateAdminKubeconfigRequest takes the representation of a adminKubeconfigRequest and creates it.  Returns the server's representation of the adminKubeconfigRequest, and an error, if there is any.
+func (c *FakeShoots) CreateAdminKubeconfigRequest(shootName string, adminKubeconfigRequest *v1beta1.AdminKubeconfigRequest) (result *v1beta1.AdminKubeconfigRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(shootsResource, shootName, "adminkubeconfig", c.ns, adminKubeconfigRequest), &v1beta1.AdminKubeconfigRequest{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AdminKubeconfigRequest), err
+}
@@ -30,6 +30,8 @@ type ShootInterface interface {
 	List(opts v1.ListOptions) (*v1beta1.ShootList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
 	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.Shoot, err error)
+	CreateAdminKubeconfigRequest(shootName string, adminKubeconfigRequest *v1beta1.AdminKubeconfigRequest) (*v1beta1.AdminKubeconfigRequest, error)
+
 	ShootExpansion
 }
 
@@ -173,3 +175,17 @@ func (c *shoots) Patch(name string, pt types.PatchType, data []byte, subresource
 		Into(result)
 	return
 }
+
+// CreateAdminKubeconfigRequest takes the representation of a adminKubeconfigRequest and creates it.  Returns the server's representation of the adminKubeconfigRequest, and an error, if there is any.
+func (c *shoots) CreateAdminKubeconfigRequest(shootName string, adminKubeconfigRequest *v1beta1.AdminKubeconfigRequest) (result *v1beta1.AdminKubeconfigRequest, err error) {
+	result = &v1beta1.AdminKubeconfigRequest{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("shoots").
+		Name(shootName).
+		SubResource("adminkubeconfig").
+		Body(adminKubeconfigRequest).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,148 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	scheme "github.com/gardener/gardener/pkg/client/settings/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterShootPoliciesGetter has a method to return a ClusterShootPolicyInterface.
+// A group's client should implement this interface.
+type ClusterShootPoliciesGetter interface {
+	ClusterShootPolicies() ClusterShootPolicyInterface
+}
+
+// ClusterShootPolicyInterface has methods to work with ClusterShootPolicy resources.
+type ClusterShootPolicyInterface interface {
+	Create(*v1alpha1.ClusterShootPolicy) (*v1alpha1.ClusterShootPolicy, error)
+	Update(*v1alpha1.ClusterShootPolicy) (*v1alpha1.ClusterShootPolicy, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.ClusterShootPolicy, error)
+	List(opts v1.ListOptions) (*v1alpha1.ClusterShootPolicyList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ClusterShootPolicy, err error)
+	ClusterShootPolicyExpansion
+}
+
+// clusterShootPolicies implements ClusterShootPolicyInterface
+type clusterShootPolicies struct {
+	client rest.Interface
+}
+
+// newClusterShootPolicies returns a ClusterShootPolicies
+func newClusterShootPolicies(c *SettingsV1alpha1Client) *clusterShootPolicies {
+	return &clusterShootPolicies{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterShootPolicy, and returns the corresponding clusterShootPolicy object, and an error if there is any.
+func (c *clusterShootPolicies) Get(name string, options v1.GetOptions) (result *v1alpha1.ClusterShootPolicy, err error) {
+	result = &v1alpha1.ClusterShootPolicy{}
+	err = c.client.Get().
+		Resource("clustershootpolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterShootPolicies that match those selectors.
+func (c *clusterShootPolicies) List(opts v1.ListOptions) (result *v1alpha1.ClusterShootPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ClusterShootPolicyList{}
+	err = c.client.Get().
+		Resource("clustershootpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterShootPolicies.
+func (c *clusterShootPolicies) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clustershootpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a clusterShootPolicy and creates it.  Returns the server's representation of the clusterShootPolicy, and an error, if there is any.
+func (c *clusterShootPolicies) Create(clusterShootPolicy *v1alpha1.ClusterShootPolicy) (result *v1alpha1.ClusterShootPolicy, err error) {
+	result = &v1alpha1.ClusterShootPolicy{}
+	err = c.client.Post().
+		Resource("clustershootpolicies").
+		Body(clusterShootPolicy).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterShootPolicy and updates it. Returns the server's representation of the clusterShootPolicy, and an error, if there is any.
+func (c *clusterShootPolicies) Update(clusterShootPolicy *v1alpha1.ClusterShootPolicy) (result *v1alpha1.ClusterShootPolicy, err error) {
+	result = &v1alpha1.ClusterShootPolicy{}
+	err = c.client.Put().
+		Resource("clustershootpolicies").
+		Name(clusterShootPolicy.Name).
+		Body(clusterShootPolicy).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterShootPolicy and deletes it. Returns an error if one occurs.
+func (c *clusterShootPolicies) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clustershootpolicies").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterShootPolicies) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("clustershootpolicies").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterShootPolicy.
+func (c *clusterShootPolicies) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ClusterShootPolicy, err error) {
+	result = &v1alpha1.ClusterShootPolicy{}
+	err = c.client.Patch(pt).
+		Resource("clustershootpolicies").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,148 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	scheme "github.com/gardener/gardener/pkg/client/settings/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterShootPresetsGetter has a method to return a ClusterShootPresetInterface.
+// A group's client should implement this interface.
+type ClusterShootPresetsGetter interface {
+	ClusterShootPresets() ClusterShootPresetInterface
+}
+
+// ClusterShootPresetInterface has methods to work with ClusterShootPreset resources.
+type ClusterShootPresetInterface interface {
+	Create(*v1alpha1.ClusterShootPreset) (*v1alpha1.ClusterShootPreset, error)
+	Update(*v1alpha1.ClusterShootPreset) (*v1alpha1.ClusterShootPreset, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.ClusterShootPreset, error)
+	List(opts v1.ListOptions) (*v1alpha1.ClusterShootPresetList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ClusterShootPreset, err error)
+	ClusterShootPresetExpansion
+}
+
+// clusterShootPresets implements ClusterShootPresetInterface
+type clusterShootPresets struct {
+	client rest.Interface
+}
+
+// newClusterShootPresets returns a ClusterShootPresets
+func newClusterShootPresets(c *SettingsV1alpha1Client) *clusterShootPresets {
+	return &clusterShootPresets{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterShootPreset, and returns the corresponding clusterShootPreset object, and an error if there is any.
+func (c *clusterShootPresets) Get(name string, options v1.GetOptions) (result *v1alpha1.ClusterShootPreset, err error) {
+	result = &v1alpha1.ClusterShootPreset{}
+	err = c.client.Get().
+		Resource("clustershootpresets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterShootPresets that match those selectors.
+func (c *clusterShootPresets) List(opts v1.ListOptions) (result *v1alpha1.ClusterShootPresetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ClusterShootPresetList{}
+	err = c.client.Get().
+		Resource("clustershootpresets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterShootPresets.
+func (c *clusterShootPresets) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clustershootpresets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a clusterShootPreset and creates it.  Returns the server's representation of the clusterShootPreset, and an error, if there is any.
+func (c *clusterShootPresets) Create(clusterShootPreset *v1alpha1.ClusterShootPreset) (result *v1alpha1.ClusterShootPreset, err error) {
+	result = &v1alpha1.ClusterShootPreset{}
+	err = c.client.Post().
+		Resource("clustershootpresets").
+		Body(clusterShootPreset).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterShootPreset and updates it. Returns the server's representation of the clusterShootPreset, and an error, if there is any.
+func (c *clusterShootPresets) Update(clusterShootPreset *v1alpha1.ClusterShootPreset) (result *v1alpha1.ClusterShootPreset, err error) {
+	result = &v1alpha1.ClusterShootPreset{}
+	err = c.client.Put().
+		Resource("clustershootpresets").
+		Name(clusterShootPreset.Name).
+		Body(clusterShootPreset).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterShootPreset and deletes it. Returns an error if one occurs.
+func (c *clusterShootPresets) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clustershootpresets").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterShootPresets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("clustershootpresets").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterShootPreset.
+func (c *clusterShootPresets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ClusterShootPreset, err error) {
+	result = &v1alpha1.ClusterShootPreset{}
+	err = c.client.Patch(pt).
+		Resource("clustershootpresets").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
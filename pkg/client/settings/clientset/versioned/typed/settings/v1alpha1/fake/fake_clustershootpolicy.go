@@ -0,0 +1,104 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeClusterShootPolicies implements ClusterShootPolicyInterface
+type FakeClusterShootPolicies struct {
+	Fake *FakeSettingsV1alpha1
+}
+
+var clustershootpoliciesResource = schema.GroupVersionResource{Group: "settings.gardener.cloud", Version: "v1alpha1", Resource: "clustershootpolicies"}
+
+var clustershootpoliciesKind = schema.GroupVersionKind{Group: "settings.gardener.cloud", Version: "v1alpha1", Kind: "ClusterShootPolicy"}
+
+// Get takes name of the clusterShootPolicy, and returns the corresponding clusterShootPolicy object, and an error if there is any.
+func (c *FakeClusterShootPolicies) Get(name string, options v1.GetOptions) (result *v1alpha1.ClusterShootPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(clustershootpoliciesResource, name), &v1alpha1.ClusterShootPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of ClusterShootPolicies that match those selectors.
+func (c *FakeClusterShootPolicies) List(opts v1.ListOptions) (result *v1alpha1.ClusterShootPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(clustershootpoliciesResource, clustershootpoliciesKind, opts), &v1alpha1.ClusterShootPolicyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ClusterShootPolicyList{ListMeta: obj.(*v1alpha1.ClusterShootPolicyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ClusterShootPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested clusterShootPolicies.
+func (c *FakeClusterShootPolicies) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(clustershootpoliciesResource, opts))
+}
+
+// Create takes the representation of a clusterShootPolicy and creates it.  Returns the server's representation of the clusterShootPolicy, and an error, if there is any.
+func (c *FakeClusterShootPolicies) Create(clusterShootPolicy *v1alpha1.ClusterShootPolicy) (result *v1alpha1.ClusterShootPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(clustershootpoliciesResource, clusterShootPolicy), &v1alpha1.ClusterShootPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPolicy), err
+}
+
+// Update takes the representation of a clusterShootPolicy and updates it. Returns the server's representation of the clusterShootPolicy, and an error, if there is any.
+func (c *FakeClusterShootPolicies) Update(clusterShootPolicy *v1alpha1.ClusterShootPolicy) (result *v1alpha1.ClusterShootPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(clustershootpoliciesResource, clusterShootPolicy), &v1alpha1.ClusterShootPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPolicy), err
+}
+
+// Delete takes name of the clusterShootPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeClusterShootPolicies) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(clustershootpoliciesResource, name), &v1alpha1.ClusterShootPolicy{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeClusterShootPolicies) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(clustershootpoliciesResource, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ClusterShootPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched clusterShootPolicy.
+func (c *FakeClusterShootPolicies) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ClusterShootPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(clustershootpoliciesResource, name, pt, data, subresources...), &v1alpha1.ClusterShootPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPolicy), err
+}
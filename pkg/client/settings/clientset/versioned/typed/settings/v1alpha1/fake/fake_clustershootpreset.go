@@ -0,0 +1,104 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeClusterShootPresets implements ClusterShootPresetInterface
+type FakeClusterShootPresets struct {
+	Fake *FakeSettingsV1alpha1
+}
+
+var clustershootpresetsResource = schema.GroupVersionResource{Group: "settings.gardener.cloud", Version: "v1alpha1", Resource: "clustershootpresets"}
+
+var clustershootpresetsKind = schema.GroupVersionKind{Group: "settings.gardener.cloud", Version: "v1alpha1", Kind: "ClusterShootPreset"}
+
+// Get takes name of the clusterShootPreset, and returns the corresponding clusterShootPreset object, and an error if there is any.
+func (c *FakeClusterShootPresets) Get(name string, options v1.GetOptions) (result *v1alpha1.ClusterShootPreset, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(clustershootpresetsResource, name), &v1alpha1.ClusterShootPreset{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPreset), err
+}
+
+// List takes label and field selectors, and returns the list of ClusterShootPresets that match those selectors.
+func (c *FakeClusterShootPresets) List(opts v1.ListOptions) (result *v1alpha1.ClusterShootPresetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(clustershootpresetsResource, clustershootpresetsKind, opts), &v1alpha1.ClusterShootPresetList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ClusterShootPresetList{ListMeta: obj.(*v1alpha1.ClusterShootPresetList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ClusterShootPresetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested clusterShootPresets.
+func (c *FakeClusterShootPresets) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(clustershootpresetsResource, opts))
+}
+
+// Create takes the representation of a clusterShootPreset and creates it.  Returns the server's representation of the clusterShootPreset, and an error, if there is any.
+func (c *FakeClusterShootPresets) Create(clusterShootPreset *v1alpha1.ClusterShootPreset) (result *v1alpha1.ClusterShootPreset, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(clustershootpresetsResource, clusterShootPreset), &v1alpha1.ClusterShootPreset{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPreset), err
+}
+
+// Update takes the representation of a clusterShootPreset and updates it. Returns the server's representation of the clusterShootPreset, and an error, if there is any.
+func (c *FakeClusterShootPresets) Update(clusterShootPreset *v1alpha1.ClusterShootPreset) (result *v1alpha1.ClusterShootPreset, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(clustershootpresetsResource, clusterShootPreset), &v1alpha1.ClusterShootPreset{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPreset), err
+}
+
+// Delete takes name of the clusterShootPreset and deletes it. Returns an error if one occurs.
+func (c *FakeClusterShootPresets) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(clustershootpresetsResource, name), &v1alpha1.ClusterShootPreset{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeClusterShootPresets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(clustershootpresetsResource, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ClusterShootPresetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched clusterShootPreset.
+func (c *FakeClusterShootPresets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ClusterShootPreset, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(clustershootpresetsResource, name, pt, data, subresources...), &v1alpha1.ClusterShootPreset{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterShootPreset), err
+}
@@ -0,0 +1,112 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeShootPresets implements ShootPresetInterface
+type FakeShootPresets struct {
+	Fake *FakeSettingsV1alpha1
+	ns   string
+}
+
+var shootpresetsResource = schema.GroupVersionResource{Group: "settings.gardener.cloud", Version: "v1alpha1", Resource: "shootpresets"}
+
+var shootpresetsKind = schema.GroupVersionKind{Group: "settings.gardener.cloud", Version: "v1alpha1", Kind: "ShootPreset"}
+
+// Get takes name of the shootPreset, and returns the corresponding shootPreset object, and an error if there is any.
+func (c *FakeShootPresets) Get(name string, options v1.GetOptions) (result *v1alpha1.ShootPreset, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(shootpresetsResource, c.ns, name), &v1alpha1.ShootPreset{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ShootPreset), err
+}
+
+// List takes label and field selectors, and returns the list of ShootPresets that match those selectors.
+func (c *FakeShootPresets) List(opts v1.ListOptions) (result *v1alpha1.ShootPresetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(shootpresetsResource, shootpresetsKind, c.ns, opts), &v1alpha1.ShootPresetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ShootPresetList{ListMeta: obj.(*v1alpha1.ShootPresetList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ShootPresetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested shootPresets.
+func (c *FakeShootPresets) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(shootpresetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a shootPreset and creates it.  Returns the server's representation of the shootPreset, and an error, if there is any.
+func (c *FakeShootPresets) Create(shootPreset *v1alpha1.ShootPreset) (result *v1alpha1.ShootPreset, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(shootpresetsResource, c.ns, shootPreset), &v1alpha1.ShootPreset{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ShootPreset), err
+}
+
+// Update takes the representation of a shootPreset and updates it. Returns the server's representation of the shootPreset, and an error, if there is any.
+func (c *FakeShootPresets) Update(shootPreset *v1alpha1.ShootPreset) (result *v1alpha1.ShootPreset, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(shootpresetsResource, c.ns, shootPreset), &v1alpha1.ShootPreset{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ShootPreset), err
+}
+
+// Delete takes name of the shootPreset and deletes it. Returns an error if one occurs.
+func (c *FakeShootPresets) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(shootpresetsResource, c.ns, name), &v1alpha1.ShootPreset{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeShootPresets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(shootpresetsResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ShootPresetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched shootPreset.
+func (c *FakeShootPresets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ShootPreset, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(shootpresetsResource, c.ns, name, pt, data, subresources...), &v1alpha1.ShootPreset{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ShootPreset), err
+}
@@ -4,4 +4,10 @@ package v1alpha1
 
 type ClusterOpenIDConnectPresetExpansion interface{}
 
+type ClusterShootPolicyExpansion interface{}
+
+type ClusterShootPresetExpansion interface{}
+
 type OpenIDConnectPresetExpansion interface{}
+
+type ShootPresetExpansion interface{}
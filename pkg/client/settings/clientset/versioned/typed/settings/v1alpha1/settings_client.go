@@ -12,7 +12,10 @@ import (
 type SettingsV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	ClusterOpenIDConnectPresetsGetter
+	ClusterShootPoliciesGetter
+	ClusterShootPresetsGetter
 	OpenIDConnectPresetsGetter
+	ShootPresetsGetter
 }
 
 // SettingsV1alpha1Client is used to interact with features provided by the settings.gardener.cloud group.
@@ -24,10 +27,22 @@ func (c *SettingsV1alpha1Client) ClusterOpenIDConnectPresets() ClusterOpenIDConn
 	return newClusterOpenIDConnectPresets(c)
 }
 
+func (c *SettingsV1alpha1Client) ClusterShootPolicies() ClusterShootPolicyInterface {
+	return newClusterShootPolicies(c)
+}
+
+func (c *SettingsV1alpha1Client) ClusterShootPresets() ClusterShootPresetInterface {
+	return newClusterShootPresets(c)
+}
+
 func (c *SettingsV1alpha1Client) OpenIDConnectPresets(namespace string) OpenIDConnectPresetInterface {
 	return newOpenIDConnectPresets(c, namespace)
 }
 
+func (c *SettingsV1alpha1Client) ShootPresets(namespace string) ShootPresetInterface {
+	return newShootPresets(c, namespace)
+}
+
 // NewForConfig creates a new SettingsV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*SettingsV1alpha1Client, error) {
 	config := *c
@@ -0,0 +1,158 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	scheme "github.com/gardener/gardener/pkg/client/settings/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ShootPresetsGetter has a method to return a ShootPresetInterface.
+// A group's client should implement this interface.
+type ShootPresetsGetter interface {
+	ShootPresets(namespace string) ShootPresetInterface
+}
+
+// ShootPresetInterface has methods to work with ShootPreset resources.
+type ShootPresetInterface interface {
+	Create(*v1alpha1.ShootPreset) (*v1alpha1.ShootPreset, error)
+	Update(*v1alpha1.ShootPreset) (*v1alpha1.ShootPreset, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.ShootPreset, error)
+	List(opts v1.ListOptions) (*v1alpha1.ShootPresetList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ShootPreset, err error)
+	ShootPresetExpansion
+}
+
+// shootPresets implements ShootPresetInterface
+type shootPresets struct {
+	client rest.Interface
+	ns     string
+}
+
+// newShootPresets returns a ShootPresets
+func newShootPresets(c *SettingsV1alpha1Client, namespace string) *shootPresets {
+	return &shootPresets{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the shootPreset, and returns the corresponding shootPreset object, and an error if there is any.
+func (c *shootPresets) Get(name string, options v1.GetOptions) (result *v1alpha1.ShootPreset, err error) {
+	result = &v1alpha1.ShootPreset{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("shootpresets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ShootPresets that match those selectors.
+func (c *shootPresets) List(opts v1.ListOptions) (result *v1alpha1.ShootPresetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ShootPresetList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("shootpresets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested shootPresets.
+func (c *shootPresets) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("shootpresets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a shootPreset and creates it.  Returns the server's representation of the shootPreset, and an error, if there is any.
+func (c *shootPresets) Create(shootPreset *v1alpha1.ShootPreset) (result *v1alpha1.ShootPreset, err error) {
+	result = &v1alpha1.ShootPreset{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("shootpresets").
+		Body(shootPreset).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a shootPreset and updates it. Returns the server's representation of the shootPreset, and an error, if there is any.
+func (c *shootPresets) Update(shootPreset *v1alpha1.ShootPreset) (result *v1alpha1.ShootPreset, err error) {
+	result = &v1alpha1.ShootPreset{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("shootpresets").
+		Name(shootPreset.Name).
+		Body(shootPreset).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the shootPreset and deletes it. Returns an error if one occurs.
+func (c *shootPresets) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("shootpresets").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *shootPresets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("shootpresets").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched shootPreset.
+func (c *shootPresets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ShootPreset, err error) {
+	result = &v1alpha1.ShootPreset{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("shootpresets").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,49 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ClusterShootPolicyLister helps list ClusterShootPolicies.
+type ClusterShootPolicyLister interface {
+	// List lists all ClusterShootPolicies in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.ClusterShootPolicy, err error)
+	// Get retrieves the ClusterShootPolicy from the index for a given name.
+	Get(name string) (*v1alpha1.ClusterShootPolicy, error)
+	ClusterShootPolicyListerExpansion
+}
+
+// clusterShootPolicyLister implements the ClusterShootPolicyLister interface.
+type clusterShootPolicyLister struct {
+	indexer cache.Indexer
+}
+
+// NewClusterShootPolicyLister returns a new ClusterShootPolicyLister.
+func NewClusterShootPolicyLister(indexer cache.Indexer) ClusterShootPolicyLister {
+	return &clusterShootPolicyLister{indexer: indexer}
+}
+
+// List lists all ClusterShootPolicies in the indexer.
+func (s *clusterShootPolicyLister) List(selector labels.Selector) (ret []*v1alpha1.ClusterShootPolicy, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ClusterShootPolicy))
+	})
+	return ret, err
+}
+
+// Get retrieves the ClusterShootPolicy from the index for a given name.
+func (s *clusterShootPolicyLister) Get(name string) (*v1alpha1.ClusterShootPolicy, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("clustershootpolicy"), name)
+	}
+	return obj.(*v1alpha1.ClusterShootPolicy), nil
+}
@@ -0,0 +1,49 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ClusterShootPresetLister helps list ClusterShootPresets.
+type ClusterShootPresetLister interface {
+	// List lists all ClusterShootPresets in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.ClusterShootPreset, err error)
+	// Get retrieves the ClusterShootPreset from the index for a given name.
+	Get(name string) (*v1alpha1.ClusterShootPreset, error)
+	ClusterShootPresetListerExpansion
+}
+
+// clusterShootPresetLister implements the ClusterShootPresetLister interface.
+type clusterShootPresetLister struct {
+	indexer cache.Indexer
+}
+
+// NewClusterShootPresetLister returns a new ClusterShootPresetLister.
+func NewClusterShootPresetLister(indexer cache.Indexer) ClusterShootPresetLister {
+	return &clusterShootPresetLister{indexer: indexer}
+}
+
+// List lists all ClusterShootPresets in the indexer.
+func (s *clusterShootPresetLister) List(selector labels.Selector) (ret []*v1alpha1.ClusterShootPreset, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ClusterShootPreset))
+	})
+	return ret, err
+}
+
+// Get retrieves the ClusterShootPreset from the index for a given name.
+func (s *clusterShootPresetLister) Get(name string) (*v1alpha1.ClusterShootPreset, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("clustershootpreset"), name)
+	}
+	return obj.(*v1alpha1.ClusterShootPreset), nil
+}
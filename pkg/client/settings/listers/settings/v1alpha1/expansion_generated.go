@@ -6,6 +6,14 @@ package v1alpha1
 // ClusterOpenIDConnectPresetLister.
 type ClusterOpenIDConnectPresetListerExpansion interface{}
 
+// ClusterShootPolicyListerExpansion allows custom methods to be added to
+// ClusterShootPolicyLister.
+type ClusterShootPolicyListerExpansion interface{}
+
+// ClusterShootPresetListerExpansion allows custom methods to be added to
+// ClusterShootPresetLister.
+type ClusterShootPresetListerExpansion interface{}
+
 // OpenIDConnectPresetListerExpansion allows custom methods to be added to
 // OpenIDConnectPresetLister.
 type OpenIDConnectPresetListerExpansion interface{}
@@ -13,3 +21,11 @@ type OpenIDConnectPresetListerExpansion interface{}
 // OpenIDConnectPresetNamespaceListerExpansion allows custom methods to be added to
 // OpenIDConnectPresetNamespaceLister.
 type OpenIDConnectPresetNamespaceListerExpansion interface{}
+
+// ShootPresetListerExpansion allows custom methods to be added to
+// ShootPresetLister.
+type ShootPresetListerExpansion interface{}
+
+// ShootPresetNamespaceListerExpansion allows custom methods to be added to
+// ShootPresetNamespaceLister.
+type ShootPresetNamespaceListerExpansion interface{}
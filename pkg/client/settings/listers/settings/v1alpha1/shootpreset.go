@@ -0,0 +1,78 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ShootPresetLister helps list ShootPresets.
+type ShootPresetLister interface {
+	// List lists all ShootPresets in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.ShootPreset, err error)
+	// ShootPresets returns an object that can list and get ShootPresets.
+	ShootPresets(namespace string) ShootPresetNamespaceLister
+	ShootPresetListerExpansion
+}
+
+// shootPresetLister implements the ShootPresetLister interface.
+type shootPresetLister struct {
+	indexer cache.Indexer
+}
+
+// NewShootPresetLister returns a new ShootPresetLister.
+func NewShootPresetLister(indexer cache.Indexer) ShootPresetLister {
+	return &shootPresetLister{indexer: indexer}
+}
+
+// List lists all ShootPresets in the indexer.
+func (s *shootPresetLister) List(selector labels.Selector) (ret []*v1alpha1.ShootPreset, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ShootPreset))
+	})
+	return ret, err
+}
+
+// ShootPresets returns an object that can list and get ShootPresets.
+func (s *shootPresetLister) ShootPresets(namespace string) ShootPresetNamespaceLister {
+	return shootPresetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ShootPresetNamespaceLister helps list and get ShootPresets.
+type ShootPresetNamespaceLister interface {
+	// List lists all ShootPresets in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha1.ShootPreset, err error)
+	// Get retrieves the ShootPreset from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha1.ShootPreset, error)
+	ShootPresetNamespaceListerExpansion
+}
+
+// shootPresetNamespaceLister implements the ShootPresetNamespaceLister
+// interface.
+type shootPresetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ShootPresets in the indexer for a given namespace.
+func (s shootPresetNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ShootPreset, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ShootPreset))
+	})
+	return ret, err
+}
+
+// Get retrieves the ShootPreset from the indexer for a given namespace and name.
+func (s shootPresetNamespaceLister) Get(name string) (*v1alpha1.ShootPreset, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("shootpreset"), name)
+	}
+	return obj.(*v1alpha1.ShootPreset), nil
+}
@@ -15,6 +15,7 @@
 package config
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfig "k8s.io/component-base/config"
 	"k8s.io/klog"
@@ -128,6 +129,9 @@ type ControllerInstallationControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int
+	// HealthCheckPeriod is the duration how often the health of the resources deployed by
+	// ControllerInstallations is checked.
+	HealthCheckPeriod *metav1.Duration
 }
 
 // PlantConfiguration defines the configuration of the
@@ -154,6 +158,30 @@ type ProjectControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int
+	// StaleSyncPeriod is the duration how often the controller checks whether projects are stale.
+	StaleSyncPeriod *metav1.Duration
+	// StalePeriodDays is the number of days without any activity after which a project is considered
+	// stale/unused.
+	StalePeriodDays *int
+	// StaleAutoDeleteGracePeriodDays is the number of days after which a stale project without any Shoots, Plants
+	// or SecretBindings is deleted automatically. If it is not set (default) then stale projects are only marked and
+	// never deleted automatically.
+	StaleAutoDeleteGracePeriodDays *int
+	// Quotas is the list of quota configurations for project namespaces. The first configuration whose project
+	// selector matches a project is applied to its namespace.
+	Quotas []ProjectQuotaConfiguration
+}
+
+// ProjectQuotaConfiguration defines the ResourceQuota and LimitRange which are maintained in the namespaces of the
+// selected projects.
+type ProjectQuotaConfiguration struct {
+	// ProjectSelector selects the projects this configuration applies to. If it is not set then all projects are
+	// selected.
+	ProjectSelector *metav1.LabelSelector
+	// ResourceQuota is the specification of the ResourceQuota maintained in the project namespace.
+	ResourceQuota *corev1.ResourceQuotaSpec
+	// LimitRange is the specification of the LimitRange maintained in the project namespace.
+	LimitRange *corev1.LimitRangeSpec
 }
 
 // QuotaControllerConfiguration defines the configuration of the Quota controller.
@@ -176,6 +204,44 @@ type SeedControllerConfiguration struct {
 	ReserveExcessCapacity *bool
 	// SyncPeriod is the duration how often the existing resources are reconciled.
 	SyncPeriod metav1.Duration
+	// GarbageCollection defines the configuration of the garbage collection in the
+	// Seed clusters. If it is not specified, then no garbage collection is performed.
+	GarbageCollection *SeedGarbageCollectionConfiguration
+}
+
+// SeedGarbageCollectionConfiguration defines the configuration of the garbage
+// collection in the Seed clusters.
+type SeedGarbageCollectionConfiguration struct {
+	// DryRun indicates whether the garbage collection only reports the objects which
+	// would be deleted instead of actually deleting them. It defaults to true.
+	DryRun *bool
+	// Period is the minimum duration between two garbage collections of the same Seed
+	// cluster. It defaults to 1h.
+	Period *metav1.Duration
+	// OrphanedShootNamespaces is the policy for Shoot namespaces in the Seed clusters
+	// whose Shoot does not exist anymore.
+	OrphanedShootNamespaces *GarbageCollectionPolicy
+	// OrphanedExtensionResources is the policy for extension resources in the Seed
+	// clusters whose Shoot does not exist anymore.
+	OrphanedExtensionResources *GarbageCollectionPolicy
+	// StaleManagedResources is the policy for ManagedResources in the Seed clusters
+	// whose referenced secrets do not exist anymore.
+	StaleManagedResources *GarbageCollectionPolicy
+	// TerraformerResources is the policy for finished Terraformer jobs and pods as well
+	// as for Terraformer configurations with an empty state in the Seed clusters.
+	TerraformerResources *GarbageCollectionPolicy
+	// OrphanedBackupEntries is the policy for BackupEntries in the Garden cluster which
+	// are assigned to a Seed and whose Shoot does not exist anymore.
+	OrphanedBackupEntries *GarbageCollectionPolicy
+}
+
+// GarbageCollectionPolicy defines the configuration of a garbage collection policy.
+type GarbageCollectionPolicy struct {
+	// Enabled indicates whether the policy is enabled.
+	Enabled bool
+	// MinimumAge is the minimum age of an object before it is garbage collected. It
+	// defaults to 24h.
+	MinimumAge *metav1.Duration
 }
 
 // ShootControllerConfiguration defines the configuration of the CloudProfile
@@ -190,6 +256,10 @@ type ShootControllerConfiguration struct {
 	// RespectSyncPeriodOverwrite determines whether a sync period overwrite of a
 	// Shoot (via annotation) is respected or not. Defaults to false.
 	RespectSyncPeriodOverwrite *bool
+	// RetriggerStuckExtensionResources determines whether extension resources whose last operation did not make
+	// progress within their reconcile timeout are annotated once more to retrigger their reconciliation before the
+	// operation fails. Defaults to false.
+	RetriggerStuckExtensionResources *bool
 	// RetryDuration is the maximum duration how often a reconciliation will be retried
 	// in case of errors.
 	RetryDuration metav1.Duration
@@ -85,6 +85,9 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 			ConcurrentSyncs: 5,
 		}
 	}
+	if obj.Controllers.ControllerInstallation.HealthCheckPeriod == nil {
+		obj.Controllers.ControllerInstallation.HealthCheckPeriod = &metav1.Duration{Duration: time.Minute}
+	}
 	if obj.Controllers.SecretBinding == nil {
 		obj.Controllers.SecretBinding = &SecretBindingControllerConfiguration{
 			ConcurrentSyncs: 5,
@@ -95,6 +98,13 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 			ConcurrentSyncs: 5,
 		}
 	}
+	if obj.Controllers.Project.StaleSyncPeriod == nil {
+		obj.Controllers.Project.StaleSyncPeriod = &metav1.Duration{Duration: 12 * time.Hour}
+	}
+	if obj.Controllers.Project.StalePeriodDays == nil {
+		v := 90
+		obj.Controllers.Project.StalePeriodDays = &v
+	}
 	if obj.Controllers.Quota == nil {
 		obj.Controllers.Quota = &QuotaControllerConfiguration{
 			ConcurrentSyncs: 5,
@@ -109,12 +119,29 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 		if obj.Controllers.Seed.ReserveExcessCapacity == nil {
 			obj.Controllers.Seed.ReserveExcessCapacity = &trueVar
 		}
+		if gc := obj.Controllers.Seed.GarbageCollection; gc != nil {
+			if gc.DryRun == nil {
+				gc.DryRun = &trueVar
+			}
+			if gc.Period == nil {
+				gc.Period = &metav1.Duration{Duration: time.Hour}
+			}
+			for _, policy := range []*GarbageCollectionPolicy{gc.OrphanedShootNamespaces, gc.OrphanedExtensionResources, gc.StaleManagedResources, gc.TerraformerResources, gc.OrphanedBackupEntries} {
+				if policy != nil && policy.MinimumAge == nil {
+					policy.MinimumAge = &metav1.Duration{Duration: 24 * time.Hour}
+				}
+			}
+		}
 	}
 
 	if obj.Controllers.Shoot.RespectSyncPeriodOverwrite == nil {
 		falseVar := false
 		obj.Controllers.Shoot.RespectSyncPeriodOverwrite = &falseVar
 	}
+	if obj.Controllers.Shoot.RetriggerStuckExtensionResources == nil {
+		falseVar := false
+		obj.Controllers.Shoot.RetriggerStuckExtensionResources = &falseVar
+	}
 	if obj.Controllers.Shoot.RetrySyncPeriod == nil {
 		durationVar := metav1.Duration{Duration: 15 * time.Second}
 		obj.Controllers.Shoot.RetrySyncPeriod = &durationVar
@@ -17,6 +17,7 @@ package v1alpha1
 import (
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/klog"
@@ -125,6 +126,10 @@ type ControllerInstallationControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// HealthCheckPeriod is the duration how often the health of the resources deployed by
+	// ControllerInstallations is checked.
+	// +optional
+	HealthCheckPeriod *metav1.Duration `json:"healthCheckPeriod,omitempty"`
 }
 
 // PlantConfiguration defines the configuration of the
@@ -151,6 +156,37 @@ type ProjectControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// StaleSyncPeriod is the duration how often the controller checks whether projects are stale.
+	// +optional
+	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// StalePeriodDays is the number of days without any activity after which a project is considered
+	// stale/unused.
+	// +optional
+	StalePeriodDays *int `json:"stalePeriodDays,omitempty"`
+	// StaleAutoDeleteGracePeriodDays is the number of days after which a stale project without any Shoots, Plants
+	// or SecretBindings is deleted automatically. If it is not set (default) then stale projects are only marked and
+	// never deleted automatically.
+	// +optional
+	StaleAutoDeleteGracePeriodDays *int `json:"staleAutoDeleteGracePeriodDays,omitempty"`
+	// Quotas is the list of quota configurations for project namespaces. The first configuration whose project
+	// selector matches a project is applied to its namespace.
+	// +optional
+	Quotas []ProjectQuotaConfiguration `json:"quotas,omitempty"`
+}
+
+// ProjectQuotaConfiguration defines the ResourceQuota and LimitRange which are maintained in the namespaces of the
+// selected projects.
+type ProjectQuotaConfiguration struct {
+	// ProjectSelector selects the projects this configuration applies to. If it is not set then all projects are
+	// selected.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+	// ResourceQuota is the specification of the ResourceQuota maintained in the project namespace.
+	// +optional
+	ResourceQuota *corev1.ResourceQuotaSpec `json:"resourceQuota,omitempty"`
+	// LimitRange is the specification of the LimitRange maintained in the project namespace.
+	// +optional
+	LimitRange *corev1.LimitRangeSpec `json:"limitRange,omitempty"`
 }
 
 // QuotaControllerConfiguration defines the configuration of the Quota controller.
@@ -174,6 +210,53 @@ type SeedControllerConfiguration struct {
 	ReserveExcessCapacity *bool `json:"reserveExcessCapacity,omitempty"`
 	// SyncPeriod is the duration how often the existing resources are reconciled.
 	SyncPeriod metav1.Duration `json:"syncPeriod"`
+	// GarbageCollection defines the configuration of the garbage collection in the
+	// Seed clusters. If it is not specified, then no garbage collection is performed.
+	// +optional
+	GarbageCollection *SeedGarbageCollectionConfiguration `json:"garbageCollection,omitempty"`
+}
+
+// SeedGarbageCollectionConfiguration defines the configuration of the garbage
+// collection in the Seed clusters.
+type SeedGarbageCollectionConfiguration struct {
+	// DryRun indicates whether the garbage collection only reports the objects which
+	// would be deleted instead of actually deleting them. It defaults to true.
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+	// Period is the minimum duration between two garbage collections of the same Seed
+	// cluster. It defaults to 1h.
+	// +optional
+	Period *metav1.Duration `json:"period,omitempty"`
+	// OrphanedShootNamespaces is the policy for Shoot namespaces in the Seed clusters
+	// whose Shoot does not exist anymore.
+	// +optional
+	OrphanedShootNamespaces *GarbageCollectionPolicy `json:"orphanedShootNamespaces,omitempty"`
+	// OrphanedExtensionResources is the policy for extension resources in the Seed
+	// clusters whose Shoot does not exist anymore.
+	// +optional
+	OrphanedExtensionResources *GarbageCollectionPolicy `json:"orphanedExtensionResources,omitempty"`
+	// StaleManagedResources is the policy for ManagedResources in the Seed clusters
+	// whose referenced secrets do not exist anymore.
+	// +optional
+	StaleManagedResources *GarbageCollectionPolicy `json:"staleManagedResources,omitempty"`
+	// TerraformerResources is the policy for finished Terraformer jobs and pods as well
+	// as for Terraformer configurations with an empty state in the Seed clusters.
+	// +optional
+	TerraformerResources *GarbageCollectionPolicy `json:"terraformerResources,omitempty"`
+	// OrphanedBackupEntries is the policy for BackupEntries in the Garden cluster which
+	// are assigned to a Seed and whose Shoot does not exist anymore.
+	// +optional
+	OrphanedBackupEntries *GarbageCollectionPolicy `json:"orphanedBackupEntries,omitempty"`
+}
+
+// GarbageCollectionPolicy defines the configuration of a garbage collection policy.
+type GarbageCollectionPolicy struct {
+	// Enabled indicates whether the policy is enabled.
+	Enabled bool `json:"enabled"`
+	// MinimumAge is the minimum age of an object before it is garbage collected. It
+	// defaults to 24h.
+	// +optional
+	MinimumAge *metav1.Duration `json:"minimumAge,omitempty"`
 }
 
 // ShootControllerConfiguration defines the configuration of the Shoot
@@ -190,6 +273,11 @@ type ShootControllerConfiguration struct {
 	// Shoot (via annotation) is respected or not. Defaults to false.
 	// +optional
 	RespectSyncPeriodOverwrite *bool `json:"respectSyncPeriodOverwrite,omitempty"`
+	// RetriggerStuckExtensionResources determines whether extension resources whose last operation did not make
+	// progress within their reconcile timeout are annotated once more to retrigger their reconciliation before the
+	// operation fails. Defaults to false.
+	// +optional
+	RetriggerStuckExtensionResources *bool `json:"retriggerStuckExtensionResources,omitempty"`
 	// RetryDuration is the maximum duration how often a reconciliation will be retried
 	// in case of errors.
 	RetryDuration metav1.Duration `json:"retryDuration"`
@@ -24,6 +24,7 @@ import (
 	unsafe "unsafe"
 
 	config "github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -128,6 +129,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GarbageCollectionPolicy)(nil), (*config.GarbageCollectionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GarbageCollectionPolicy_To_config_GarbageCollectionPolicy(a.(*GarbageCollectionPolicy), b.(*config.GarbageCollectionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.GarbageCollectionPolicy)(nil), (*GarbageCollectionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_GarbageCollectionPolicy_To_v1alpha1_GarbageCollectionPolicy(a.(*config.GarbageCollectionPolicy), b.(*GarbageCollectionPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HTTPSServer)(nil), (*config.HTTPSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HTTPSServer_To_config_HTTPSServer(a.(*HTTPSServer), b.(*config.HTTPSServer), scope)
 	}); err != nil {
@@ -168,6 +179,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectQuotaConfiguration)(nil), (*config.ProjectQuotaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProjectQuotaConfiguration_To_config_ProjectQuotaConfiguration(a.(*ProjectQuotaConfiguration), b.(*config.ProjectQuotaConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProjectQuotaConfiguration)(nil), (*ProjectQuotaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProjectQuotaConfiguration_To_v1alpha1_ProjectQuotaConfiguration(a.(*config.ProjectQuotaConfiguration), b.(*ProjectQuotaConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*QuotaControllerConfiguration)(nil), (*config.QuotaControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_QuotaControllerConfiguration_To_config_QuotaControllerConfiguration(a.(*QuotaControllerConfiguration), b.(*config.QuotaControllerConfiguration), scope)
 	}); err != nil {
@@ -198,6 +219,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedGarbageCollectionConfiguration)(nil), (*config.SeedGarbageCollectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedGarbageCollectionConfiguration_To_config_SeedGarbageCollectionConfiguration(a.(*SeedGarbageCollectionConfiguration), b.(*config.SeedGarbageCollectionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedGarbageCollectionConfiguration)(nil), (*SeedGarbageCollectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedGarbageCollectionConfiguration_To_v1alpha1_SeedGarbageCollectionConfiguration(a.(*config.SeedGarbageCollectionConfiguration), b.(*SeedGarbageCollectionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -377,6 +408,7 @@ func Convert_config_ConditionThreshold_To_v1alpha1_ConditionThreshold(in *config
 
 func autoConvert_v1alpha1_ControllerInstallationControllerConfiguration_To_config_ControllerInstallationControllerConfiguration(in *ControllerInstallationControllerConfiguration, out *config.ControllerInstallationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.HealthCheckPeriod = (*v1.Duration)(unsafe.Pointer(in.HealthCheckPeriod))
 	return nil
 }
 
@@ -387,6 +419,7 @@ func Convert_v1alpha1_ControllerInstallationControllerConfiguration_To_config_Co
 
 func autoConvert_config_ControllerInstallationControllerConfiguration_To_v1alpha1_ControllerInstallationControllerConfiguration(in *config.ControllerInstallationControllerConfiguration, out *ControllerInstallationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.HealthCheckPeriod = (*v1.Duration)(unsafe.Pointer(in.HealthCheckPeriod))
 	return nil
 }
 
@@ -575,6 +608,28 @@ func Convert_config_DiscoveryConfiguration_To_v1alpha1_DiscoveryConfiguration(in
 	return autoConvert_config_DiscoveryConfiguration_To_v1alpha1_DiscoveryConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_GarbageCollectionPolicy_To_config_GarbageCollectionPolicy(in *GarbageCollectionPolicy, out *config.GarbageCollectionPolicy, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MinimumAge = (*v1.Duration)(unsafe.Pointer(in.MinimumAge))
+	return nil
+}
+
+// Convert_v1alpha1_GarbageCollectionPolicy_To_config_GarbageCollectionPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_GarbageCollectionPolicy_To_config_GarbageCollectionPolicy(in *GarbageCollectionPolicy, out *config.GarbageCollectionPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GarbageCollectionPolicy_To_config_GarbageCollectionPolicy(in, out, s)
+}
+
+func autoConvert_config_GarbageCollectionPolicy_To_v1alpha1_GarbageCollectionPolicy(in *config.GarbageCollectionPolicy, out *GarbageCollectionPolicy, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MinimumAge = (*v1.Duration)(unsafe.Pointer(in.MinimumAge))
+	return nil
+}
+
+// Convert_config_GarbageCollectionPolicy_To_v1alpha1_GarbageCollectionPolicy is an autogenerated conversion function.
+func Convert_config_GarbageCollectionPolicy_To_v1alpha1_GarbageCollectionPolicy(in *config.GarbageCollectionPolicy, out *GarbageCollectionPolicy, s conversion.Scope) error {
+	return autoConvert_config_GarbageCollectionPolicy_To_v1alpha1_GarbageCollectionPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_HTTPSServer_To_config_HTTPSServer(in *HTTPSServer, out *config.HTTPSServer, s conversion.Scope) error {
 	if err := Convert_v1alpha1_Server_To_config_Server(&in.Server, &out.Server, s); err != nil {
 		return err
@@ -657,6 +712,10 @@ func Convert_config_PlantConfiguration_To_v1alpha1_PlantConfiguration(in *config
 
 func autoConvert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectControllerConfiguration(in *ProjectControllerConfiguration, out *config.ProjectControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.StalePeriodDays = (*int)(unsafe.Pointer(in.StalePeriodDays))
+	out.StaleAutoDeleteGracePeriodDays = (*int)(unsafe.Pointer(in.StaleAutoDeleteGracePeriodDays))
+	out.Quotas = *(*[]config.ProjectQuotaConfiguration)(unsafe.Pointer(&in.Quotas))
 	return nil
 }
 
@@ -667,6 +726,10 @@ func Convert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectController
 
 func autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectControllerConfiguration(in *config.ProjectControllerConfiguration, out *ProjectControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.StalePeriodDays = (*int)(unsafe.Pointer(in.StalePeriodDays))
+	out.StaleAutoDeleteGracePeriodDays = (*int)(unsafe.Pointer(in.StaleAutoDeleteGracePeriodDays))
+	out.Quotas = *(*[]ProjectQuotaConfiguration)(unsafe.Pointer(&in.Quotas))
 	return nil
 }
 
@@ -675,6 +738,30 @@ func Convert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectController
 	return autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ProjectQuotaConfiguration_To_config_ProjectQuotaConfiguration(in *ProjectQuotaConfiguration, out *config.ProjectQuotaConfiguration, s conversion.Scope) error {
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.ResourceQuota = (*corev1.ResourceQuotaSpec)(unsafe.Pointer(in.ResourceQuota))
+	out.LimitRange = (*corev1.LimitRangeSpec)(unsafe.Pointer(in.LimitRange))
+	return nil
+}
+
+// Convert_v1alpha1_ProjectQuotaConfiguration_To_config_ProjectQuotaConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ProjectQuotaConfiguration_To_config_ProjectQuotaConfiguration(in *ProjectQuotaConfiguration, out *config.ProjectQuotaConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProjectQuotaConfiguration_To_config_ProjectQuotaConfiguration(in, out, s)
+}
+
+func autoConvert_config_ProjectQuotaConfiguration_To_v1alpha1_ProjectQuotaConfiguration(in *config.ProjectQuotaConfiguration, out *ProjectQuotaConfiguration, s conversion.Scope) error {
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.ResourceQuota = (*corev1.ResourceQuotaSpec)(unsafe.Pointer(in.ResourceQuota))
+	out.LimitRange = (*corev1.LimitRangeSpec)(unsafe.Pointer(in.LimitRange))
+	return nil
+}
+
+// Convert_config_ProjectQuotaConfiguration_To_v1alpha1_ProjectQuotaConfiguration is an autogenerated conversion function.
+func Convert_config_ProjectQuotaConfiguration_To_v1alpha1_ProjectQuotaConfiguration(in *config.ProjectQuotaConfiguration, out *ProjectQuotaConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ProjectQuotaConfiguration_To_v1alpha1_ProjectQuotaConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_QuotaControllerConfiguration_To_config_QuotaControllerConfiguration(in *QuotaControllerConfiguration, out *config.QuotaControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	return nil
@@ -719,6 +806,7 @@ func autoConvert_v1alpha1_SeedControllerConfiguration_To_config_SeedControllerCo
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.ReserveExcessCapacity = (*bool)(unsafe.Pointer(in.ReserveExcessCapacity))
 	out.SyncPeriod = in.SyncPeriod
+	out.GarbageCollection = (*config.SeedGarbageCollectionConfiguration)(unsafe.Pointer(in.GarbageCollection))
 	return nil
 }
 
@@ -731,6 +819,7 @@ func autoConvert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerCo
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.ReserveExcessCapacity = (*bool)(unsafe.Pointer(in.ReserveExcessCapacity))
 	out.SyncPeriod = in.SyncPeriod
+	out.GarbageCollection = (*SeedGarbageCollectionConfiguration)(unsafe.Pointer(in.GarbageCollection))
 	return nil
 }
 
@@ -739,6 +828,38 @@ func Convert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfig
 	return autoConvert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedGarbageCollectionConfiguration_To_config_SeedGarbageCollectionConfiguration(in *SeedGarbageCollectionConfiguration, out *config.SeedGarbageCollectionConfiguration, s conversion.Scope) error {
+	out.DryRun = (*bool)(unsafe.Pointer(in.DryRun))
+	out.Period = (*v1.Duration)(unsafe.Pointer(in.Period))
+	out.OrphanedShootNamespaces = (*config.GarbageCollectionPolicy)(unsafe.Pointer(in.OrphanedShootNamespaces))
+	out.OrphanedExtensionResources = (*config.GarbageCollectionPolicy)(unsafe.Pointer(in.OrphanedExtensionResources))
+	out.StaleManagedResources = (*config.GarbageCollectionPolicy)(unsafe.Pointer(in.StaleManagedResources))
+	out.TerraformerResources = (*config.GarbageCollectionPolicy)(unsafe.Pointer(in.TerraformerResources))
+	out.OrphanedBackupEntries = (*config.GarbageCollectionPolicy)(unsafe.Pointer(in.OrphanedBackupEntries))
+	return nil
+}
+
+// Convert_v1alpha1_SeedGarbageCollectionConfiguration_To_config_SeedGarbageCollectionConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedGarbageCollectionConfiguration_To_config_SeedGarbageCollectionConfiguration(in *SeedGarbageCollectionConfiguration, out *config.SeedGarbageCollectionConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedGarbageCollectionConfiguration_To_config_SeedGarbageCollectionConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedGarbageCollectionConfiguration_To_v1alpha1_SeedGarbageCollectionConfiguration(in *config.SeedGarbageCollectionConfiguration, out *SeedGarbageCollectionConfiguration, s conversion.Scope) error {
+	out.DryRun = (*bool)(unsafe.Pointer(in.DryRun))
+	out.Period = (*v1.Duration)(unsafe.Pointer(in.Period))
+	out.OrphanedShootNamespaces = (*GarbageCollectionPolicy)(unsafe.Pointer(in.OrphanedShootNamespaces))
+	out.OrphanedExtensionResources = (*GarbageCollectionPolicy)(unsafe.Pointer(in.OrphanedExtensionResources))
+	out.StaleManagedResources = (*GarbageCollectionPolicy)(unsafe.Pointer(in.StaleManagedResources))
+	out.TerraformerResources = (*GarbageCollectionPolicy)(unsafe.Pointer(in.TerraformerResources))
+	out.OrphanedBackupEntries = (*GarbageCollectionPolicy)(unsafe.Pointer(in.OrphanedBackupEntries))
+	return nil
+}
+
+// Convert_config_SeedGarbageCollectionConfiguration_To_v1alpha1_SeedGarbageCollectionConfiguration is an autogenerated conversion function.
+func Convert_config_SeedGarbageCollectionConfiguration_To_v1alpha1_SeedGarbageCollectionConfiguration(in *config.SeedGarbageCollectionConfiguration, out *SeedGarbageCollectionConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedGarbageCollectionConfiguration_To_v1alpha1_SeedGarbageCollectionConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -839,6 +960,7 @@ func autoConvert_v1alpha1_ShootControllerConfiguration_To_config_ShootController
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.ReconcileInMaintenanceOnly = (*bool)(unsafe.Pointer(in.ReconcileInMaintenanceOnly))
 	out.RespectSyncPeriodOverwrite = (*bool)(unsafe.Pointer(in.RespectSyncPeriodOverwrite))
+	out.RetriggerStuckExtensionResources = (*bool)(unsafe.Pointer(in.RetriggerStuckExtensionResources))
 	out.RetryDuration = in.RetryDuration
 	out.RetrySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.RetrySyncPeriod))
 	out.SyncPeriod = in.SyncPeriod
@@ -854,6 +976,7 @@ func autoConvert_config_ShootControllerConfiguration_To_v1alpha1_ShootController
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.ReconcileInMaintenanceOnly = (*bool)(unsafe.Pointer(in.ReconcileInMaintenanceOnly))
 	out.RespectSyncPeriodOverwrite = (*bool)(unsafe.Pointer(in.RespectSyncPeriodOverwrite))
+	out.RetriggerStuckExtensionResources = (*bool)(unsafe.Pointer(in.RetriggerStuckExtensionResources))
 	out.RetryDuration = in.RetryDuration
 	out.RetrySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.RetrySyncPeriod))
 	out.SyncPeriod = in.SyncPeriod
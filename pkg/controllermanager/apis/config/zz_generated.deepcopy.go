@@ -21,6 +21,7 @@ limitations under the License.
 package config
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -98,6 +99,11 @@ func (in *ConditionThreshold) DeepCopy() *ConditionThreshold {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerInstallationControllerConfiguration) DeepCopyInto(out *ControllerInstallationControllerConfiguration) {
 	*out = *in
+	if in.HealthCheckPeriod != nil {
+		in, out := &in.HealthCheckPeriod, &out.HealthCheckPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -181,7 +187,7 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 	if in.ControllerInstallation != nil {
 		in, out := &in.ControllerInstallation, &out.ControllerInstallation
 		*out = new(ControllerInstallationControllerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Plant != nil {
 		in, out := &in.Plant, &out.Plant
@@ -196,7 +202,7 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(ProjectControllerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Quota != nil {
 		in, out := &in.Quota, &out.Quota
@@ -273,6 +279,27 @@ func (in *DiscoveryConfiguration) DeepCopy() *DiscoveryConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollectionPolicy) DeepCopyInto(out *GarbageCollectionPolicy) {
+	*out = *in
+	if in.MinimumAge != nil {
+		in, out := &in.MinimumAge, &out.MinimumAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionPolicy.
+func (in *GarbageCollectionPolicy) DeepCopy() *GarbageCollectionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GarbageCollectionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPSServer) DeepCopyInto(out *HTTPSServer) {
 	*out = *in
@@ -328,6 +355,28 @@ func (in *PlantConfiguration) DeepCopy() *PlantConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerConfiguration) {
 	*out = *in
+	if in.StaleSyncPeriod != nil {
+		in, out := &in.StaleSyncPeriod, &out.StaleSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StalePeriodDays != nil {
+		in, out := &in.StalePeriodDays, &out.StalePeriodDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.StaleAutoDeleteGracePeriodDays != nil {
+		in, out := &in.StaleAutoDeleteGracePeriodDays, &out.StaleAutoDeleteGracePeriodDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]ProjectQuotaConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -341,6 +390,37 @@ func (in *ProjectControllerConfiguration) DeepCopy() *ProjectControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuotaConfiguration) DeepCopyInto(out *ProjectQuotaConfiguration) {
+	*out = *in
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(corev1.ResourceQuotaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LimitRange != nil {
+		in, out := &in.LimitRange, &out.LimitRange
+		*out = new(corev1.LimitRangeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuotaConfiguration.
+func (in *ProjectQuotaConfiguration) DeepCopy() *ProjectQuotaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuotaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaControllerConfiguration) DeepCopyInto(out *QuotaControllerConfiguration) {
 	*out = *in
@@ -382,6 +462,11 @@ func (in *SeedControllerConfiguration) DeepCopyInto(out *SeedControllerConfigura
 		**out = **in
 	}
 	out.SyncPeriod = in.SyncPeriod
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(SeedGarbageCollectionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -395,6 +480,57 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedGarbageCollectionConfiguration) DeepCopyInto(out *SeedGarbageCollectionConfiguration) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OrphanedShootNamespaces != nil {
+		in, out := &in.OrphanedShootNamespaces, &out.OrphanedShootNamespaces
+		*out = new(GarbageCollectionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrphanedExtensionResources != nil {
+		in, out := &in.OrphanedExtensionResources, &out.OrphanedExtensionResources
+		*out = new(GarbageCollectionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaleManagedResources != nil {
+		in, out := &in.StaleManagedResources, &out.StaleManagedResources
+		*out = new(GarbageCollectionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerraformerResources != nil {
+		in, out := &in.TerraformerResources, &out.TerraformerResources
+		*out = new(GarbageCollectionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrphanedBackupEntries != nil {
+		in, out := &in.OrphanedBackupEntries, &out.OrphanedBackupEntries
+		*out = new(GarbageCollectionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedGarbageCollectionConfiguration.
+func (in *SeedGarbageCollectionConfiguration) DeepCopy() *SeedGarbageCollectionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedGarbageCollectionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -480,6 +616,11 @@ func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfigu
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RetriggerStuckExtensionResources != nil {
+		in, out := &in.RetriggerStuckExtensionResources, &out.RetriggerStuckExtensionResources
+		*out = new(bool)
+		**out = **in
+	}
 	out.RetryDuration = in.RetryDuration
 	if in.RetrySyncPeriod != nil {
 		in, out := &in.RetrySyncPeriod, &out.RetrySyncPeriod
@@ -0,0 +1,85 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	"container/list"
+	"sync"
+)
+
+// chartCache is a least-recently-used cache for chart tarballs which is bounded by the total size of the cached
+// tarballs. Charts are cached by their digest.
+type chartCache struct {
+	maxSize int
+	size    int
+
+	entries map[string]*list.Element
+	order   *list.List
+	lock    sync.Mutex
+}
+
+type chartCacheEntry struct {
+	digest string
+	chart  []byte
+}
+
+// newChartCache creates a new chartCache which holds charts with a total size of at most <maxSize> bytes.
+func newChartCache(maxSize int) *chartCache {
+	return &chartCache{
+		maxSize: maxSize,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// get returns the cached chart with the given digest and marks it as recently used.
+func (c *chartCache) get(digest string) ([]byte, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	element, ok := c.entries[digest]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*chartCacheEntry).chart, true
+}
+
+// add adds the given chart to the cache. The least recently used charts are evicted until the total size does not
+// exceed the maximum size anymore. Charts which are larger than the maximum size are not cached at all.
+func (c *chartCache) add(digest string, chart []byte) {
+	if len(chart) > c.maxSize {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if element, ok := c.entries[digest]; ok {
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[digest] = c.order.PushFront(&chartCacheEntry{digest, chart})
+	c.size += len(chart)
+
+	for c.size > c.maxSize {
+		oldest := c.order.Back()
+		entry := oldest.Value.(*chartCacheEntry)
+		c.order.Remove(oldest)
+		delete(c.entries, entry.digest)
+		c.size -= len(entry.chart)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("chartCache", func() {
+	var cache *chartCache
+
+	BeforeEach(func() {
+		cache = newChartCache(10)
+	})
+
+	It("should return cached charts", func() {
+		cache.add("sha256:a", []byte("aaaa"))
+
+		chart, ok := cache.get("sha256:a")
+		Expect(ok).To(BeTrue())
+		Expect(chart).To(Equal([]byte("aaaa")))
+
+		_, ok = cache.get("sha256:b")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should evict the least recently used charts if the maximum size is exceeded", func() {
+		cache.add("sha256:a", []byte("aaaa"))
+		cache.add("sha256:b", []byte("bbbb"))
+		cache.get("sha256:a")
+		cache.add("sha256:c", []byte("cccc"))
+
+		_, ok := cache.get("sha256:b")
+		Expect(ok).To(BeFalse())
+		_, ok = cache.get("sha256:a")
+		Expect(ok).To(BeTrue())
+		_, ok = cache.get("sha256:c")
+		Expect(ok).To(BeTrue())
+		Expect(cache.size).To(Equal(8))
+	})
+
+	It("should not cache charts exceeding the maximum size", func() {
+		cache.add("sha256:a", []byte("aaaaaaaaaaa"))
+
+		_, ok := cache.get("sha256:a")
+		Expect(ok).To(BeFalse())
+		Expect(cache.size).To(BeZero())
+	})
+})
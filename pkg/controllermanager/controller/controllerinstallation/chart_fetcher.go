@@ -0,0 +1,438 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/helm/pkg/repo"
+)
+
+const (
+	// maxChartSize is the maximum size of a chart tarball (and of a repository index) which is downloaded.
+	maxChartSize = 32 * 1024 * 1024
+	// maxChartCacheSize is the maximum total size of the chart tarballs which are cached.
+	maxChartCacheSize = 256 * 1024 * 1024
+
+	ociScheme                 = "oci://"
+	ociManifestMediaType      = "application/vnd.oci.image.manifest.v1+json"
+	helmChartContentMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	helmChartLegacyMediaType  = "application/tar+gzip"
+)
+
+var bearerChallengeParameterRegexp = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// ChartFetcher fetches the tarballs of Helm charts which are referenced by HelmChartReferences.
+type ChartFetcher interface {
+	// Fetch returns the chart tarball referenced by the given reference. The given credentials are optional.
+	Fetch(ctx context.Context, ref *HelmChartReference, credentials *RepositoryCredentials) ([]byte, error)
+}
+
+// RepositoryCredentials are the credentials used to authenticate against a Helm chart repository or an OCI registry.
+type RepositoryCredentials struct {
+	Username string
+	Password string
+}
+
+// hostCredentials are RepositoryCredentials which are only sent to the given host.
+type hostCredentials struct {
+	*RepositoryCredentials
+	host string
+}
+
+// NewChartFetcher creates a new ChartFetcher which uses the given HTTP client. Fetched charts whose digest is known
+// are cached by their digest, hence, they are only downloaded once as long as they are not evicted from the cache.
+func NewChartFetcher(httpClient *http.Client) ChartFetcher {
+	return &chartFetcher{
+		httpClient: httpClient,
+		cache:      newChartCache(maxChartCacheSize),
+	}
+}
+
+type chartFetcher struct {
+	httpClient *http.Client
+	cache      *chartCache
+}
+
+func (f *chartFetcher) Fetch(ctx context.Context, ref *HelmChartReference, credentials *RepositoryCredentials) ([]byte, error) {
+	var scopedCredentials *hostCredentials
+	if credentials != nil {
+		scopedCredentials = &hostCredentials{credentials, repositoryHost(ref.Repository)}
+	}
+
+	if strings.HasPrefix(ref.Repository, ociScheme) {
+		return f.fetchFromRegistry(ctx, ref, scopedCredentials)
+	}
+	return f.fetchFromRepository(ctx, ref, scopedCredentials)
+}
+
+// fetchFromRepository fetches a chart from a Helm chart repository. The chart is looked up in the index of the
+// repository.
+func (f *chartFetcher) fetchFromRepository(ctx context.Context, ref *HelmChartReference, credentials *hostCredentials) ([]byte, error) {
+	repositoryURL, err := url.Parse(strings.TrimSuffix(ref.Repository, "/") + "/")
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository URL %q: %v", ref.Repository, err)
+	}
+
+	indexData, err := f.get(ctx, repositoryURL.ResolveReference(&url.URL{Path: "index.yaml"}).String(), "", credentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch index of repository %q: %v", ref.Repository, err)
+	}
+
+	index := &repo.IndexFile{}
+	if err := yaml.Unmarshal(indexData, index); err != nil {
+		return nil, fmt.Errorf("could not decode index of repository %q: %v", ref.Repository, err)
+	}
+	index.SortEntries()
+
+	chartVersion, err := index.Get(ref.Name, ref.Version)
+	if err != nil {
+		return nil, fmt.Errorf("could not find chart %q with version %q in repository %q: %v", ref.Name, ref.Version, ref.Repository, err)
+	}
+	if len(chartVersion.URLs) == 0 {
+		return nil, fmt.Errorf("chart %q with version %q in repository %q has no URL", ref.Name, chartVersion.Version, ref.Repository)
+	}
+
+	chartURL, err := repositoryURL.Parse(chartVersion.URLs[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL of chart %q with version %q: %v", ref.Name, chartVersion.Version, err)
+	}
+
+	var digests []string
+	if len(ref.Digest) > 0 {
+		digests = append(digests, ref.Digest)
+	}
+	if len(chartVersion.Digest) > 0 {
+		digests = append(digests, chartVersion.Digest)
+	}
+
+	var digest string
+	if len(digests) > 0 {
+		digest = normalizeDigest(digests[0])
+	}
+
+	return f.fetchCached(digest, func() ([]byte, error) {
+		chart, err := f.get(ctx, chartURL.String(), "", credentials)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch chart %q with version %q: %v", ref.Name, chartVersion.Version, err)
+		}
+		for _, digest := range digests {
+			if err := verifyDigest(chart, digest); err != nil {
+				return nil, fmt.Errorf("could not verify chart %q with version %q: %v", ref.Name, chartVersion.Version, err)
+			}
+		}
+		return chart, nil
+	})
+}
+
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+// fetchFromRegistry fetches a chart from an OCI registry by means of the OCI distribution API. The version of the
+// chart is used as tag.
+func (f *chartFetcher) fetchFromRegistry(ctx context.Context, ref *HelmChartReference, credentials *hostCredentials) ([]byte, error) {
+	reference := strings.TrimPrefix(ref.Repository, ociScheme)
+
+	i := strings.Index(reference, "/")
+	if i <= 0 || i == len(reference)-1 {
+		return nil, fmt.Errorf("invalid OCI repository %q, expected %s<registry>/<repository>", ref.Repository, ociScheme)
+	}
+	var (
+		registry   = reference[:i]
+		repository = strings.TrimSuffix(reference[i+1:], "/")
+		baseURL    = fmt.Sprintf("https://%s/v2/%s", registry, repository)
+	)
+
+	manifestData, err := f.get(ctx, fmt.Sprintf("%s/manifests/%s", baseURL, ref.Version), ociManifestMediaType, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch manifest of %s:%s: %v", ref.Repository, ref.Version, err)
+	}
+
+	manifest := &ociManifest{}
+	if err := json.Unmarshal(manifestData, manifest); err != nil {
+		return nil, fmt.Errorf("could not decode manifest of %s:%s: %v", ref.Repository, ref.Version, err)
+	}
+
+	var layer *ociDescriptor
+	for i, l := range manifest.Layers {
+		if l.MediaType == helmChartContentMediaType || l.MediaType == helmChartLegacyMediaType {
+			layer = &manifest.Layers[i]
+			break
+		}
+	}
+	if layer == nil {
+		return nil, fmt.Errorf("manifest of %s:%s does not contain a Helm chart layer", ref.Repository, ref.Version)
+	}
+	if len(ref.Digest) > 0 && normalizeDigest(ref.Digest) != normalizeDigest(layer.Digest) {
+		return nil, fmt.Errorf("digest of %s:%s does not match: expected %s, got %s", ref.Repository, ref.Version, normalizeDigest(ref.Digest), normalizeDigest(layer.Digest))
+	}
+
+	return f.fetchCached(normalizeDigest(layer.Digest), func() ([]byte, error) {
+		chart, err := f.get(ctx, fmt.Sprintf("%s/blobs/%s", baseURL, layer.Digest), "", credentials)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch chart of %s:%s: %v", ref.Repository, ref.Version, err)
+		}
+		if err := verifyDigest(chart, layer.Digest); err != nil {
+			return nil, fmt.Errorf("could not verify chart of %s:%s: %v", ref.Repository, ref.Version, err)
+		}
+		return chart, nil
+	})
+}
+
+// fetchCached returns the cached chart with the given digest. If there is none then it is fetched with the given
+// function and added to the cache. Charts whose digest is unknown are not cached as their content might change.
+func (f *chartFetcher) fetchCached(digest string, fetch func() ([]byte, error)) ([]byte, error) {
+	if len(digest) > 0 {
+		if chart, ok := f.cache.get(digest); ok {
+			return chart, nil
+		}
+	}
+
+	chart, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(digest) > 0 {
+		f.cache.add(digest, chart)
+	}
+	return chart, nil
+}
+
+// get performs a GET request for the given URL. If the server responds with a bearer token challenge (as OCI
+// registries do) then a token is requested and the request is retried with it.
+func (f *chartFetcher) get(ctx context.Context, rawURL, accept string, credentials *hostCredentials) ([]byte, error) {
+	resp, err := f.do(ctx, rawURL, accept, credentials, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+
+		if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+			return nil, fmt.Errorf("unauthorized to GET %s", rawURL)
+		}
+
+		token, err := f.token(ctx, challenge, credentials)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp, err = f.do(ctx, rawURL, accept, nil, token); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for GET %s", resp.StatusCode, rawURL)
+	}
+	return readLimited(resp.Body)
+}
+
+// do performs a GET request for the given URL. The given credentials are only sent if the URL points to their host, as
+// the URLs might be taken from repository indices or bearer challenges.
+func (f *chartFetcher) do(ctx context.Context, rawURL, accept string, credentials *hostCredentials, token string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	if len(accept) > 0 {
+		req.Header.Set("Accept", accept)
+	}
+	if len(token) > 0 {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if credentials != nil && req.URL.Host == credentials.host {
+		req.SetBasicAuth(credentials.Username, credentials.Password)
+	}
+
+	return f.httpClient.Do(req)
+}
+
+// token requests a bearer token from the authorization service given in the challenge.
+func (f *chartFetcher) token(ctx context.Context, challenge string, credentials *hostCredentials) (string, error) {
+	parameters := map[string]string{}
+	for _, match := range bearerChallengeParameterRegexp.FindAllStringSubmatch(challenge, -1) {
+		parameters[strings.ToLower(match[1])] = match[2]
+	}
+
+	realm, ok := parameters["realm"]
+	if !ok {
+		return "", fmt.Errorf("bearer challenge %q does not contain a realm", challenge)
+	}
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", fmt.Errorf("invalid realm in bearer challenge %q: %v", challenge, err)
+	}
+
+	query := tokenURL.Query()
+	for _, key := range []string{"service", "scope"} {
+		if value, ok := parameters[key]; ok {
+			query.Set(key, value)
+		}
+	}
+	tokenURL.RawQuery = query.Encode()
+
+	resp, err := f.do(ctx, tokenURL.String(), "", credentials, "")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d when requesting a token from %s", resp.StatusCode, realm)
+	}
+
+	data, err := readLimited(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	tokenResponse := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.Unmarshal(data, &tokenResponse); err != nil {
+		return "", fmt.Errorf("could not decode token response from %s: %v", realm, err)
+	}
+	if len(tokenResponse.Token) > 0 {
+		return tokenResponse.Token, nil
+	}
+	if len(tokenResponse.AccessToken) > 0 {
+		return tokenResponse.AccessToken, nil
+	}
+	return "", fmt.Errorf("token response from %s does not contain a token", realm)
+}
+
+// RepositoryCredentialsFromSecret reads the credentials for the given repository from the given secret. The secret
+// must either contain a `username` and `password` or a `.dockerconfigjson` key. In the latter case the credentials
+// for the registry of the repository are used.
+func RepositoryCredentialsFromSecret(secret *corev1.Secret, repository string) (*RepositoryCredentials, error) {
+	if username, ok := secret.Data["username"]; ok {
+		return &RepositoryCredentials{
+			Username: string(username),
+			Password: string(secret.Data["password"]),
+		}, nil
+	}
+
+	dockerConfigJSON, ok := secret.Data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s neither contains a username nor a %s key", secret.Namespace, secret.Name, corev1.DockerConfigJsonKey)
+	}
+
+	dockerConfig := struct {
+		Auths map[string]struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+			Auth     string `json:"auth"`
+		} `json:"auths"`
+	}{}
+	if err := json.Unmarshal(dockerConfigJSON, &dockerConfig); err != nil {
+		return nil, fmt.Errorf("could not decode %s of secret %s/%s: %v", corev1.DockerConfigJsonKey, secret.Namespace, secret.Name, err)
+	}
+
+	host := repositoryHost(repository)
+	for server, auth := range dockerConfig.Auths {
+		if repositoryHost(server) != host {
+			continue
+		}
+
+		if len(auth.Username) > 0 {
+			return &RepositoryCredentials{Username: auth.Username, Password: auth.Password}, nil
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode auth for %s in secret %s/%s: %v", server, secret.Namespace, secret.Name, err)
+		}
+		parts := strings.SplitN(string(decoded), ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid auth for %s in secret %s/%s", server, secret.Namespace, secret.Name)
+		}
+		return &RepositoryCredentials{Username: parts[0], Password: parts[1]}, nil
+	}
+
+	return nil, fmt.Errorf("secret %s/%s does not contain credentials for %s", secret.Namespace, secret.Name, host)
+}
+
+// repositoryHost returns the host of the given repository URL, OCI reference or docker config server.
+func repositoryHost(repository string) string {
+	for _, prefix := range []string{ociScheme, "https://", "http://"} {
+		repository = strings.TrimPrefix(repository, prefix)
+	}
+	if i := strings.Index(repository, "/"); i >= 0 {
+		repository = repository[:i]
+	}
+	return repository
+}
+
+// verifyDigest verifies that the given data has the given sha256 digest. The digest may be given with or without the
+// algorithm prefix.
+func verifyDigest(data []byte, digest string) error {
+	expected := normalizeDigest(digest)
+	if !strings.HasPrefix(expected, "sha256:") {
+		return fmt.Errorf("unsupported digest %q, only sha256 is supported", digest)
+	}
+
+	sum := sha256.Sum256(data)
+	if actual := "sha256:" + hex.EncodeToString(sum[:]); actual != expected {
+		return fmt.Errorf("digest mismatch: expected %s, got %s", expected, actual)
+	}
+	return nil
+}
+
+// normalizeDigest returns the given digest in the form <algorithm>:<hex>. Digests without algorithm are considered to
+// be sha256 digests (as published in Helm repository indices).
+func normalizeDigest(digest string) string {
+	digest = strings.ToLower(digest)
+	if !strings.Contains(digest, ":") {
+		return "sha256:" + digest
+	}
+	return digest
+}
+
+func readLimited(r io.Reader) ([]byte, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxChartSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxChartSize {
+		return nil, fmt.Errorf("response exceeds the maximum size of %d bytes", maxChartSize)
+	}
+	return data, nil
+}
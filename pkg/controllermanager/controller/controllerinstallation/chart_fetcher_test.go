@@ -0,0 +1,289 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("ChartFetcher", func() {
+	var (
+		ctx   = context.TODO()
+		chart = []byte("chart-tarball")
+
+		sum         = sha256.Sum256(chart)
+		chartDigest = "sha256:" + hex.EncodeToString(sum[:])
+
+		requests map[string]int
+	)
+
+	BeforeEach(func() {
+		requests = map[string]int{}
+	})
+
+	Describe("Helm chart repository", func() {
+		var (
+			server       *httptest.Server
+			indexDigest  string
+			fetcher      ChartFetcher
+			username     string
+			password     string
+			requireLogin bool
+			externalURL  string
+		)
+
+		BeforeEach(func() {
+			indexDigest = hex.EncodeToString(sum[:])
+			requireLogin = false
+			username, password = "user", "pass"
+			externalURL = "http://127.0.0.1:0/extension-1.0.0.tgz"
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests[r.URL.Path]++
+
+				if requireLogin {
+					if u, p, ok := r.BasicAuth(); !ok || u != username || p != password {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+				}
+
+				switch r.URL.Path {
+				case "/charts/index.yaml":
+					fmt.Fprintf(w, `apiVersion: v1
+entries:
+  extension:
+  - name: extension
+    version: 1.1.0
+    digest: %s
+    urls:
+    - extension-1.1.0.tgz
+  - name: extension
+    version: 1.0.0
+    urls:
+    - extension-1.0.0.tgz
+  external:
+  - name: external
+    version: 1.0.0
+    urls:
+    - %s
+`, indexDigest, externalURL)
+				case "/charts/extension-1.1.0.tgz", "/charts/extension-1.0.0.tgz":
+					w.Write(chart)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			fetcher = NewChartFetcher(server.Client())
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should fetch the chart and cache it", func() {
+			ref := &HelmChartReference{Repository: server.URL + "/charts", Name: "extension", Version: "1.1.0"}
+
+			for i := 0; i < 2; i++ {
+				data, err := fetcher.Fetch(ctx, ref, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(data).To(Equal(chart))
+			}
+
+			Expect(requests["/charts/index.yaml"]).To(Equal(2))
+			Expect(requests["/charts/extension-1.1.0.tgz"]).To(Equal(1))
+		})
+
+		It("should not cache charts without digest", func() {
+			ref := &HelmChartReference{Repository: server.URL + "/charts", Name: "extension", Version: "1.0.0"}
+
+			for i := 0; i < 2; i++ {
+				_, err := fetcher.Fetch(ctx, ref, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(requests["/charts/extension-1.0.0.tgz"]).To(Equal(2))
+		})
+
+		It("should resolve version constraints", func() {
+			_, err := fetcher.Fetch(ctx, &HelmChartReference{Repository: server.URL + "/charts", Name: "extension", Version: "~1.0"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requests["/charts/extension-1.0.0.tgz"]).To(Equal(1))
+		})
+
+		It("should verify the given digest", func() {
+			_, err := fetcher.Fetch(ctx, &HelmChartReference{Repository: server.URL + "/charts", Name: "extension", Version: "1.0.0", Digest: chartDigest}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = fetcher.Fetch(ctx, &HelmChartReference{Repository: server.URL + "/charts", Name: "extension", Version: "1.0.0", Digest: "sha256:0000"}, nil)
+			Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+		})
+
+		It("should verify the digest published in the index", func() {
+			indexDigest = strings.Repeat("0", 64)
+
+			_, err := fetcher.Fetch(ctx, &HelmChartReference{Repository: server.URL + "/charts", Name: "extension", Version: "1.1.0"}, nil)
+			Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+		})
+
+		It("should fail for unknown charts", func() {
+			_, err := fetcher.Fetch(ctx, &HelmChartReference{Repository: server.URL + "/charts", Name: "foo", Version: "1.0.0"}, nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should authenticate with the given credentials", func() {
+			requireLogin = true
+			ref := &HelmChartReference{Repository: server.URL + "/charts", Name: "extension", Version: "1.1.0"}
+
+			_, err := fetcher.Fetch(ctx, ref, nil)
+			Expect(err).To(HaveOccurred())
+
+			data, err := fetcher.Fetch(ctx, ref, &RepositoryCredentials{Username: username, Password: password})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(chart))
+		})
+
+		It("should not send the credentials to other hosts", func() {
+			var authorization string
+			external := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization = r.Header.Get("Authorization")
+				w.Write(chart)
+			}))
+			defer external.Close()
+			externalURL = external.URL + "/extension-1.0.0.tgz"
+
+			data, err := fetcher.Fetch(ctx, &HelmChartReference{Repository: server.URL + "/charts", Name: "external", Version: "1.0.0"}, &RepositoryCredentials{Username: username, Password: password})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(chart))
+			Expect(authorization).To(BeEmpty())
+		})
+	})
+
+	Describe("OCI registry", func() {
+		var (
+			server  *httptest.Server
+			fetcher ChartFetcher
+			ref     *HelmChartReference
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests[r.URL.Path]++
+
+				if r.URL.Path == "/token" {
+					if u, p, ok := r.BasicAuth(); !ok || u != "user" || p != "pass" || r.URL.Query().Get("scope") != "repository:extensions/extension:pull" {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					fmt.Fprint(w, `{"token":"secret-token"}`)
+					return
+				}
+
+				if r.Header.Get("Authorization") != "Bearer secret-token" {
+					w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="https://%s/token",service="registry",scope="repository:extensions/extension:pull"`, r.Host))
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				switch r.URL.Path {
+				case "/v2/extensions/extension/manifests/1.0.0":
+					Expect(r.Header.Get("Accept")).To(Equal("application/vnd.oci.image.manifest.v1+json"))
+					fmt.Fprintf(w, `{"schemaVersion":2,"layers":[{"mediaType":"application/vnd.cncf.helm.chart.content.v1.tar+gzip","digest":%q}]}`, chartDigest)
+				case "/v2/extensions/extension/blobs/" + chartDigest:
+					w.Write(chart)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			fetcher = NewChartFetcher(server.Client())
+			ref = &HelmChartReference{Repository: "oci://" + strings.TrimPrefix(server.URL, "https://") + "/extensions/extension", Version: "1.0.0"}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should fetch the chart with a token and cache it", func() {
+			credentials := &RepositoryCredentials{Username: "user", Password: "pass"}
+
+			for i := 0; i < 2; i++ {
+				data, err := fetcher.Fetch(ctx, ref, credentials)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(data).To(Equal(chart))
+			}
+
+			Expect(requests["/v2/extensions/extension/manifests/1.0.0"]).To(Equal(4))
+			Expect(requests["/v2/extensions/extension/blobs/"+chartDigest]).To(Equal(2))
+		})
+
+		It("should fail without credentials", func() {
+			_, err := fetcher.Fetch(ctx, ref, nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the digest does not match", func() {
+			ref.Digest = "sha256:0000"
+
+			_, err := fetcher.Fetch(ctx, ref, &RepositoryCredentials{Username: "user", Password: "pass"})
+			Expect(err).To(MatchError(ContainSubstring("does not match")))
+		})
+
+		It("should fail for unknown versions", func() {
+			ref.Version = "2.0.0"
+
+			_, err := fetcher.Fetch(ctx, ref, &RepositoryCredentials{Username: "user", Password: "pass"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#RepositoryCredentialsFromSecret", func() {
+		It("should read username and password", func() {
+			secret := &corev1.Secret{Data: map[string][]byte{"username": []byte("user"), "password": []byte("pass")}}
+
+			Expect(RepositoryCredentialsFromSecret(secret, "https://charts.example.com")).To(Equal(&RepositoryCredentials{Username: "user", Password: "pass"}))
+		})
+
+		It("should read the credentials of the registry from a docker config", func() {
+			auth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+			secret := &corev1.Secret{Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(fmt.Sprintf(`{"auths":{"https://other.example.com":{"username":"foo","password":"bar"},"registry.example.com":{"auth":%q}}}`, auth)),
+			}}
+
+			Expect(RepositoryCredentialsFromSecret(secret, "oci://registry.example.com/extensions/extension")).To(Equal(&RepositoryCredentials{Username: "user", Password: "pass"}))
+		})
+
+		It("should fail if there are no credentials for the registry", func() {
+			secret := &corev1.Secret{Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{"other.example.com":{"username":"foo","password":"bar"}}}`),
+			}}
+
+			_, err := RepositoryCredentialsFromSecret(secret, "oci://registry.example.com/extensions/extension")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -16,6 +16,7 @@ package controllerinstallation
 
 import (
 	"context"
+	"net/http"
 	"sync"
 	"time"
 
@@ -34,8 +35,19 @@ import (
 	"k8s.io/client-go/util/workqueue"
 )
 
-// FinalizerName is the name of the ControllerInstallation finalizer.
-const FinalizerName = "core.gardener.cloud/controllerinstallation"
+const (
+	// FinalizerName is the name of the ControllerInstallation finalizer.
+	FinalizerName = "core.gardener.cloud/controllerinstallation"
+
+	// chartFetchTimeout is the timeout for requests to Helm chart repositories and OCI registries.
+	chartFetchTimeout = 2 * time.Minute
+
+	// pinnedRegistrationSpecSecretName is the name of the secret in the namespace of a ControllerInstallation in the
+	// seed which contains the ControllerRegistration specification the installation has been deployed with.
+	pinnedRegistrationSpecSecretName = "controllerregistration-spec"
+	// pinnedRegistrationSpecDataKey is the data key of the pinned ControllerRegistration specification.
+	pinnedRegistrationSpecDataKey = "spec"
+)
 
 // Controller controls ControllerInstallation.
 type Controller struct {
@@ -45,6 +57,7 @@ type Controller struct {
 	config *config.ControllerManagerConfiguration
 
 	controllerInstallationControl ControlInterface
+	careControl                   CareControlInterface
 
 	recorder record.EventRecorder
 
@@ -55,9 +68,10 @@ type Controller struct {
 	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 	controllerRegistrationSynced cache.InformerSynced
 
-	controllerInstallationQueue  workqueue.RateLimitingInterface
-	controllerInstallationLister gardencorelisters.ControllerInstallationLister
-	controllerInstallationSynced cache.InformerSynced
+	controllerInstallationQueue     workqueue.RateLimitingInterface
+	controllerInstallationCareQueue workqueue.RateLimitingInterface
+	controllerInstallationLister    gardencorelisters.ControllerInstallationLister
+	controllerInstallationSynced    cache.InformerSynced
 
 	workerCh               chan int
 	numberOfRunningWorkers int
@@ -78,20 +92,28 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenCoreInformerFacto
 		controllerInstallationInformer = gardenCoreInformer.ControllerInstallations()
 		controllerInstallationLister   = controllerInstallationInformer.Lister()
 		controllerInstallationQueue    = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "controllerinstallation")
+
+		deploymentTypes = map[string]DeploymentType{
+			DeploymentTypeHelm:      NewHelmDeploymentType(k8sGardenClient.Client(), NewChartFetcher(&http.Client{Timeout: chartFetchTimeout})),
+			DeploymentTypeManifest:  NewManifestDeploymentType(),
+			DeploymentTypeKustomize: NewKustomizeDeploymentType(),
+		}
 	)
 
 	controller := &Controller{
 		k8sGardenClient:               k8sGardenClient,
 		k8sGardenCoreInformers:        gardenCoreInformerFactory,
-		controllerInstallationControl: NewDefaultControllerInstallationControl(k8sGardenClient, gardenCoreInformerFactory, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister, gardenNamespace),
+		controllerInstallationControl: NewDefaultControllerInstallationControl(k8sGardenClient, gardenCoreInformerFactory, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister, gardenNamespace, deploymentTypes),
+		careControl:                   NewDefaultCareControl(k8sGardenClient, config, seedLister, controllerRegistrationLister, deploymentTypes),
 		config:                        config,
 		recorder:                      recorder,
 
 		seedLister: seedLister,
 		seedQueue:  seedQueue,
 
-		controllerInstallationLister: controllerInstallationLister,
-		controllerInstallationQueue:  controllerInstallationQueue,
+		controllerInstallationLister:    controllerInstallationLister,
+		controllerInstallationQueue:     controllerInstallationQueue,
+		controllerInstallationCareQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "controllerinstallation-care"),
 
 		workerCh: make(chan int),
 	}
@@ -104,6 +126,9 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenCoreInformerFacto
 		UpdateFunc: controller.controllerInstallationUpdate,
 		DeleteFunc: controller.controllerInstallationDelete,
 	})
+	controllerInstallationInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.controllerInstallationCareAdd,
+	})
 	controller.controllerInstallationSynced = controllerInstallationInformer.Informer().HasSynced
 
 	return controller
@@ -132,18 +157,20 @@ func (c *Controller) Run(ctx context.Context, workers int) {
 
 	for i := 0; i < workers; i++ {
 		controllerutils.DeprecatedCreateWorker(ctx, c.controllerInstallationQueue, "ControllerInstallation", c.reconcileControllerInstallationKey, &waitGroup, c.workerCh)
+		controllerutils.DeprecatedCreateWorker(ctx, c.controllerInstallationCareQueue, "ControllerInstallation Care", c.reconcileControllerInstallationCareKey, &waitGroup, c.workerCh)
 	}
 
 	// Shutdown handling
 	<-ctx.Done()
 	c.controllerInstallationQueue.ShutDown()
+	c.controllerInstallationCareQueue.ShutDown()
 
 	for {
-		if c.controllerInstallationQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+		if c.controllerInstallationQueue.Len() == 0 && c.controllerInstallationCareQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Debug("No running ControllerInstallation worker and no items left in the queues. Terminated ControllerInstallation controller...")
 			break
 		}
-		logger.Logger.Debugf("Waiting for %d ControllerInstallation worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.controllerInstallationQueue.Len())
+		logger.Logger.Debugf("Waiting for %d ControllerInstallation worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.controllerInstallationQueue.Len()+c.controllerInstallationCareQueue.Len())
 		time.Sleep(5 * time.Second)
 	}
 
@@ -0,0 +1,161 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/logger"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (c *Controller) controllerInstallationCareAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	c.controllerInstallationCareQueue.Add(key)
+}
+
+func (c *Controller) reconcileControllerInstallationCareKey(key string) error {
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	controllerInstallation, err := c.controllerInstallationLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[CONTROLLERINSTALLATION CARE] Stopping care operations for ControllerInstallation %s since it has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[CONTROLLERINSTALLATION CARE] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+
+	if err := c.careControl.Care(controllerInstallation); err != nil {
+		return err
+	}
+
+	c.controllerInstallationCareQueue.AddAfter(key, c.config.Controllers.ControllerInstallation.HealthCheckPeriod.Duration)
+	return nil
+}
+
+// CareControlInterface implements the control logic for caring for ControllerInstallations. It is implemented as an
+// interface to allow for extensions that provide different semantics. Currently, there is only one implementation.
+type CareControlInterface interface {
+	Care(controllerInstallation *gardencorev1alpha1.ControllerInstallation) error
+}
+
+// NewDefaultCareControl returns a new instance of the default implementation CareControlInterface that implements the
+// documented semantics for caring for ControllerInstallations. You should use an instance returned from
+// NewDefaultCareControl() for any scenario other than testing.
+func NewDefaultCareControl(k8sGardenClient kubernetes.Interface, config *config.ControllerManagerConfiguration, seedLister gardencorelisters.SeedLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, deploymentTypes map[string]DeploymentType) CareControlInterface {
+	return &defaultCareControl{k8sGardenClient, config, seedLister, controllerRegistrationLister, deploymentTypes}
+}
+
+type defaultCareControl struct {
+	k8sGardenClient              kubernetes.Interface
+	config                       *config.ControllerManagerConfiguration
+	seedLister                   gardencorelisters.SeedLister
+	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
+	deploymentTypes              map[string]DeploymentType
+}
+
+// Care computes the Healthy condition of the given ControllerInstallation based on the resources which have been
+// deployed to the seed. ControllerInstallations which are deployed by other parties are responsible for their Healthy
+// condition themselves.
+func (c *defaultCareControl) Care(obj *gardencorev1alpha1.ControllerInstallation) error {
+	var (
+		ctx                    = context.TODO()
+		controllerInstallation = obj.DeepCopy()
+		logger                 = logger.NewFieldLogger(logger.Logger, "controllerinstallation", controllerInstallation.Name)
+	)
+
+	if controllerInstallation.DeletionTimestamp != nil {
+		return nil
+	}
+
+	controllerRegistration, err := c.controllerRegistrationLister.Get(controllerInstallation.Spec.RegistrationRef.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if deployment := controllerRegistration.Spec.Deployment; deployment == nil || c.deploymentTypes[deployment.Type] == nil {
+		return nil
+	}
+
+	conditionHealthy := gardencorev1alpha1helper.GetOrInitCondition(controllerInstallation.Status.Conditions, gardencorev1alpha1.ControllerInstallationHealthy)
+
+	if conditionInstalled := gardencorev1alpha1helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1alpha1.ControllerInstallationInstalled); conditionInstalled == nil || conditionInstalled.Status != gardencorev1alpha1.ConditionTrue {
+		conditionHealthy = gardencorev1alpha1helper.UpdatedCondition(conditionHealthy, gardencorev1alpha1.ConditionUnknown, "NotInstalled", "The controller has not been installed successfully yet.")
+		return c.updateHealthyCondition(controllerInstallation, conditionHealthy)
+	}
+
+	var deployedResources DeployedResources
+	if providerStatus := controllerInstallation.Status.ProviderStatus; providerStatus != nil {
+		if err := json.Unmarshal(providerStatus.Raw, &deployedResources); err != nil {
+			conditionHealthy = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionHealthy, fmt.Sprintf("Deployed resources cannot be read from the provider status: %+v", err))
+			return c.updateHealthyCondition(controllerInstallation, conditionHealthy)
+		}
+	}
+
+	seed, err := c.seedLister.Get(controllerInstallation.Spec.SeedRef.Name)
+	if err != nil {
+		return err
+	}
+
+	k8sSeedClient, err := kubernetes.NewClientFromSecret(c.k8sGardenClient, seed.Spec.SecretRef.Namespace, seed.Spec.SecretRef.Name,
+		kubernetes.WithClientConnectionOptions(c.config.SeedClientConnection),
+		kubernetes.WithClientOptions(client.Options{
+			Scheme: kubernetes.SeedScheme,
+		}),
+	)
+	if err != nil {
+		logger.Errorf("Could not create a client for the Seed cluster: %v", err)
+		conditionHealthy = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionHealthy, fmt.Sprintf("Could not create a client for the Seed cluster: %v", err))
+		return c.updateHealthyCondition(controllerInstallation, conditionHealthy)
+	}
+
+	conditionHealthy = CheckDeployedResources(ctx, k8sSeedClient.Client(), deployedResources.Resources, conditionHealthy)
+	return c.updateHealthyCondition(controllerInstallation, conditionHealthy)
+}
+
+func (c *defaultCareControl) updateHealthyCondition(controllerInstallation *gardencorev1alpha1.ControllerInstallation, conditionHealthy gardencorev1alpha1.Condition) error {
+	_, err := kutil.TryUpdateControllerInstallationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta,
+		func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
+			controllerInstallation.Status.Conditions = gardencorev1alpha1helper.MergeConditions(controllerInstallation.Status.Conditions, conditionHealthy)
+			return controllerInstallation, nil
+		}, func(cur, updated *gardencorev1alpha1.ControllerInstallation) bool {
+			return equality.Semantic.DeepEqual(cur.Status.Conditions, updated.Status.Conditions)
+		},
+	)
+	return err
+}
@@ -19,29 +19,32 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-	"github.com/gardener/gardener/pkg/chartrenderer"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/logger"
-	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/yaml"
@@ -51,8 +54,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const installationTypeHelm = "helm"
-
 func (c *Controller) controllerInstallationAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
@@ -69,6 +70,12 @@ func (c *Controller) controllerInstallationUpdate(oldObj, newObj interface{}) {
 		return
 	}
 
+	// Installations on the same seed may depend on this installation, hence, they are re-evaluated as soon as it has been
+	// installed.
+	if installedStatus(old) != installedStatus(new) {
+		c.enqueueSeedControllerInstallations(new.Spec.SeedRef.Name, new.Name)
+	}
+
 	if new.DeletionTimestamp == nil && old.Spec.RegistrationRef.ResourceVersion == new.Spec.RegistrationRef.ResourceVersion && old.Spec.SeedRef.ResourceVersion == new.Spec.SeedRef.ResourceVersion {
 		return
 	}
@@ -83,6 +90,37 @@ func (c *Controller) controllerInstallationDelete(obj interface{}) {
 		return
 	}
 	c.controllerInstallationQueue.Add(key)
+
+	// The deletion of installations on the same seed may have been blocked by this installation.
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if controllerInstallation, ok := obj.(*gardencorev1alpha1.ControllerInstallation); ok {
+		c.enqueueSeedControllerInstallations(controllerInstallation.Spec.SeedRef.Name, controllerInstallation.Name)
+	}
+}
+
+// enqueueSeedControllerInstallations adds all ControllerInstallations of the given seed except the one with the given
+// name to the queue.
+func (c *Controller) enqueueSeedControllerInstallations(seedName, except string) {
+	controllerInstallations, err := c.controllerInstallationLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("Couldn't list ControllerInstallations: %v", err)
+		return
+	}
+
+	for _, controllerInstallation := range controllerInstallations {
+		if controllerInstallation.Spec.SeedRef.Name == seedName && controllerInstallation.Name != except {
+			c.controllerInstallationAdd(controllerInstallation)
+		}
+	}
+}
+
+func installedStatus(controllerInstallation *gardencorev1alpha1.ControllerInstallation) gardencorev1alpha1.ConditionStatus {
+	if condition := helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1alpha1.ControllerInstallationInstalled); condition != nil {
+		return condition.Status
+	}
+	return gardencorev1alpha1.ConditionUnknown
 }
 
 func (c *Controller) reconcileControllerInstallationKey(key string) error {
@@ -113,8 +151,8 @@ type ControlInterface interface {
 // NewDefaultControllerInstallationControl returns a new instance of the default implementation ControlInterface that
 // implements the documented semantics for ControllerInstallations. You should use an instance returned from
 // NewDefaultControllerInstallationControl() for any scenario other than testing.
-func NewDefaultControllerInstallationControl(k8sGardenClient kubernetes.Interface, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, seedLister gardencorelisters.SeedLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister, gardenNamespace *corev1.Namespace) ControlInterface {
-	return &defaultControllerInstallationControl{k8sGardenClient, k8sGardenCoreInformers, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister, gardenNamespace}
+func NewDefaultControllerInstallationControl(k8sGardenClient kubernetes.Interface, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, seedLister gardencorelisters.SeedLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister, gardenNamespace *corev1.Namespace, deploymentTypes map[string]DeploymentType) ControlInterface {
+	return &defaultControllerInstallationControl{k8sGardenClient, k8sGardenCoreInformers, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister, gardenNamespace, deploymentTypes}
 }
 
 type defaultControllerInstallationControl struct {
@@ -126,6 +164,7 @@ type defaultControllerInstallationControl struct {
 	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 	controllerInstallationLister gardencorelisters.ControllerInstallationLister
 	gardenNamespace              *corev1.Namespace
+	deploymentTypes              map[string]DeploymentType
 }
 
 func (c *defaultControllerInstallationControl) Reconcile(obj *gardencorev1alpha1.ControllerInstallation) error {
@@ -182,6 +221,11 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
+	registrationSpecHash, err := helper.ComputeControllerRegistrationSpecHash(controllerRegistration.Spec)
+	if err != nil {
+		return err
+	}
+
 	seed, err := c.seedLister.Get(controllerInstallation.Spec.SeedRef.Name)
 	if err != nil {
 		return err
@@ -201,19 +245,46 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		}
 		return err
 	}
-	chartRenderer, err := chartrenderer.NewForConfig(k8sSeedClient.RESTConfig())
-	if err != nil {
-		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionUnknown, "ChartRendererCreationFailed", fmt.Sprintf("ChartRenderer cannot be recreated for referenced Seed: %+v", err))
-		return err
+
+	namespace := getNamespaceForControllerInstallation(controllerInstallation)
+
+	// The ControllerRegistration controller rolls out changes of the registration seed by seed and updates the
+	// registration spec hash label of the installation once its seed is due. Until then, the installation is reconciled
+	// with the specification it has been deployed with before.
+	hash, ok := controllerInstallation.Labels[common.RegistrationSpecHash]
+	pinned := ok && hash != registrationSpecHash
+	if pinned {
+		spec, err := pinnedRegistrationSpec(ctx, k8sSeedClient.Client(), namespace.Name, hash)
+		if err != nil {
+			return fmt.Errorf("the rollout of the current ControllerRegistration specification has not reached this installation yet, and its previous specification cannot be read: %v", err)
+		}
+		logger.Infof("Reconciling the pinned ControllerRegistration specification because the rollout of the current specification has not reached this installation yet")
+
+		controllerRegistration = controllerRegistration.DeepCopy()
+		controllerRegistration.Spec = *spec
 	}
 
-	var helmDeployment HelmDeployment
-	if err := json.Unmarshal(controllerRegistration.Spec.Deployment.ProviderConfig.Raw, &helmDeployment); err != nil {
-		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "ChartInformationInvalid", fmt.Sprintf("Chart Information cannot be unmarshalled: %+v", err))
-		return err
+	if len(controllerRegistration.Spec.Dependencies) > 0 {
+		controllerRegistrations, err := c.controllerRegistrationLister.List(labels.Everything())
+		if err != nil {
+			return err
+		}
+		controllerInstallations, err := c.controllerInstallationLister.List(labels.Everything())
+		if err != nil {
+			return err
+		}
+		if err := checkDependenciesInstalled(controllerRegistration, seed.Name, controllerRegistrations, controllerInstallations); err != nil {
+			conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionUnknown, "DependenciesNotInstalled", err.Error())
+			return err
+		}
+	}
+
+	deploymentType, ok := c.deploymentTypes[controllerRegistration.Spec.Deployment.Type]
+	if !ok {
+		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "DeploymentTypeUnsupported", fmt.Sprintf("Deployment type %q is not supported", controllerRegistration.Spec.Deployment.Type))
+		return fmt.Errorf("deployment type %q is not supported", controllerRegistration.Spec.Deployment.Type)
 	}
 
-	namespace := getNamespaceForControllerInstallation(controllerInstallation)
 	if err := kutil.CreateOrUpdate(ctx, k8sSeedClient.Client(), namespace, func() error {
 		kutil.SetMetaDataLabel(&namespace.ObjectMeta, v1alpha1constants.GardenRole, v1alpha1constants.GardenRoleExtension)
 		kutil.SetMetaDataLabel(&namespace.ObjectMeta, v1alpha1constants.LabelControllerRegistrationName, controllerRegistration.Name)
@@ -254,15 +325,31 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		},
 	}
 
-	release, err := chartRenderer.RenderArchive(helmDeployment.Chart, controllerRegistration.Name, namespace.Name, utils.MergeMaps(helmDeployment.Values, seedValues))
+	manifest, err := deploymentType.Render(ctx, &DeploymentContext{
+		ControllerRegistration: controllerRegistration,
+		Seed:                   seed,
+		SeedClient:             k8sSeedClient,
+		Namespace:              namespace.Name,
+		Values:                 seedValues,
+	})
 	if err != nil {
-		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "ChartCannotBeRendered", fmt.Sprintf("Chart rendering process failed: %+v", err))
+		reason := "DeploymentCannotBeRendered"
+		if deploymentErr, ok := err.(*DeploymentError); ok {
+			reason = deploymentErr.Reason
+		}
+		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, reason, err.Error())
 		return err
 	}
-	conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionTrue, "RegistrationValid", "Chart could be rendered successfully.")
+	conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionTrue, "RegistrationValid", fmt.Sprintf("Deployment of type %q could be rendered successfully.", controllerRegistration.Spec.Deployment.Type))
+
+	if !pinned {
+		if err := pinRegistrationSpec(ctx, k8sSeedClient.Client(), namespace.Name, controllerRegistration.Spec, registrationSpecHash); err != nil {
+			conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionFalse, "InstallationFailed", fmt.Sprintf("Could not store the deployed ControllerRegistration specification: %+v", err))
+			return err
+		}
+	}
 
 	var (
-		manifest        = release.Manifest()
 		newResources    DeployedResources
 		newResourcesSet = sets.NewString()
 
@@ -320,7 +407,7 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
-	if err := k8sSeedClient.Applier().ApplyManifest(context.TODO(), kubernetes.NewManifestReader(release.Manifest()), kubernetes.DefaultApplierOptions); err != nil {
+	if err := k8sSeedClient.Applier().ApplyManifest(context.TODO(), kubernetes.NewManifestReader(manifest), kubernetes.DefaultApplierOptions); err != nil {
 		conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionFalse, "InstallationFailed", fmt.Sprintf("Installation of new resources failed: %+v", err))
 		return err
 	}
@@ -373,6 +460,35 @@ func (c *defaultControllerInstallationControl) delete(controllerInstallation *ga
 		return err
 	}
 
+	// Controllers are only uninstalled once all controllers on the same seed which depend on them are gone.
+	controllerRegistrations, err := c.controllerRegistrationLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	controllerInstallations, err := c.controllerInstallationLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	if dependents := dependentControllerInstallations(controllerRegistration, seed.Name, controllerRegistrations, controllerInstallations); len(dependents) > 0 {
+		conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionTrue, "DeletionBlocked", fmt.Sprintf("Deletion is blocked by the dependent ControllerInstallations %s.", strings.Join(dependents, ", ")))
+		return fmt.Errorf("deletion is blocked by the dependent ControllerInstallations %s", strings.Join(dependents, ", "))
+	}
+
+	// If neither the seed nor the registration is deleted then the installation is deleted because the seed is no longer
+	// selected by the registration. The controller is only uninstalled once the seed does not contain any extension
+	// resources it is responsible for anymore.
+	if seed.DeletionTimestamp == nil && controllerRegistration.DeletionTimestamp == nil {
+		extensionResources, err := extensionResourcesInUse(ctx, k8sSeedClient.Client(), controllerRegistration)
+		if err != nil {
+			conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionUnknown, "DeletionFailed", fmt.Sprintf("Extension resources cannot be read: %+v", err))
+			return err
+		}
+		if len(extensionResources) > 0 {
+			conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionTrue, "DeletionBlocked", fmt.Sprintf("Deletion is blocked by the extension resources %s which still exist on the seed.", strings.Join(extensionResources, ", ")))
+			return fmt.Errorf("deletion is blocked by the extension resources %s which still exist on the seed", strings.Join(extensionResources, ", "))
+		}
+	}
+
 	if err := c.cleanOldExtensions(ctx, k8sSeedClient.Client(), controllerRegistration); err != nil {
 		if isDeletionInProgressError(err) {
 			conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionFalse, "DeletionPending", err.Error())
@@ -409,10 +525,11 @@ func (c *defaultControllerInstallationControl) delete(controllerInstallation *ga
 func (c *defaultControllerInstallationControl) updateConditions(controllerInstallation *gardencorev1alpha1.ControllerInstallation, conditions ...gardencorev1alpha1.Condition) (*gardencorev1alpha1.ControllerInstallation, error) {
 	return kutil.TryUpdateControllerInstallationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta,
 		func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
-			controllerInstallation.Status.Conditions = conditions
+			controllerInstallation.Status.Conditions = helper.MergeConditions(controllerInstallation.Status.Conditions, conditions...)
+			controllerInstallation.Status.ObservedGeneration = controllerInstallation.Generation
 			return controllerInstallation, nil
 		}, func(cur, updated *gardencorev1alpha1.ControllerInstallation) bool {
-			return equality.Semantic.DeepEqual(cur.Status.Conditions, updated.Status.Conditions)
+			return equality.Semantic.DeepEqual(cur.Status, updated.Status)
 		},
 	)
 }
@@ -424,11 +541,42 @@ func (c *defaultControllerInstallationControl) isResponsible(controllerInstallat
 	}
 
 	if deployment := controllerRegistration.Spec.Deployment; deployment != nil {
-		return deployment.Type == installationTypeHelm, nil
+		_, ok := c.deploymentTypes[deployment.Type]
+		return ok, nil
 	}
 	return false, nil
 }
 
+// extensionResourcesInUse returns the extension resources on the seed whose kinds and types are supported by the given
+// ControllerRegistration. Kinds which are not known by the seed are ignored.
+func extensionResourcesInUse(ctx context.Context, seedClient client.Client, controllerRegistration *gardencorev1alpha1.ControllerRegistration) ([]string, error) {
+	var extensionResources []string
+
+	for _, resource := range controllerRegistration.Spec.Resources {
+		groupVersion := extensionsv1alpha1.SchemeGroupVersion
+		if resource.Kind == dnsv1alpha1.DNSProviderKind {
+			groupVersion = dnsv1alpha1.SchemeGroupVersion
+		}
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(groupVersion.WithKind(resource.Kind + "List"))
+		if err := seedClient.List(ctx, list); err != nil {
+			if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, item := range list.Items {
+			if extensionType, _, _ := unstructured.NestedString(item.Object, "spec", "type"); strings.EqualFold(extensionType, resource.Type) {
+				extensionResources = append(extensionResources, fmt.Sprintf("%s %s/%s", resource.Kind, item.GetNamespace(), item.GetName()))
+			}
+		}
+	}
+
+	return extensionResources, nil
+}
+
 func (c *defaultControllerInstallationControl) cleanOldExtensions(ctx context.Context, seedClient client.Client, controllerRegistration *gardencorev1alpha1.ControllerRegistration) error {
 	var (
 		fns               []flow.TaskFn
@@ -538,6 +686,41 @@ func objectReferenceToString(o corev1.ObjectReference) string {
 	return fmt.Sprintf("%s/%s/%s/%s", o.APIVersion, o.Kind, o.Namespace, o.Name)
 }
 
+// pinRegistrationSpec stores the given ControllerRegistration specification with the given hash in the given namespace
+// of the seed. It is used to reconcile the installation as long as a newer specification has not been rolled out to
+// the seed.
+func pinRegistrationSpec(ctx context.Context, c client.Client, namespace string, spec gardencorev1alpha1.ControllerRegistrationSpec, hash string) error {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: pinnedRegistrationSpecSecretName, Namespace: namespace}}
+	return kutil.CreateOrUpdate(ctx, c, secret, func() error {
+		kutil.SetMetaDataLabel(&secret.ObjectMeta, common.RegistrationSpecHash, hash)
+		secret.Data = map[string][]byte{pinnedRegistrationSpecDataKey: data}
+		return nil
+	})
+}
+
+// pinnedRegistrationSpec reads the ControllerRegistration specification with the given hash which has been stored
+// in the given namespace of the seed.
+func pinnedRegistrationSpec(ctx context.Context, c client.Client, namespace, hash string) (*gardencorev1alpha1.ControllerRegistrationSpec, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, kutil.Key(namespace, pinnedRegistrationSpecSecretName), secret); err != nil {
+		return nil, err
+	}
+	if pinnedHash := secret.Labels[common.RegistrationSpecHash]; pinnedHash != hash {
+		return nil, fmt.Errorf("stored specification has hash %q instead of %q", pinnedHash, hash)
+	}
+
+	spec := &gardencorev1alpha1.ControllerRegistrationSpec{}
+	if err := json.Unmarshal(secret.Data[pinnedRegistrationSpecDataKey], spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
 func getNamespaceForControllerInstallation(controllerInstallation *gardencorev1alpha1.ControllerInstallation) *corev1.Namespace {
 	return &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
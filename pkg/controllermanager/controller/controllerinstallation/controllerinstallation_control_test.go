@@ -0,0 +1,138 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("pinned ControllerRegistration specification", func() {
+	var (
+		ctx        = context.TODO()
+		seedClient client.Client
+		namespace  = "extension-foo"
+		spec       = gardencorev1alpha1.ControllerRegistrationSpec{
+			Resources: []gardencorev1alpha1.ControllerResource{{Kind: "Extension", Type: "foo"}},
+			Deployment: &gardencorev1alpha1.ControllerDeployment{
+				Type: DeploymentTypeManifest,
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		seedClient = fake.NewFakeClient()
+	})
+
+	It("should return the pinned specification", func() {
+		Expect(pinRegistrationSpec(ctx, seedClient, namespace, spec, "hash1")).To(Succeed())
+
+		pinned, err := pinnedRegistrationSpec(ctx, seedClient, namespace, "hash1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pinned).To(Equal(&spec))
+	})
+
+	It("should fail if the pinned specification has a different hash", func() {
+		Expect(pinRegistrationSpec(ctx, seedClient, namespace, spec, "hash1")).To(Succeed())
+		Expect(pinRegistrationSpec(ctx, seedClient, namespace, spec, "hash2")).To(Succeed())
+
+		_, err := pinnedRegistrationSpec(ctx, seedClient, namespace, "hash1")
+		Expect(err).To(MatchError(ContainSubstring("hash")))
+	})
+
+	It("should fail if no specification has been pinned", func() {
+		_, err := pinnedRegistrationSpec(ctx, seedClient, namespace, "hash1")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
+
+var _ = Describe("#extensionResourcesInUse", func() {
+	var (
+		ctx        = context.TODO()
+		ctrl       *gomock.Controller
+		seedClient *mockclient.MockClient
+
+		controllerRegistration = &gardencorev1alpha1.ControllerRegistration{
+			Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+				Resources: []gardencorev1alpha1.ControllerResource{
+					{Kind: extensionsv1alpha1.InfrastructureResource, Type: "foo"},
+					{Kind: dnsv1alpha1.DNSProviderKind, Type: "foo"},
+				},
+			},
+		}
+
+		newExtensionResource = func(name, extensionType string) unstructured.Unstructured {
+			obj := unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{"type": extensionType}}}
+			obj.SetNamespace("shoot--foo--bar")
+			obj.SetName(name)
+			return obj
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		seedClient = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should return the extension resources of the supported kinds and types", func() {
+		seedClient.EXPECT().List(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, list *unstructured.UnstructuredList, _ ...client.ListOptionFunc) error {
+			Expect(list.GroupVersionKind()).To(Equal(extensionsv1alpha1.SchemeGroupVersion.WithKind("InfrastructureList")))
+			list.Items = []unstructured.Unstructured{newExtensionResource("infra", "foo"), newExtensionResource("other", "bar")}
+			return nil
+		})
+		seedClient.EXPECT().List(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, list *unstructured.UnstructuredList, _ ...client.ListOptionFunc) error {
+			Expect(list.GroupVersionKind()).To(Equal(dnsv1alpha1.SchemeGroupVersion.WithKind("DNSProviderList")))
+			list.Items = []unstructured.Unstructured{newExtensionResource("dns", "Foo")}
+			return nil
+		})
+
+		Expect(extensionResourcesInUse(ctx, seedClient, controllerRegistration)).To(ConsistOf(
+			"Infrastructure shoot--foo--bar/infra",
+			"DNSProvider shoot--foo--bar/dns",
+		))
+	})
+
+	It("should ignore kinds which are not known by the seed", func() {
+		seedClient.EXPECT().List(ctx, gomock.Any()).Return(&meta.NoKindMatchError{GroupKind: extensionsv1alpha1.SchemeGroupVersion.WithKind("Infrastructure").GroupKind()})
+		seedClient.EXPECT().List(ctx, gomock.Any()).Return(nil)
+
+		Expect(extensionResourcesInUse(ctx, seedClient, controllerRegistration)).To(BeEmpty())
+	})
+
+	It("should return other errors", func() {
+		seedClient.EXPECT().List(ctx, gomock.Any()).Return(fmt.Errorf("fake"))
+
+		_, err := extensionResourcesInUse(ctx, seedClient, controllerRegistration)
+		Expect(err).To(MatchError("fake"))
+	})
+})
@@ -15,15 +15,61 @@
 package controllerinstallation
 
 import (
+	"encoding/json"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // HelmDeployment is a providerConfig specific type for ControllerInstallation.
 type HelmDeployment struct {
 	// Chart is a Helm chart tarball.
 	Chart []byte `json:"chart,omitempty"`
+	// ChartRef is a reference to a Helm chart in a chart repository or an OCI registry. It can be specified
+	// instead of embedding the chart tarball.
+	ChartRef *HelmChartReference `json:"chartRef,omitempty"`
 	// Values is a map of values for the given chart.
 	Values map[string]interface{} `json:"values,omitempty"`
+	// SeedOverrides is a list of values for specific seeds which are merged into the values of the chart.
+	SeedOverrides []HelmSeedOverride `json:"seedOverrides,omitempty"`
+}
+
+// HelmSeedOverride contains chart values for a specific seed.
+type HelmSeedOverride struct {
+	// SeedName is the name of the seed.
+	SeedName string `json:"seedName"`
+	// Values is a map of values which overwrite the values of the chart for the seed.
+	Values map[string]interface{} `json:"values,omitempty"`
+}
+
+// HelmChartReference is a reference to a Helm chart which is stored in a Helm chart repository or an OCI registry.
+type HelmChartReference struct {
+	// Repository is either the URL of a Helm chart repository (http:// or https://) or a reference to an OCI
+	// repository without tag (oci://<registry>/<repository>).
+	Repository string `json:"repository"`
+	// Name is the name of the chart in the Helm chart repository. It is ignored for OCI repositories.
+	Name string `json:"name,omitempty"`
+	// Version is the version of the chart. For Helm chart repositories it can also be a semantic version constraint.
+	Version string `json:"version"`
+	// Digest is the expected digest of the chart tarball (sha256:<hex>). If it is not given then the digest
+	// published by the repository is verified (if any).
+	Digest string `json:"digest,omitempty"`
+	// PullSecretRef is a reference to a secret in the garden cluster which contains the credentials for the
+	// repository. It must either contain a `username` and `password` or a `.dockerconfigjson` key.
+	PullSecretRef *corev1.SecretReference `json:"pullSecretRef,omitempty"`
+}
+
+// ManifestDeployment is a providerConfig specific type for ControllerInstallation of type "manifest".
+type ManifestDeployment struct {
+	// Manifests is a list of Kubernetes objects which are applied to the seed.
+	Manifests []runtime.RawExtension `json:"manifests,omitempty"`
+}
+
+// KustomizeDeployment is a providerConfig specific type for ControllerInstallation of type "kustomize".
+type KustomizeDeployment struct {
+	// Kustomization is a kustomization whose resources are applied to the seed. Only a subset of the kustomization
+	// fields is supported, and resources and patches are embedded instead of being referenced by file paths.
+	Kustomization json.RawMessage `json:"kustomization,omitempty"`
 }
 
 // DeployedResources is a providerStatus specific type for ControllerInstallation.
@@ -0,0 +1,142 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+)
+
+// ResolveDependencies returns the ControllerRegistrations out of the given list on which the given ControllerRegistration
+// depends. A dependency by kind and type is resolved to all registrations supporting this resource. The second return
+// value contains the dependencies which cannot be resolved.
+func ResolveDependencies(controllerRegistration *gardencorev1alpha1.ControllerRegistration, controllerRegistrations []*gardencorev1alpha1.ControllerRegistration) ([]*gardencorev1alpha1.ControllerRegistration, []string) {
+	var (
+		resolved   []*gardencorev1alpha1.ControllerRegistration
+		unresolved []string
+		seen       = map[string]bool{}
+	)
+
+	for _, dependency := range controllerRegistration.Spec.Dependencies {
+		found := false
+		for _, other := range controllerRegistrations {
+			if other.Name == controllerRegistration.Name || !matchesDependency(other, dependency) {
+				continue
+			}
+			found = true
+			if !seen[other.Name] {
+				seen[other.Name] = true
+				resolved = append(resolved, other)
+			}
+		}
+		if !found {
+			unresolved = append(unresolved, dependencyToString(dependency))
+		}
+	}
+
+	sort.Slice(resolved, func(i, j int) bool { return resolved[i].Name < resolved[j].Name })
+	return resolved, unresolved
+}
+
+// DependsOn returns true if the given ControllerRegistration depends on the given other ControllerRegistration.
+func DependsOn(controllerRegistration, other *gardencorev1alpha1.ControllerRegistration) bool {
+	if controllerRegistration.Name == other.Name {
+		return false
+	}
+	for _, dependency := range controllerRegistration.Spec.Dependencies {
+		if matchesDependency(other, dependency) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesDependency(controllerRegistration *gardencorev1alpha1.ControllerRegistration, dependency gardencorev1alpha1.ControllerDependency) bool {
+	if len(dependency.Name) > 0 {
+		return controllerRegistration.Name == dependency.Name
+	}
+	for _, resource := range controllerRegistration.Spec.Resources {
+		if resource.Kind == dependency.Kind && resource.Type == dependency.Type {
+			return true
+		}
+	}
+	return false
+}
+
+func dependencyToString(dependency gardencorev1alpha1.ControllerDependency) string {
+	if len(dependency.Name) > 0 {
+		return dependency.Name
+	}
+	return fmt.Sprintf("%s/%s", dependency.Kind, dependency.Type)
+}
+
+// checkDependenciesInstalled returns an error describing the dependencies of the given ControllerRegistration which
+// are not installed successfully to the given seed yet. It returns nil if all dependencies are installed.
+func checkDependenciesInstalled(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seedName string, controllerRegistrations []*gardencorev1alpha1.ControllerRegistration, controllerInstallations []*gardencorev1alpha1.ControllerInstallation) error {
+	dependencies, unresolved := ResolveDependencies(controllerRegistration, controllerRegistrations)
+
+	var pending []string
+	for _, dependency := range dependencies {
+		controllerInstallation := findControllerInstallation(controllerInstallations, dependency.Name, seedName)
+		if controllerInstallation == nil || controllerInstallation.DeletionTimestamp != nil {
+			pending = append(pending, dependency.Name)
+			continue
+		}
+		if condition := gardencorev1alpha1helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1alpha1.ControllerInstallationInstalled); condition == nil || condition.Status != gardencorev1alpha1.ConditionTrue {
+			pending = append(pending, dependency.Name)
+		}
+	}
+
+	var messages []string
+	if len(unresolved) > 0 {
+		messages = append(messages, fmt.Sprintf("no ControllerRegistrations found for dependencies %s", strings.Join(unresolved, ", ")))
+	}
+	if len(pending) > 0 {
+		messages = append(messages, fmt.Sprintf("ControllerRegistrations %s are not installed to seed %s yet", strings.Join(pending, ", "), seedName))
+	}
+	if len(messages) > 0 {
+		return fmt.Errorf("waiting for dependencies: %s", strings.Join(messages, "; "))
+	}
+	return nil
+}
+
+// dependentControllerInstallations returns the names of the ControllerInstallations on the given seed whose
+// ControllerRegistrations depend on the given ControllerRegistration.
+func dependentControllerInstallations(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seedName string, controllerRegistrations []*gardencorev1alpha1.ControllerRegistration, controllerInstallations []*gardencorev1alpha1.ControllerInstallation) []string {
+	var dependents []string
+	for _, other := range controllerRegistrations {
+		if !DependsOn(other, controllerRegistration) {
+			continue
+		}
+		if controllerInstallation := findControllerInstallation(controllerInstallations, other.Name, seedName); controllerInstallation != nil {
+			dependents = append(dependents, controllerInstallation.Name)
+		}
+	}
+	sort.Strings(dependents)
+	return dependents
+}
+
+func findControllerInstallation(controllerInstallations []*gardencorev1alpha1.ControllerInstallation, registrationName, seedName string) *gardencorev1alpha1.ControllerInstallation {
+	for _, controllerInstallation := range controllerInstallations {
+		if controllerInstallation.Spec.RegistrationRef.Name == registrationName && controllerInstallation.Spec.SeedRef.Name == seedName {
+			return controllerInstallation
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("dependencies", func() {
+	var (
+		providerAWS = &gardencorev1alpha1.ControllerRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: "provider-aws"},
+			Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+				Resources: []gardencorev1alpha1.ControllerResource{{Kind: "Infrastructure", Type: "aws"}},
+			},
+		}
+		providerAWSCanary = &gardencorev1alpha1.ControllerRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: "provider-aws-canary"},
+			Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+				Resources: []gardencorev1alpha1.ControllerResource{{Kind: "Infrastructure", Type: "aws"}},
+			},
+		}
+		networkingCalico = &gardencorev1alpha1.ControllerRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: "networking-calico"},
+			Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+				Resources: []gardencorev1alpha1.ControllerResource{{Kind: "Network", Type: "calico"}},
+			},
+		}
+		all = []*gardencorev1alpha1.ControllerRegistration{providerAWS, providerAWSCanary, networkingCalico}
+	)
+
+	Describe("#ResolveDependencies", func() {
+		It("should resolve dependencies by name and by kind/type", func() {
+			controllerRegistration := &gardencorev1alpha1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Dependencies: []gardencorev1alpha1.ControllerDependency{
+						{Name: "networking-calico"},
+						{Kind: "Infrastructure", Type: "aws"},
+						{Name: "provider-aws"},
+					},
+				},
+			}
+
+			resolved, unresolved := ResolveDependencies(controllerRegistration, all)
+
+			Expect(resolved).To(Equal([]*gardencorev1alpha1.ControllerRegistration{networkingCalico, providerAWS, providerAWSCanary}))
+			Expect(unresolved).To(BeEmpty())
+		})
+
+		It("should return the dependencies which cannot be resolved", func() {
+			controllerRegistration := &gardencorev1alpha1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Dependencies: []gardencorev1alpha1.ControllerDependency{
+						{Name: "provider-gcp"},
+						{Kind: "Network", Type: "cilium"},
+						{Name: "networking-calico"},
+					},
+				},
+			}
+
+			resolved, unresolved := ResolveDependencies(controllerRegistration, all)
+
+			Expect(resolved).To(Equal([]*gardencorev1alpha1.ControllerRegistration{networkingCalico}))
+			Expect(unresolved).To(Equal([]string{"provider-gcp", "Network/cilium"}))
+		})
+
+		It("should not resolve a dependency to the registration itself", func() {
+			controllerRegistration := providerAWS.DeepCopy()
+			controllerRegistration.Spec.Dependencies = []gardencorev1alpha1.ControllerDependency{{Kind: "Infrastructure", Type: "aws"}}
+
+			resolved, unresolved := ResolveDependencies(controllerRegistration, all)
+
+			Expect(resolved).To(Equal([]*gardencorev1alpha1.ControllerRegistration{providerAWSCanary}))
+			Expect(unresolved).To(BeEmpty())
+		})
+	})
+
+	Describe("#DependsOn", func() {
+		It("should detect dependencies by name and by kind/type", func() {
+			byName := &gardencorev1alpha1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Dependencies: []gardencorev1alpha1.ControllerDependency{{Name: "provider-aws"}},
+				},
+			}
+			byResource := &gardencorev1alpha1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar"},
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Dependencies: []gardencorev1alpha1.ControllerDependency{{Kind: "Infrastructure", Type: "aws"}},
+				},
+			}
+
+			Expect(DependsOn(byName, providerAWS)).To(BeTrue())
+			Expect(DependsOn(byName, providerAWSCanary)).To(BeFalse())
+			Expect(DependsOn(byResource, providerAWS)).To(BeTrue())
+			Expect(DependsOn(byResource, providerAWSCanary)).To(BeTrue())
+			Expect(DependsOn(byResource, networkingCalico)).To(BeFalse())
+			Expect(DependsOn(providerAWS, byName)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,336 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kustomize"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DeploymentTypeHelm is the deployment type for ControllerRegistrations whose controllers are deployed with a
+	// Helm chart.
+	DeploymentTypeHelm = "helm"
+	// DeploymentTypeManifest is the deployment type for ControllerRegistrations whose controllers are deployed with
+	// plain Kubernetes manifests.
+	DeploymentTypeManifest = "manifest"
+	// DeploymentTypeKustomize is the deployment type for ControllerRegistrations whose controllers are deployed with
+	// a kustomization.
+	DeploymentTypeKustomize = "kustomize"
+)
+
+// DeploymentType renders the manifest which deploys the controller of a ControllerRegistration to a seed. The
+// rendered resources are applied to the seed by the ControllerInstallation controller, and resources which are no
+// longer part of the manifest are deleted.
+type DeploymentType interface {
+	// Render returns the manifest for the given deployment context.
+	Render(ctx context.Context, deploymentContext *DeploymentContext) ([]byte, error)
+}
+
+// DeploymentContext contains the information required to render the manifest of a ControllerInstallation.
+type DeploymentContext struct {
+	// ControllerRegistration is the ControllerRegistration which is installed.
+	ControllerRegistration *gardencorev1alpha1.ControllerRegistration
+	// Seed is the seed to which the controller is installed.
+	Seed *gardencorev1alpha1.Seed
+	// SeedClient is a client for the seed cluster.
+	SeedClient kubernetes.Interface
+	// Namespace is the namespace in the seed cluster into which the controller is installed.
+	Namespace string
+	// Values are standard values about the garden and the seed which are provided to the deployment.
+	Values map[string]interface{}
+}
+
+// providerConfig returns the raw provider config of the deployment of the ControllerRegistration.
+func (d *DeploymentContext) providerConfig() []byte {
+	if deployment := d.ControllerRegistration.Spec.Deployment; deployment != nil && deployment.ProviderConfig != nil {
+		return deployment.ProviderConfig.Raw
+	}
+	return nil
+}
+
+// DeploymentError is returned by DeploymentTypes. It contains a reason which is used for the Valid condition of the
+// ControllerInstallation.
+type DeploymentError struct {
+	// Reason is a machine-readable reason for the error.
+	Reason string
+	// Message is a human-readable description of the error.
+	Message string
+}
+
+// Error implements error.
+func (e *DeploymentError) Error() string {
+	return e.Message
+}
+
+// NewDeploymentError returns a new DeploymentError with the given reason and message.
+func NewDeploymentError(reason, format string, args ...interface{}) error {
+	return &DeploymentError{Reason: reason, Message: fmt.Sprintf(format, args...)}
+}
+
+type helmDeploymentType struct {
+	gardenClient client.Client
+	chartFetcher ChartFetcher
+}
+
+// NewHelmDeploymentType returns a DeploymentType which renders a Helm chart (see HelmDeployment). The given garden
+// client is used to read the pull secrets of referenced charts which are fetched with the given ChartFetcher.
+func NewHelmDeploymentType(gardenClient client.Client, chartFetcher ChartFetcher) DeploymentType {
+	return &helmDeploymentType{gardenClient, chartFetcher}
+}
+
+func (h *helmDeploymentType) Render(ctx context.Context, deploymentContext *DeploymentContext) ([]byte, error) {
+	var helmDeployment HelmDeployment
+	if err := json.Unmarshal(deploymentContext.providerConfig(), &helmDeployment); err != nil {
+		return nil, NewDeploymentError("ChartInformationInvalid", "Chart Information cannot be unmarshalled: %+v", err)
+	}
+	if err := validateHelmDeployment(&helmDeployment); err != nil {
+		return nil, NewDeploymentError("ChartInformationInvalid", "Chart Information is invalid: %+v", err)
+	}
+
+	chart, err := h.getChart(ctx, &helmDeployment)
+	if err != nil {
+		return nil, NewDeploymentError("ChartCannotBeFetched", "Referenced chart cannot be fetched: %+v", err)
+	}
+
+	chartRenderer, err := chartrenderer.NewForConfig(deploymentContext.SeedClient.RESTConfig())
+	if err != nil {
+		return nil, NewDeploymentError("ChartRendererCreationFailed", "ChartRenderer cannot be recreated for referenced Seed: %+v", err)
+	}
+
+	release, err := chartRenderer.RenderArchive(chart, deploymentContext.ControllerRegistration.Name, deploymentContext.Namespace, helmValues(&helmDeployment, deploymentContext.Seed.Name, deploymentContext.Values))
+	if err != nil {
+		return nil, NewDeploymentError("ChartCannotBeRendered", "Chart rendering process failed: %+v", err)
+	}
+	return release.Manifest(), nil
+}
+
+// helmValues returns the values of the given HelmDeployment merged with the values of its override for the seed with
+// the given name (if any) and with the given deployment values.
+func helmValues(helmDeployment *HelmDeployment, seedName string, deploymentValues map[string]interface{}) map[string]interface{} {
+	values := helmDeployment.Values
+	for _, override := range helmDeployment.SeedOverrides {
+		if override.SeedName == seedName {
+			values = utils.MergeMaps(values, override.Values)
+		}
+	}
+	return utils.MergeMaps(values, deploymentValues)
+}
+
+// getChart returns the chart tarball of the given HelmDeployment. It is either embedded or fetched from the referenced
+// repository.
+func (h *helmDeploymentType) getChart(ctx context.Context, helmDeployment *HelmDeployment) ([]byte, error) {
+	ref := helmDeployment.ChartRef
+	if ref == nil {
+		return helmDeployment.Chart, nil
+	}
+
+	var credentials *RepositoryCredentials
+	if ref.PullSecretRef != nil {
+		secret := &corev1.Secret{}
+		if err := h.gardenClient.Get(ctx, kutil.Key(ref.PullSecretRef.Namespace, ref.PullSecretRef.Name), secret); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if credentials, err = RepositoryCredentialsFromSecret(secret, ref.Repository); err != nil {
+			return nil, err
+		}
+	}
+
+	return h.chartFetcher.Fetch(ctx, ref, credentials)
+}
+
+// validateHelmDeployment validates that the given HelmDeployment either embeds a chart or references one, and that its
+// seed overrides are unique.
+func validateHelmDeployment(helmDeployment *HelmDeployment) error {
+	seedNames := sets.NewString()
+	for i, override := range helmDeployment.SeedOverrides {
+		if len(override.SeedName) == 0 {
+			return fmt.Errorf("seedOverrides[%d].seedName must be specified", i)
+		}
+		if seedNames.Has(override.SeedName) {
+			return fmt.Errorf("seedOverrides[%d].seedName %q is duplicated", i, override.SeedName)
+		}
+		seedNames.Insert(override.SeedName)
+	}
+
+	ref := helmDeployment.ChartRef
+	switch {
+	case len(helmDeployment.Chart) > 0 && ref != nil:
+		return fmt.Errorf("either chart or chartRef must be specified, not both")
+	case len(helmDeployment.Chart) == 0 && ref == nil:
+		return fmt.Errorf("either chart or chartRef must be specified")
+	case ref == nil:
+		return nil
+	}
+
+	if len(ref.Repository) == 0 {
+		return fmt.Errorf("chartRef.repository must be specified")
+	}
+	if len(ref.Version) == 0 {
+		return fmt.Errorf("chartRef.version must be specified")
+	}
+	if !strings.HasPrefix(ref.Repository, ociScheme) && len(ref.Name) == 0 {
+		return fmt.Errorf("chartRef.name must be specified for Helm chart repositories")
+	}
+	if ref.PullSecretRef != nil && (len(ref.PullSecretRef.Namespace) == 0 || len(ref.PullSecretRef.Name) == 0) {
+		return fmt.Errorf("chartRef.pullSecretRef must specify a namespace and a name")
+	}
+	return nil
+}
+
+type manifestDeploymentType struct{}
+
+// NewManifestDeploymentType returns a DeploymentType which deploys plain Kubernetes manifests (see
+// ManifestDeployment). Namespaced objects without namespace are deployed into the namespace of the
+// ControllerInstallation.
+func NewManifestDeploymentType() DeploymentType {
+	return &manifestDeploymentType{}
+}
+
+func (m *manifestDeploymentType) Render(_ context.Context, deploymentContext *DeploymentContext) ([]byte, error) {
+	var manifestDeployment ManifestDeployment
+	if err := json.Unmarshal(deploymentContext.providerConfig(), &manifestDeployment); err != nil {
+		return nil, NewDeploymentError("ManifestInformationInvalid", "Manifest Information cannot be unmarshalled: %+v", err)
+	}
+	if len(manifestDeployment.Manifests) == 0 {
+		return nil, NewDeploymentError("ManifestInformationInvalid", "Manifest Information is invalid: at least one manifest must be specified")
+	}
+
+	objects := make([]*unstructured.Unstructured, 0, len(manifestDeployment.Manifests))
+	for i, raw := range manifestDeployment.Manifests {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(raw.Raw, &obj.Object); err != nil {
+			return nil, NewDeploymentError("ManifestInformationInvalid", "Manifest %d cannot be unmarshalled: %+v", i, err)
+		}
+
+		gvk := obj.GroupVersionKind()
+		if len(gvk.Kind) == 0 || len(gvk.Version) == 0 || len(obj.GetName()) == 0 {
+			return nil, NewDeploymentError("ManifestInformationInvalid", "Manifest %d must specify an apiVersion, a kind and a name", i)
+		}
+		objects = append(objects, obj)
+	}
+
+	return renderObjects(deploymentContext, objects)
+}
+
+type kustomizeDeploymentType struct{}
+
+// NewKustomizeDeploymentType returns a DeploymentType which deploys the resources of a kustomization (see
+// KustomizeDeployment). Namespaced objects without namespace are deployed into the namespace of the
+// ControllerInstallation.
+func NewKustomizeDeploymentType() DeploymentType {
+	return &kustomizeDeploymentType{}
+}
+
+func (k *kustomizeDeploymentType) Render(_ context.Context, deploymentContext *DeploymentContext) ([]byte, error) {
+	var kustomizeDeployment KustomizeDeployment
+	if err := json.Unmarshal(deploymentContext.providerConfig(), &kustomizeDeployment); err != nil {
+		return nil, NewDeploymentError("KustomizationInvalid", "Kustomization cannot be unmarshalled: %+v", err)
+	}
+	if len(kustomizeDeployment.Kustomization) == 0 {
+		return nil, NewDeploymentError("KustomizationInvalid", "Kustomization is invalid: kustomization must be specified")
+	}
+
+	kustomization, err := kustomize.Decode(kustomizeDeployment.Kustomization)
+	if err != nil {
+		return nil, NewDeploymentError("KustomizationInvalid", "Kustomization cannot be decoded: %+v", err)
+	}
+
+	objects, err := kustomize.Build(kustomization)
+	if err != nil {
+		return nil, NewDeploymentError("KustomizationCannotBeBuilt", "Kustomization cannot be built: %+v", err)
+	}
+
+	return renderObjects(deploymentContext, objects)
+}
+
+// renderObjects returns the manifest of the given objects. Namespaced objects without namespace are put into the
+// namespace of the deployment context. The scope of custom resources whose CustomResourceDefinitions are part of the
+// given objects is taken from the definitions as the seed might not know them yet.
+func renderObjects(deploymentContext *DeploymentContext, objects []*unstructured.Unstructured) ([]byte, error) {
+	var (
+		restMapper         = deploymentContext.SeedClient.RESTMapper()
+		customResourceKind = customResourceKinds(objects)
+		documents          = make([]string, 0, len(objects))
+	)
+
+	for i, obj := range objects {
+		if len(obj.GetNamespace()) == 0 {
+			gvk := obj.GroupVersionKind()
+
+			namespaced, ok := customResourceKind[gvk.GroupKind()]
+			if !ok {
+				mapping, err := restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+				if err != nil {
+					return nil, NewDeploymentError("ManifestCannotBeRendered", "Scope of manifest %d (%s) cannot be determined: %+v", i, gvk, err)
+				}
+				namespaced = mapping.Scope.Name() == meta.RESTScopeNameNamespace
+			}
+			if namespaced {
+				obj.SetNamespace(deploymentContext.Namespace)
+			}
+		}
+
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, NewDeploymentError("ManifestCannotBeRendered", "Manifest %d cannot be marshalled: %+v", i, err)
+		}
+		documents = append(documents, string(data))
+	}
+
+	return []byte(strings.Join(documents, "---\n")), nil
+}
+
+// customResourceKinds returns the kinds defined by the CustomResourceDefinitions among the given objects, mapped to
+// whether they are namespaced.
+func customResourceKinds(objects []*unstructured.Unstructured) map[schema.GroupKind]bool {
+	kinds := map[schema.GroupKind]bool{}
+
+	for _, obj := range objects {
+		if obj.GroupVersionKind().GroupKind() != apiextensionsv1beta1.Kind("CustomResourceDefinition") {
+			continue
+		}
+
+		group, _, _ := unstructured.NestedString(obj.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(obj.Object, "spec", "names", "kind")
+		scope, _, _ := unstructured.NestedString(obj.Object, "spec", "scope")
+		if len(kind) == 0 {
+			continue
+		}
+		kinds[schema.GroupKind{Group: group, Kind: kind}] = scope != string(apiextensionsv1beta1.ClusterScoped)
+	}
+
+	return kinds
+}
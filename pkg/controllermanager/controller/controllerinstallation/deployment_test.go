@@ -0,0 +1,287 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation_test
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("DeploymentType", func() {
+	Describe("manifest", func() {
+		var (
+			ctx        = context.TODO()
+			ctrl       *gomock.Controller
+			seedClient *mockkubernetes.MockInterface
+
+			deploymentContext *DeploymentContext
+			deploymentType    DeploymentType
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			seedClient = mockkubernetes.NewMockInterface(ctrl)
+
+			restMapper := meta.NewDefaultRESTMapper(nil)
+			restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+			restMapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, meta.RESTScopeRoot)
+			restMapper.Add(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"}, meta.RESTScopeRoot)
+			seedClient.EXPECT().RESTMapper().Return(restMapper).AnyTimes()
+
+			deploymentContext = &DeploymentContext{
+				ControllerRegistration: &gardencorev1alpha1.ControllerRegistration{
+					ObjectMeta: metav1.ObjectMeta{Name: "extension"},
+					Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+						Deployment: &gardencorev1alpha1.ControllerDeployment{Type: DeploymentTypeManifest},
+					},
+				},
+				SeedClient: seedClient,
+				Namespace:  "extension-foo",
+			}
+			deploymentType = NewManifestDeploymentType()
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		setProviderConfig := func(raw string) {
+			deploymentContext.ControllerRegistration.Spec.Deployment.ProviderConfig = &gardencorev1alpha1.ProviderConfig{
+				RawExtension: runtime.RawExtension{Raw: []byte(raw)},
+			}
+		}
+
+		It("should render the manifests and default the namespace of namespaced objects", func() {
+			setProviderConfig(`{"manifests":[
+{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"controller"}},
+{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"other","namespace":"kube-system"}},
+{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"controller"}}
+]}`)
+
+			manifest, err := deploymentType.Render(ctx, deploymentContext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(manifest)).To(Equal(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller
+  namespace: extension-foo
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: other
+  namespace: kube-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: controller
+`))
+		})
+
+		It("should take the scope of custom resources from the definitions in the same manifest", func() {
+			setProviderConfig(`{"manifests":[
+{"apiVersion":"apiextensions.k8s.io/v1beta1","kind":"CustomResourceDefinition","metadata":{"name":"foos.example.com"},"spec":{"group":"example.com","names":{"kind":"Foo","plural":"foos"},"scope":"Namespaced"}},
+{"apiVersion":"apiextensions.k8s.io/v1beta1","kind":"CustomResourceDefinition","metadata":{"name":"bars.example.com"},"spec":{"group":"example.com","names":{"kind":"Bar","plural":"bars"},"scope":"Cluster"}},
+{"apiVersion":"example.com/v1","kind":"Foo","metadata":{"name":"foo"}},
+{"apiVersion":"example.com/v1","kind":"Bar","metadata":{"name":"bar"}}
+]}`)
+
+			manifest, err := deploymentType.Render(ctx, deploymentContext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(manifest)).To(ContainSubstring(`apiVersion: example.com/v1
+kind: Foo
+metadata:
+  name: foo
+  namespace: extension-foo
+`))
+			Expect(string(manifest)).To(HaveSuffix(`apiVersion: example.com/v1
+kind: Bar
+metadata:
+  name: bar
+`))
+		})
+
+		DescribeTable("should return an error for invalid provider configs",
+			func(raw, reason string) {
+				setProviderConfig(raw)
+
+				_, err := deploymentType.Render(ctx, deploymentContext)
+				Expect(err).To(BeAssignableToTypeOf(&DeploymentError{}))
+				Expect(err.(*DeploymentError).Reason).To(Equal(reason))
+			},
+			Entry("not unmarshallable", `{"manifests":"foo"}`, "ManifestInformationInvalid"),
+			Entry("no manifests", `{}`, "ManifestInformationInvalid"),
+			Entry("missing kind", `{"manifests":[{"apiVersion":"v1","metadata":{"name":"foo"}}]}`, "ManifestInformationInvalid"),
+			Entry("unknown kind", `{"manifests":[{"apiVersion":"foo/v1","kind":"Foo","metadata":{"name":"foo"}}]}`, "ManifestCannotBeRendered"),
+		)
+	})
+
+	Describe("kustomize", func() {
+		var (
+			ctx        = context.TODO()
+			ctrl       *gomock.Controller
+			seedClient *mockkubernetes.MockInterface
+
+			deploymentContext *DeploymentContext
+			deploymentType    DeploymentType
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			seedClient = mockkubernetes.NewMockInterface(ctrl)
+
+			restMapper := meta.NewDefaultRESTMapper(nil)
+			restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+			seedClient.EXPECT().RESTMapper().Return(restMapper).AnyTimes()
+
+			deploymentContext = &DeploymentContext{
+				ControllerRegistration: &gardencorev1alpha1.ControllerRegistration{
+					ObjectMeta: metav1.ObjectMeta{Name: "extension"},
+					Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+						Deployment: &gardencorev1alpha1.ControllerDeployment{Type: DeploymentTypeKustomize},
+					},
+				},
+				SeedClient: seedClient,
+				Namespace:  "extension-foo",
+			}
+			deploymentType = NewKustomizeDeploymentType()
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		setProviderConfig := func(raw string) {
+			deploymentContext.ControllerRegistration.Spec.Deployment.ProviderConfig = &gardencorev1alpha1.ProviderConfig{
+				RawExtension: runtime.RawExtension{Raw: []byte(raw)},
+			}
+		}
+
+		It("should build the kustomization and default the namespace of namespaced objects", func() {
+			setProviderConfig(`{"kustomization":{
+"resources":[{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"controller"},"spec":{"replicas":1}}],
+"commonLabels":{"extension":"foo"},
+"patchesStrategicMerge":[{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"controller"},"spec":{"replicas":2}}]
+}}`)
+
+			manifest, err := deploymentType.Render(ctx, deploymentContext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(manifest)).To(Equal(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    extension: foo
+  name: controller
+  namespace: extension-foo
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      extension: foo
+  template:
+    metadata:
+      labels:
+        extension: foo
+`))
+		})
+
+		DescribeTable("should return an error for invalid provider configs",
+			func(raw, reason string) {
+				setProviderConfig(raw)
+
+				_, err := deploymentType.Render(ctx, deploymentContext)
+				Expect(err).To(BeAssignableToTypeOf(&DeploymentError{}))
+				Expect(err.(*DeploymentError).Reason).To(Equal(reason))
+			},
+			Entry("not unmarshallable", `{"kustomization":"foo"}`, "KustomizationInvalid"),
+			Entry("no kustomization", `{}`, "KustomizationInvalid"),
+			Entry("unsupported field", `{"kustomization":{"namePrefix":"foo-"}}`, "KustomizationInvalid"),
+			Entry("no resources", `{"kustomization":{}}`, "KustomizationCannotBeBuilt"),
+			Entry("unknown kind", `{"kustomization":{"resources":[{"apiVersion":"foo/v1","kind":"Foo","metadata":{"name":"foo"}}]}}`, "ManifestCannotBeRendered"),
+		)
+	})
+
+	Describe("helm", func() {
+		var (
+			ctx               = context.TODO()
+			deploymentContext *DeploymentContext
+			deploymentType    DeploymentType
+		)
+
+		BeforeEach(func() {
+			deploymentContext = &DeploymentContext{
+				ControllerRegistration: &gardencorev1alpha1.ControllerRegistration{
+					ObjectMeta: metav1.ObjectMeta{Name: "extension"},
+					Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+						Deployment: &gardencorev1alpha1.ControllerDeployment{Type: DeploymentTypeHelm},
+					},
+				},
+				Seed:      &gardencorev1alpha1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}},
+				Namespace: "extension-foo",
+			}
+			deploymentType = NewHelmDeploymentType(nil, nil)
+		})
+
+		DescribeTable("should return an error for invalid provider configs",
+			func(raw string) {
+				deploymentContext.ControllerRegistration.Spec.Deployment.ProviderConfig = &gardencorev1alpha1.ProviderConfig{
+					RawExtension: runtime.RawExtension{Raw: []byte(raw)},
+				}
+
+				_, err := deploymentType.Render(ctx, deploymentContext)
+				Expect(err).To(BeAssignableToTypeOf(&DeploymentError{}))
+				Expect(err.(*DeploymentError).Reason).To(Equal("ChartInformationInvalid"))
+			},
+			Entry("not unmarshallable", `{"chart":1}`),
+			Entry("no chart", `{}`),
+			Entry("chart and chart reference", `{"chart":"Zm9v","chartRef":{"repository":"oci://registry/foo","version":"1.0.0"}}`),
+			Entry("seed override without seed name", `{"chart":"Zm9v","seedOverrides":[{"values":{"foo":"bar"}}]}`),
+			Entry("duplicate seed overrides", `{"chart":"Zm9v","seedOverrides":[{"seedName":"seed"},{"seedName":"seed"}]}`),
+		)
+
+		It("should merge the values of the override for the seed", func() {
+			helmDeployment := &HelmDeployment{
+				Values: map[string]interface{}{
+					"replicas": 1,
+					"config":   map[string]interface{}{"foo": "bar", "baz": "qux"},
+				},
+				SeedOverrides: []HelmSeedOverride{
+					{SeedName: "other", Values: map[string]interface{}{"replicas": 3}},
+					{SeedName: "seed", Values: map[string]interface{}{"config": map[string]interface{}{"foo": "seed"}}},
+				},
+			}
+
+			Expect(ExportHelmValues(helmDeployment, "seed", map[string]interface{}{"gardener": "values"})).To(Equal(map[string]interface{}{
+				"replicas": 1,
+				"config":   map[string]interface{}{"foo": "seed", "baz": "qux"},
+				"gardener": "values",
+			}))
+			Expect(ExportHelmValues(helmDeployment, "unknown", nil)).To(Equal(helmDeployment.Values))
+		})
+	})
+})
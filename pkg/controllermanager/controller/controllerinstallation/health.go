@@ -0,0 +1,136 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CheckDeployedResources checks the health of the given resources which have been deployed to the seed for a
+// ControllerInstallation. Deployments, StatefulSets and DaemonSets must be ready, and the services of webhooks must
+// have ready endpoints. Other resources are not checked.
+func CheckDeployedResources(ctx context.Context, seedClient client.Client, resources []corev1.ObjectReference, condition gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
+	for _, resource := range resources {
+		if err := checkDeployedResource(ctx, seedClient, resource); err != nil {
+			if apierrors.IsNotFound(err) {
+				return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "ResourceMissing", fmt.Sprintf("%s %s is missing: %v", resource.Kind, objectReferenceName(resource), err))
+			}
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "ResourceUnhealthy", fmt.Sprintf("%s %s is unhealthy: %v", resource.Kind, objectReferenceName(resource), err))
+		}
+	}
+
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "ResourcesHealthy", fmt.Sprintf("All %d deployed resources are healthy.", len(resources)))
+}
+
+func checkDeployedResource(ctx context.Context, seedClient client.Client, resource corev1.ObjectReference) error {
+	gv, err := schema.ParseGroupVersion(resource.APIVersion)
+	if err != nil {
+		return err
+	}
+	key := kutil.Key(resource.Namespace, resource.Name)
+
+	switch gv.Group {
+	case appsv1.GroupName:
+		switch resource.Kind {
+		case "Deployment":
+			deployment := &appsv1.Deployment{}
+			if err := seedClient.Get(ctx, key, deployment); err != nil {
+				return err
+			}
+			return health.CheckDeployment(deployment)
+		case "StatefulSet":
+			statefulSet := &appsv1.StatefulSet{}
+			if err := seedClient.Get(ctx, key, statefulSet); err != nil {
+				return err
+			}
+			return health.CheckStatefulSet(statefulSet)
+		case "DaemonSet":
+			daemonSet := &appsv1.DaemonSet{}
+			if err := seedClient.Get(ctx, key, daemonSet); err != nil {
+				return err
+			}
+			return health.CheckDaemonSet(daemonSet)
+		}
+
+	case admissionregistrationv1beta1.GroupName:
+		switch resource.Kind {
+		case "ValidatingWebhookConfiguration":
+			webhookConfiguration := &admissionregistrationv1beta1.ValidatingWebhookConfiguration{}
+			if err := seedClient.Get(ctx, key, webhookConfiguration); err != nil {
+				return err
+			}
+			for _, webhook := range webhookConfiguration.Webhooks {
+				if err := checkWebhookService(ctx, seedClient, webhook.Name, webhook.ClientConfig.Service); err != nil {
+					return err
+				}
+			}
+		case "MutatingWebhookConfiguration":
+			webhookConfiguration := &admissionregistrationv1beta1.MutatingWebhookConfiguration{}
+			if err := seedClient.Get(ctx, key, webhookConfiguration); err != nil {
+				return err
+			}
+			for _, webhook := range webhookConfiguration.Webhooks {
+				if err := checkWebhookService(ctx, seedClient, webhook.Name, webhook.ClientConfig.Service); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkWebhookService checks whether the given service of a webhook has at least one ready endpoint. Webhooks which
+// are called via URL are not checked.
+func checkWebhookService(ctx context.Context, seedClient client.Client, webhookName string, service *admissionregistrationv1beta1.ServiceReference) error {
+	if service == nil {
+		return nil
+	}
+
+	endpoints := &corev1.Endpoints{}
+	if err := seedClient.Get(ctx, kutil.Key(service.Namespace, service.Name), endpoints); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("service %s/%s of webhook %s has no endpoints", service.Namespace, service.Name, webhookName)
+		}
+		return err
+	}
+
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Addresses) > 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("service %s/%s of webhook %s has no ready endpoints", service.Namespace, service.Name, webhookName)
+}
+
+func objectReferenceName(resource corev1.ObjectReference) string {
+	if len(resource.Namespace) == 0 {
+		return resource.Name
+	}
+	return fmt.Sprintf("%s/%s", resource.Namespace, resource.Name)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation_test
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("health", func() {
+	var (
+		ctrl          *gomock.Controller
+		runtimeClient *mockclient.MockClient
+		ctx           = context.TODO()
+		condition     = gardencorev1alpha1.Condition{Type: gardencorev1alpha1.ControllerInstallationHealthy, Status: gardencorev1alpha1.ConditionUnknown}
+
+		deploymentRef = corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "extension", Name: "controller"}
+		webhookRef    = corev1.ObjectReference{APIVersion: "admissionregistration.k8s.io/v1beta1", Kind: "ValidatingWebhookConfiguration", Name: "webhook"}
+		configMapRef  = corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "extension", Name: "config"}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		runtimeClient = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#CheckDeployedResources", func() {
+		expectDeployment := func(available corev1.ConditionStatus) {
+			runtimeClient.EXPECT().Get(ctx, kutil.Key("extension", "controller"), gomock.AssignableToTypeOf(&appsv1.Deployment{})).DoAndReturn(func(_ context.Context, _ interface{}, deployment *appsv1.Deployment) error {
+				deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: available}}
+				return nil
+			})
+		}
+
+		expectWebhook := func(addresses ...corev1.EndpointAddress) {
+			runtimeClient.EXPECT().Get(ctx, kutil.Key("webhook"), gomock.AssignableToTypeOf(&admissionregistrationv1beta1.ValidatingWebhookConfiguration{})).DoAndReturn(func(_ context.Context, _ interface{}, webhookConfiguration *admissionregistrationv1beta1.ValidatingWebhookConfiguration) error {
+				webhookConfiguration.Webhooks = []admissionregistrationv1beta1.Webhook{{
+					Name:         "validation.extension.gardener.cloud",
+					ClientConfig: admissionregistrationv1beta1.WebhookClientConfig{Service: &admissionregistrationv1beta1.ServiceReference{Namespace: "extension", Name: "webhook"}},
+				}}
+				return nil
+			})
+			runtimeClient.EXPECT().Get(ctx, kutil.Key("extension", "webhook"), gomock.AssignableToTypeOf(&corev1.Endpoints{})).DoAndReturn(func(_ context.Context, _ interface{}, endpoints *corev1.Endpoints) error {
+				endpoints.Subsets = []corev1.EndpointSubset{{Addresses: addresses}}
+				return nil
+			})
+		}
+
+		It("should report healthy resources", func() {
+			expectDeployment(corev1.ConditionTrue)
+			expectWebhook(corev1.EndpointAddress{IP: "10.0.0.1"})
+
+			result := CheckDeployedResources(ctx, runtimeClient, []corev1.ObjectReference{deploymentRef, webhookRef, configMapRef}, condition)
+			Expect(result.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(result.Reason).To(Equal("ResourcesHealthy"))
+		})
+
+		It("should report an unready deployment", func() {
+			expectDeployment(corev1.ConditionFalse)
+
+			result := CheckDeployedResources(ctx, runtimeClient, []corev1.ObjectReference{deploymentRef, webhookRef}, condition)
+			Expect(result.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(result.Reason).To(Equal("ResourceUnhealthy"))
+			Expect(result.Message).To(ContainSubstring("extension/controller"))
+		})
+
+		It("should report a webhook without ready endpoints", func() {
+			expectWebhook()
+
+			result := CheckDeployedResources(ctx, runtimeClient, []corev1.ObjectReference{webhookRef}, condition)
+			Expect(result.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(result.Message).To(ContainSubstring("no ready endpoints"))
+		})
+
+		It("should report missing resources", func() {
+			runtimeClient.EXPECT().Get(ctx, kutil.Key("extension", "controller"), gomock.AssignableToTypeOf(&appsv1.Deployment{})).Return(apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "controller"))
+
+			result := CheckDeployedResources(ctx, runtimeClient, []corev1.ObjectReference{deploymentRef}, condition)
+			Expect(result.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(result.Reason).To(Equal("ResourceMissing"))
+		})
+	})
+})
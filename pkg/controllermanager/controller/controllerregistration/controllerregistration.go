@@ -110,6 +110,9 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenCoreInformerFacto
 	})
 	controller.controllerRegistrationSynced = controllerRegistrationInformer.Informer().HasSynced
 
+	controllerInstallationInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: controller.controllerInstallationUpdate,
+	})
 	controller.controllerInstallationSynced = controllerInstallationInformer.Informer().HasSynced
 
 	return controller
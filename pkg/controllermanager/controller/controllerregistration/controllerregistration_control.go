@@ -36,6 +36,7 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -67,6 +68,24 @@ func (c *Controller) controllerRegistrationDelete(obj interface{}) {
 	c.controllerRegistrationQueue.Add(key)
 }
 
+// controllerInstallationUpdate enqueues the ControllerRegistration of the given ControllerInstallation if its status
+// has changed so that the rollout of the registration can proceed.
+func (c *Controller) controllerInstallationUpdate(oldObj, newObj interface{}) {
+	oldControllerInstallation, ok := oldObj.(*gardencorev1alpha1.ControllerInstallation)
+	if !ok {
+		return
+	}
+	newControllerInstallation, ok := newObj.(*gardencorev1alpha1.ControllerInstallation)
+	if !ok {
+		return
+	}
+
+	if apiequality.Semantic.DeepEqual(oldControllerInstallation.Status, newControllerInstallation.Status) {
+		return
+	}
+	c.controllerRegistrationQueue.Add(newControllerInstallation.Spec.RegistrationRef.Name)
+}
+
 func (c *Controller) reconcileControllerRegistrationKey(key string) error {
 	_, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -125,7 +144,8 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 	var (
 		err              error
 		result           error
-		installationsMap = map[string]string{}
+		installationsMap = map[string]*gardencorev1alpha1.ControllerInstallation{}
+		rolloutSeeds     []rolloutSeed
 
 		mustWriteFinalizer = false
 	)
@@ -156,6 +176,11 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 		}
 	}
 
+	registrationSpecHash, err := gardencorev1alpha1helper.ComputeControllerRegistrationSpecHash(controllerRegistration.Spec)
+	if err != nil {
+		return err
+	}
+
 	// Live lookup to prevent working on a stale cache and trying to create multiple installations for the same
 	// registration/seed combination.
 	controllerInstallationList, err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().List(metav1.ListOptions{})
@@ -165,22 +190,55 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 
 	for _, controllerInstallation := range controllerInstallationList.Items {
 		if controllerInstallation.Spec.RegistrationRef.Name == controllerRegistration.Name {
-			installationsMap[controllerInstallation.Spec.SeedRef.Name] = controllerInstallation.Name
+			installationsMap[controllerInstallation.Spec.SeedRef.Name] = controllerInstallation.DeepCopy()
+		}
+	}
+
+	seedSelector := labels.Everything()
+	if controllerRegistration.Spec.SeedSelector != nil {
+		seedSelector, err = metav1.LabelSelectorAsSelector(controllerRegistration.Spec.SeedSelector)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, seed := range seedList {
+		if seed.DeletionTimestamp == nil && seedSelector.Matches(labels.Set(seed.Labels)) {
+			rolloutSeeds = append(rolloutSeeds, rolloutSeed{seed, installationsMap[seed.Name]})
 		}
 	}
 
+	seedsToUpdate, rolloutStatus, err := computeRollout(controllerRegistration.Spec.RolloutStrategy, rolloutSeeds, registrationSpecHash)
+	if err != nil {
+		return err
+	}
+
 	for _, seed := range seedList {
-		if err := c.reconcileSeedInstallations(controllerRegistration, seed, installationsMap); err != nil {
+		if seed.DeletionTimestamp == nil && !seedSelector.Matches(labels.Set(seed.Labels)) {
+			if err := c.deleteSeedInstallation(installationsMap[seed.Name]); err != nil {
+				result = multierror.Append(result, err)
+			}
+			continue
+		}
+
+		if err := c.reconcileSeedInstallations(controllerRegistration, seed, installationsMap[seed.Name], seedsToUpdate.Has(seed.Name), registrationSpecHash); err != nil {
 			result = multierror.Append(result, err)
 		}
 	}
 
+	if err := c.updateRolloutStatus(controllerRegistration, rolloutStatus); err != nil {
+		result = multierror.Append(result, err)
+	}
+
 	return result
 }
 
-func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardencorev1alpha1.Seed, installationsMap map[string]string) error {
+// reconcileSeedInstallations ensures the ControllerInstallation for the given ControllerRegistration and seed. The
+// registration specification with the given hash is only rolled out to the seed if <rollout> is true, otherwise an
+// existing ControllerInstallation keeps referencing the registration specification it was created or last updated for.
+func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardencorev1alpha1.Seed, installation *gardencorev1alpha1.ControllerInstallation, rollout bool, registrationSpecHash string) error {
 	if seed.DeletionTimestamp != nil {
-		if installation, ok := installationsMap[seed.Name]; ok {
+		if installation != nil {
 			if seed.Spec.Backup != nil {
 				logger := logger.NewFieldLogger(logger.Logger, "controllerregistration-seed", seed.Name)
 				if err := waitUntilBackupBucketDeleted(context.TODO(), c.k8sGardenClient.Client(), seed, logger); err != nil {
@@ -188,9 +246,7 @@ func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(contro
 				}
 			}
 
-			if err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Delete(installation, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
-				return err
-			}
+			return c.deleteSeedInstallation(installation)
 		}
 		return nil
 	}
@@ -223,18 +279,17 @@ func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(contro
 	if err != nil {
 		return err
 	}
-	registrationSpecMap, err := convertObjToMap(controllerRegistration.Spec)
-	if err != nil {
-		return err
-	}
-
-	var (
-		seedSpecHash         = utils.HashForMap(seedSpecMap)[:16]
-		registrationSpecHash = utils.HashForMap(registrationSpecMap)[:16]
-	)
+	seedSpecHash := utils.HashForMap(seedSpecMap)[:16]
+
+	if installation != nil {
+		// Installations which already deploy the current registration specification or which are not rolled out yet
+		// keep their registration reference. Otherwise, every change of the registration's status would update them.
+		if !rollout || installation.Labels[common.RegistrationSpecHash] == registrationSpecHash {
+			installationSpec.RegistrationRef = installation.Spec.RegistrationRef
+			registrationSpecHash = installation.Labels[common.RegistrationSpecHash]
+		}
 
-	if name, ok := installationsMap[seed.Name]; ok {
-		_, err := kutil.CreateOrPatchControllerInstallation(c.k8sGardenClient.GardenCore(), metav1.ObjectMeta{Name: name}, func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) *gardencorev1alpha1.ControllerInstallation {
+		_, err := kutil.CreateOrPatchControllerInstallation(c.k8sGardenClient.GardenCore(), metav1.ObjectMeta{Name: installation.Name}, func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) *gardencorev1alpha1.ControllerInstallation {
 			kutil.SetMetaDataLabel(&controllerInstallation.ObjectMeta, common.SeedSpecHash, seedSpecHash)
 			kutil.SetMetaDataLabel(&controllerInstallation.ObjectMeta, common.RegistrationSpecHash, registrationSpecHash)
 			controllerInstallation.Spec = installationSpec
@@ -258,6 +313,38 @@ func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(contro
 	return err
 }
 
+// deleteSeedInstallation deletes the given ControllerInstallation (if any), e.g. because its seed is deleted or is no
+// longer selected by the ControllerRegistration.
+func (c *defaultControllerRegistrationControl) deleteSeedInstallation(installation *gardencorev1alpha1.ControllerInstallation) error {
+	if installation == nil || installation.DeletionTimestamp != nil {
+		return nil
+	}
+	if err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Delete(installation.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// updateRolloutStatus writes the given rollout status and the observed generation into the status of the given
+// ControllerRegistration. The last update time is only changed if the rollout status has changed.
+func (c *defaultControllerRegistrationControl) updateRolloutStatus(controllerRegistration *gardencorev1alpha1.ControllerRegistration, rolloutStatus *gardencorev1alpha1.ControllerRolloutStatus) error {
+	if old := controllerRegistration.Status.Rollout; old != nil {
+		rolloutStatus.LastUpdateTime = old.LastUpdateTime
+	}
+	if !apiequality.Semantic.DeepEqual(controllerRegistration.Status.Rollout, rolloutStatus) {
+		rolloutStatus.LastUpdateTime = metav1.Now()
+	}
+
+	_, err := kutil.TryUpdateControllerRegistrationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerRegistration.ObjectMeta, func(controllerRegistration *gardencorev1alpha1.ControllerRegistration) (*gardencorev1alpha1.ControllerRegistration, error) {
+		controllerRegistration.Status.ObservedGeneration = controllerRegistration.Generation
+		controllerRegistration.Status.Rollout = rolloutStatus
+		return controllerRegistration, nil
+	}, func(cur, updated *gardencorev1alpha1.ControllerRegistration) bool {
+		return apiequality.Semantic.DeepEqual(cur.Status, updated.Status)
+	})
+	return err
+}
+
 func (c *defaultControllerRegistrationControl) delete(controllerRegistration *gardencorev1alpha1.ControllerRegistration, logger logrus.FieldLogger) error {
 	var (
 		result error
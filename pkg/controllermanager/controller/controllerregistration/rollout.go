@@ -0,0 +1,207 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// rolloutSeed is a seed to which a ControllerRegistration is rolled out together with its ControllerInstallation (if
+// there is already one).
+type rolloutSeed struct {
+	seed                   *gardencorev1alpha1.Seed
+	controllerInstallation *gardencorev1alpha1.ControllerInstallation
+}
+
+// isUpdated returns true if the ControllerInstallation of the seed deploys the registration with the given spec hash.
+// Seeds without ControllerInstallation are always updated as they get the current specification when their
+// installation is created.
+func (r rolloutSeed) isUpdated(specHash string) bool {
+	return r.controllerInstallation == nil || r.controllerInstallation.Labels[common.RegistrationSpecHash] == specHash
+}
+
+// health returns whether the ControllerInstallation of the seed has been reconciled successfully (available) or has
+// failed. If both are false then the ControllerInstallation has not been processed yet.
+func (r rolloutSeed) health() (available, failed bool) {
+	controllerInstallation := r.controllerInstallation
+	if controllerInstallation == nil || controllerInstallation.Status.ObservedGeneration < controllerInstallation.Generation {
+		return false, false
+	}
+
+	available = true
+	for _, conditionType := range []gardencorev1alpha1.ConditionType{gardencorev1alpha1.ControllerInstallationValid, gardencorev1alpha1.ControllerInstallationInstalled} {
+		condition := gardencorev1alpha1helper.GetCondition(controllerInstallation.Status.Conditions, conditionType)
+		if condition == nil || condition.Status != gardencorev1alpha1.ConditionTrue {
+			available = false
+		}
+		if condition != nil && condition.Status == gardencorev1alpha1.ConditionFalse {
+			failed = true
+		}
+	}
+	// The Healthy condition is optional as it is not necessarily reported for installations deployed by operators.
+	if condition := gardencorev1alpha1helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1alpha1.ControllerInstallationHealthy); condition != nil && condition.Status == gardencorev1alpha1.ConditionFalse {
+		failed = true
+	}
+	return available && !failed, failed
+}
+
+// computeRollout determines the seeds whose ControllerInstallations shall be updated to the registration
+// specification with the given hash according to the given rollout strategy. It returns the names of these seeds and
+// the resulting rollout status. Without strategy all seeds are updated at once. Seeds without ControllerInstallation
+// are always updated.
+func computeRollout(strategy *gardencorev1alpha1.ControllerRolloutStrategy, seeds []rolloutSeed, specHash string) (sets.String, *gardencorev1alpha1.ControllerRolloutStatus, error) {
+	var (
+		toUpdate = sets.NewString()
+		status   = &gardencorev1alpha1.ControllerRolloutStatus{TotalSeeds: int32(len(seeds))}
+
+		unavailable int32
+		failed      []string
+	)
+
+	sort.Slice(seeds, func(i, j int) bool { return seeds[i].seed.Name < seeds[j].seed.Name })
+
+	for _, s := range seeds {
+		if s.controllerInstallation == nil {
+			toUpdate.Insert(s.seed.Name)
+		}
+		if !s.isUpdated(specHash) {
+			continue
+		}
+
+		status.UpdatedSeeds++
+		switch isAvailable, isFailed := s.health(); {
+		case isAvailable:
+			status.AvailableSeeds++
+		case isFailed:
+			failed = append(failed, s.seed.Name)
+			unavailable++
+		default:
+			unavailable++
+		}
+	}
+
+	if strategy == nil {
+		for _, s := range seeds {
+			toUpdate.Insert(s.seed.Name)
+		}
+		status.UpdatedSeeds = status.TotalSeeds
+		status.Phase, status.Message = phaseForAvailability(status)
+		return toUpdate, status, nil
+	}
+
+	if strategy.Paused {
+		status.Phase = gardencorev1alpha1.ControllerRolloutPaused
+		status.Message = "Rollout is paused."
+		return toUpdate, status, nil
+	}
+	if len(failed) > 0 {
+		status.Phase = gardencorev1alpha1.ControllerRolloutPaused
+		status.Message = fmt.Sprintf("Rollout is paused because the updated ControllerInstallations of the following seeds are unhealthy: %s", strings.Join(failed, ", "))
+		return toUpdate, status, nil
+	}
+
+	waves, err := assignWaves(strategy.Waves, seeds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	maxUnavailable := int32(1)
+	if strategy.MaxUnavailable != nil {
+		maxUnavailable = *strategy.MaxUnavailable
+	}
+
+	for i, wave := range waves {
+		var (
+			outdated        []string
+			waveUnavailable bool
+		)
+		for _, s := range wave {
+			if !s.isUpdated(specHash) {
+				outdated = append(outdated, s.seed.Name)
+			} else if isAvailable, _ := s.health(); !isAvailable {
+				waveUnavailable = true
+			}
+		}
+		if len(outdated) == 0 && !waveUnavailable {
+			continue
+		}
+
+		if i < len(strategy.Waves) {
+			waveName := strategy.Waves[i].Name
+			status.CurrentWave = &waveName
+		}
+
+		for _, name := range outdated {
+			if unavailable >= maxUnavailable {
+				break
+			}
+			toUpdate.Insert(name)
+			unavailable++
+			status.UpdatedSeeds++
+		}
+
+		status.Phase = gardencorev1alpha1.ControllerRolloutProgressing
+		status.Message = fmt.Sprintf("Rolling out to %d of %d seeds (%d available).", status.UpdatedSeeds, status.TotalSeeds, status.AvailableSeeds)
+		if status.CurrentWave != nil {
+			status.Message = fmt.Sprintf("Rolling out wave %q to %d of %d seeds (%d available).", *status.CurrentWave, status.UpdatedSeeds, status.TotalSeeds, status.AvailableSeeds)
+		}
+		return toUpdate, status, nil
+	}
+
+	status.Phase, status.Message = phaseForAvailability(status)
+	return toUpdate, status, nil
+}
+
+// assignWaves assigns the given seeds to the given waves. A seed belongs to the first wave whose selector matches it.
+// The seeds which do not belong to any wave are returned as additional last wave.
+func assignWaves(waves []gardencorev1alpha1.ControllerRolloutWave, seeds []rolloutSeed) ([][]rolloutSeed, error) {
+	selectors := make([]labels.Selector, 0, len(waves))
+	for _, wave := range waves {
+		selector, err := metav1.LabelSelectorAsSelector(wave.SeedSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid seed selector of wave %q: %v", wave.Name, err)
+		}
+		selectors = append(selectors, selector)
+	}
+
+	result := make([][]rolloutSeed, len(waves)+1)
+	for _, s := range seeds {
+		i := 0
+		for ; i < len(selectors); i++ {
+			if wave := waves[i]; wave.SeedSelector != nil && selectors[i].Matches(labels.Set(s.seed.Labels)) {
+				break
+			}
+		}
+		result[i] = append(result[i], s)
+	}
+	return result, nil
+}
+
+func phaseForAvailability(status *gardencorev1alpha1.ControllerRolloutStatus) (gardencorev1alpha1.ControllerRolloutPhase, string) {
+	if status.AvailableSeeds == status.TotalSeeds {
+		return gardencorev1alpha1.ControllerRolloutCompleted, fmt.Sprintf("Rolled out to all %d seeds.", status.TotalSeeds)
+	}
+	return gardencorev1alpha1.ControllerRolloutProgressing, fmt.Sprintf("Rolling out to %d of %d seeds (%d available).", status.UpdatedSeeds, status.TotalSeeds, status.AvailableSeeds)
+}
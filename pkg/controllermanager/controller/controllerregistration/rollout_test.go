@@ -0,0 +1,175 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Rollout", func() {
+	const (
+		oldHash = "old"
+		newHash = "new"
+	)
+
+	var (
+		int32Ptr = func(i int32) *int32 { return &i }
+
+		newSeed = func(name, stage string) *gardencorev1alpha1.Seed {
+			return &gardencorev1alpha1.Seed{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"stage": stage}}}
+		}
+		newInstallation = func(hash string, status gardencorev1alpha1.ConditionStatus) *gardencorev1alpha1.ControllerInstallation {
+			return &gardencorev1alpha1.ControllerInstallation{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1, Labels: map[string]string{common.RegistrationSpecHash: hash}},
+				Status: gardencorev1alpha1.ControllerInstallationStatus{
+					ObservedGeneration: 1,
+					Conditions: []gardencorev1alpha1.Condition{
+						{Type: gardencorev1alpha1.ControllerInstallationValid, Status: status},
+						{Type: gardencorev1alpha1.ControllerInstallationInstalled, Status: status},
+					},
+				},
+			}
+		}
+		waves = []gardencorev1alpha1.ControllerRolloutWave{
+			{Name: "canary", SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "canary"}}},
+			{Name: "live", SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "live"}}},
+		}
+	)
+
+	Describe("#computeRollout", func() {
+		It("should update all seeds if no strategy is given", func() {
+			seeds := []rolloutSeed{
+				{newSeed("a", "canary"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+				{newSeed("b", "live"), nil},
+			}
+
+			toUpdate, status, err := computeRollout(nil, seeds, newHash)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toUpdate.List()).To(ConsistOf("a", "b"))
+			Expect(status.Phase).To(Equal(gardencorev1alpha1.ControllerRolloutProgressing))
+			Expect(status.TotalSeeds).To(Equal(int32(2)))
+			Expect(status.UpdatedSeeds).To(Equal(int32(2)))
+			Expect(status.CurrentWave).To(BeNil())
+		})
+
+		It("should update the seeds of the first wave up to max unavailable", func() {
+			seeds := []rolloutSeed{
+				{newSeed("c", "canary"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+				{newSeed("b", "canary"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+				{newSeed("a", "live"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+			}
+
+			toUpdate, status, err := computeRollout(&gardencorev1alpha1.ControllerRolloutStrategy{Waves: waves, MaxUnavailable: int32Ptr(1)}, seeds, newHash)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toUpdate.List()).To(ConsistOf("b"))
+			Expect(status.Phase).To(Equal(gardencorev1alpha1.ControllerRolloutProgressing))
+			Expect(status.CurrentWave).To(PointTo(Equal("canary")))
+			Expect(status.UpdatedSeeds).To(Equal(int32(1)))
+		})
+
+		It("should wait for updated seeds of the current wave to become available", func() {
+			seeds := []rolloutSeed{
+				{newSeed("a", "canary"), newInstallation(newHash, gardencorev1alpha1.ConditionUnknown)},
+				{newSeed("b", "canary"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+				{newSeed("c", "live"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+			}
+
+			toUpdate, status, err := computeRollout(&gardencorev1alpha1.ControllerRolloutStrategy{Waves: waves, MaxUnavailable: int32Ptr(1)}, seeds, newHash)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toUpdate.List()).To(BeEmpty())
+			Expect(status.CurrentWave).To(PointTo(Equal("canary")))
+			Expect(status.UpdatedSeeds).To(Equal(int32(1)))
+			Expect(status.AvailableSeeds).To(Equal(int32(0)))
+		})
+
+		It("should proceed with the next wave once the previous one is available", func() {
+			seeds := []rolloutSeed{
+				{newSeed("a", "canary"), newInstallation(newHash, gardencorev1alpha1.ConditionTrue)},
+				{newSeed("b", "live"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+				{newSeed("c", "live"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+				{newSeed("d", "other"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+			}
+
+			toUpdate, status, err := computeRollout(&gardencorev1alpha1.ControllerRolloutStrategy{Waves: waves, MaxUnavailable: int32Ptr(2)}, seeds, newHash)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toUpdate.List()).To(ConsistOf("b", "c"))
+			Expect(status.CurrentWave).To(PointTo(Equal("live")))
+		})
+
+		It("should roll out to seeds which do not belong to any wave last", func() {
+			seeds := []rolloutSeed{
+				{newSeed("a", "canary"), newInstallation(newHash, gardencorev1alpha1.ConditionTrue)},
+				{newSeed("b", "other"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+			}
+
+			toUpdate, status, err := computeRollout(&gardencorev1alpha1.ControllerRolloutStrategy{Waves: waves, MaxUnavailable: int32Ptr(1)}, seeds, newHash)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toUpdate.List()).To(ConsistOf("b"))
+			Expect(status.CurrentWave).To(BeNil())
+		})
+
+		It("should pause the rollout if an updated installation is unhealthy", func() {
+			seeds := []rolloutSeed{
+				{newSeed("a", "canary"), newInstallation(newHash, gardencorev1alpha1.ConditionFalse)},
+				{newSeed("b", "canary"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+				{newSeed("c", "live"), nil},
+			}
+
+			toUpdate, status, err := computeRollout(&gardencorev1alpha1.ControllerRolloutStrategy{Waves: waves, MaxUnavailable: int32Ptr(5)}, seeds, newHash)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toUpdate.List()).To(ConsistOf("c"))
+			Expect(status.Phase).To(Equal(gardencorev1alpha1.ControllerRolloutPaused))
+			Expect(status.Message).To(HaveSuffix("unhealthy: a"))
+		})
+
+		It("should not update any seed if the rollout is paused", func() {
+			seeds := []rolloutSeed{
+				{newSeed("a", "canary"), newInstallation(oldHash, gardencorev1alpha1.ConditionTrue)},
+			}
+
+			toUpdate, status, err := computeRollout(&gardencorev1alpha1.ControllerRolloutStrategy{Waves: waves, Paused: true}, seeds, newHash)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toUpdate.List()).To(BeEmpty())
+			Expect(status.Phase).To(Equal(gardencorev1alpha1.ControllerRolloutPaused))
+		})
+
+		It("should report a completed rollout", func() {
+			seeds := []rolloutSeed{
+				{newSeed("a", "canary"), newInstallation(newHash, gardencorev1alpha1.ConditionTrue)},
+				{newSeed("b", "live"), newInstallation(newHash, gardencorev1alpha1.ConditionTrue)},
+			}
+
+			toUpdate, status, err := computeRollout(&gardencorev1alpha1.ControllerRolloutStrategy{Waves: waves}, seeds, newHash)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toUpdate.List()).To(BeEmpty())
+			Expect(status.Phase).To(Equal(gardencorev1alpha1.ControllerRolloutCompleted))
+			Expect(status.AvailableSeeds).To(Equal(int32(2)))
+		})
+	})
+})
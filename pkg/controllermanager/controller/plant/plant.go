@@ -53,6 +53,11 @@ type Controller struct {
 	plantLister  gardencorelisters.PlantLister
 	plantSynced  cache.InformerSynced
 
+	cloudProfileLister gardencorelisters.CloudProfileLister
+	cloudProfileSynced cache.InformerSynced
+
+	configMapSynced cache.InformerSynced
+
 	plantQueue workqueue.RateLimitingInterface
 
 	workerCh               chan int
@@ -75,6 +80,12 @@ func NewController(k8sGardenClient kubernetes.Interface,
 		secretInformer = kubeInfomer.Secrets()
 		secretLister   = secretInformer.Lister()
 
+		cloudProfileInformer = gardenCoreInformer.CloudProfiles()
+		cloudProfileLister   = cloudProfileInformer.Lister()
+
+		configMapInformer = kubeInfomer.ConfigMaps()
+		configMapLister   = configMapInformer.Lister()
+
 		plantQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "plant")
 	)
 
@@ -84,10 +95,11 @@ func NewController(k8sGardenClient kubernetes.Interface,
 		config:   config,
 		recorder: recorder,
 
-		secretLister: secretLister,
-		plantLister:  plantLister,
-		plantQueue:   plantQueue,
-		plantControl: NewDefaultPlantControl(k8sGardenClient, recorder, config, plantLister, secretLister),
+		secretLister:       secretLister,
+		cloudProfileLister: cloudProfileLister,
+		plantLister:        plantLister,
+		plantQueue:         plantQueue,
+		plantControl:       NewDefaultPlantControl(k8sGardenClient, recorder, config, plantLister, secretLister, cloudProfileLister, configMapLister),
 
 		workerCh: make(chan int),
 	}
@@ -99,6 +111,10 @@ func NewController(k8sGardenClient kubernetes.Interface,
 		DeleteFunc: controller.plantDelete,
 	})
 
+	controller.cloudProfileSynced = cloudProfileInformer.Informer().HasSynced
+
+	controller.configMapSynced = configMapInformer.Informer().HasSynced
+
 	controller.secretSynced = secretInformer.Informer().HasSynced
 	secretInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    controller.reconcilePlantForMatchingSecret,
@@ -113,7 +129,7 @@ func NewController(k8sGardenClient kubernetes.Interface,
 func (c *Controller) Run(ctx context.Context, workers int) {
 	var waitGroup sync.WaitGroup
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.plantSynced, c.secretSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.plantSynced, c.secretSynced, c.cloudProfileSynced, c.configMapSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -27,10 +28,12 @@ import (
 	"github.com/gardener/gardener/pkg/logger"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
+	"github.com/ghodss/yaml"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	kubernetesclientset "k8s.io/client-go/kubernetes"
@@ -99,7 +102,8 @@ func (c *Controller) plantUpdate(oldObj, newObj interface{}) {
 		return
 	}
 
-	if new.ObjectMeta.Generation == old.ObjectMeta.Generation {
+	if new.ObjectMeta.Generation == old.ObjectMeta.Generation &&
+		old.Annotations[v1alpha1constants.AnnotationPlantExportShootTemplate] == new.Annotations[v1alpha1constants.AnnotationPlantExportShootTemplate] {
 		return
 	}
 
@@ -148,13 +152,15 @@ type ControlInterface interface {
 
 // NewDefaultPlantControl returns a new instance of the default implementation ControlInterface that
 // implements the documented semantics for Plants.
-func NewDefaultPlantControl(k8sGardenClient kubernetes.Interface, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, plantsLister gardencorelisters.PlantLister, secretLister kubecorev1listers.SecretLister) ControlInterface {
+func NewDefaultPlantControl(k8sGardenClient kubernetes.Interface, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, plantsLister gardencorelisters.PlantLister, secretLister kubecorev1listers.SecretLister, cloudProfileLister gardencorelisters.CloudProfileLister, configMapLister kubecorev1listers.ConfigMapLister) ControlInterface {
 	return &defaultPlantControl{
-		k8sGardenClient: k8sGardenClient,
-		plantLister:     plantsLister,
-		secretsLister:   secretLister,
-		recorder:        recorder,
-		config:          config,
+		k8sGardenClient:    k8sGardenClient,
+		plantLister:        plantsLister,
+		secretsLister:      secretLister,
+		cloudProfileLister: cloudProfileLister,
+		configMapLister:    configMapLister,
+		recorder:           recorder,
+		config:             config,
 	}
 }
 
@@ -186,14 +192,30 @@ func (c *defaultPlantControl) reconcile(ctx context.Context, plant *gardencorev1
 	}
 
 	var (
-		conditionAPIServerAvailable = gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantAPIServerAvailable)
-		conditionEveryNodeReady     = gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantEveryNodeReady)
+		conditionAPIServerAvailable      = gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantAPIServerAvailable)
+		conditionEveryNodeReady          = gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantEveryNodeReady)
+		conditionSystemComponentsHealthy = gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantSystemComponentsHealthy)
+		conditionCertificatesValid       = gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantCertificatesValid)
+		conditionVersionSupported        *gardencorev1alpha1.Condition
+		conditionEndpointsReachable      *gardencorev1alpha1.Condition
 	)
 
+	// The version and endpoint conditions are only maintained if the Plant references a CloudProfile or lists endpoints.
+	if plant.Spec.CloudProfileName != nil {
+		condition := gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantKubernetesVersionSupported)
+		conditionVersionSupported = &condition
+	}
+	if len(plant.Spec.Endpoints) > 0 {
+		condition := gardencorev1alpha1helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantEndpointsReachable)
+		conditionEndpointsReachable = &condition
+	}
+
+	otherConditions := appendOptionalConditions([]gardencorev1alpha1.Condition{conditionSystemComponentsHealthy, conditionCertificatesValid}, conditionVersionSupported, conditionEndpointsReachable)
+
 	kubeconfigSecret, err := c.secretsLister.Secrets(plant.Namespace).Get(plant.Spec.SecretRef.Name)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return c.updateStatusToUnknown(ctx, plant, "Referenced Plant secret could not be found.", conditionAPIServerAvailable, conditionEveryNodeReady)
+			return c.updateStatusToUnknown(ctx, plant, "Referenced Plant secret could not be found.", conditionAPIServerAvailable, conditionEveryNodeReady, otherConditions...)
 		}
 		return err
 	}
@@ -210,32 +232,114 @@ func (c *defaultPlantControl) reconcile(ctx context.Context, plant *gardencorev1
 	kubeconfig, ok := kubeconfigSecret.Data["kubeconfig"]
 	if !ok {
 		message := "Plant secret needs to contain a kubeconfig key."
-		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady)
+		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady, otherConditions...)
 	}
 
 	plantClusterClient, discoveryClient, err := c.initializePlantClients(plant, key, kubeconfig)
 	if err != nil {
 		message := fmt.Sprintf("Could not initialize Plant clients: %+v", err)
-		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady)
+		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady, otherConditions...)
 	}
 
 	healthChecker := NewHealthChecker(plantClusterClient, discoveryClient)
 
 	// Trigger health check
 	conditionAPIServerAvailable, conditionEveryNodeReady = c.healthChecks(ctx, healthChecker, logger, conditionAPIServerAvailable, conditionEveryNodeReady)
+	conditionSystemComponentsHealthy = healthChecker.CheckSystemComponents(ctx, conditionSystemComponentsHealthy)
+	conditionCertificatesValid = healthChecker.CheckCertificates(conditionCertificatesValid, kubeconfig)
 
 	cloudInfo, err := FetchCloudInfo(ctx, plantClusterClient, discoveryClient, logger)
 	if err != nil {
 		return err
 	}
 
-	return c.updateStatus(ctx, plant, cloudInfo, conditionAPIServerAvailable, conditionEveryNodeReady)
+	if conditionVersionSupported != nil {
+		*conditionVersionSupported = c.checkKubernetesVersion(healthChecker, *conditionVersionSupported, *plant.Spec.CloudProfileName, cloudInfo.K8sVersion)
+	}
+	if conditionEndpointsReachable != nil {
+		*conditionEndpointsReachable = healthChecker.CheckEndpoints(ctx, *conditionEndpointsReachable, plant.Spec.Endpoints)
+	}
+
+	conditions := appendOptionalConditions([]gardencorev1alpha1.Condition{conditionAPIServerAvailable, conditionEveryNodeReady, conditionSystemComponentsHealthy, conditionCertificatesValid}, conditionVersionSupported, conditionEndpointsReachable)
+	if err := c.updateStatus(ctx, plant, cloudInfo, conditions...); err != nil {
+		return err
+	}
+
+	return c.reconcileShootTemplate(ctx, plant, cloudInfo)
+}
+
+// reconcileShootTemplate exports a Shoot manifest template for the Plant cluster into a ConfigMap in the Plant's
+// namespace if the Plant is annotated accordingly. Otherwise, a previously exported template is deleted. ConfigMaps
+// which are not controlled by the Plant are never touched.
+func (c *defaultPlantControl) reconcileShootTemplate(ctx context.Context, plant *gardencorev1alpha1.Plant, cloudInfo *StatusCloudInfo) error {
+	name := ShootTemplateConfigMapName(plant.Name)
+
+	existing, err := c.configMapLister.ConfigMaps(plant.Namespace).Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	controlled := err == nil && metav1.IsControlledBy(existing, plant)
+
+	if !kutil.HasMetaDataAnnotation(&plant.ObjectMeta, v1alpha1constants.AnnotationPlantExportShootTemplate, "true") {
+		if !controlled {
+			return nil
+		}
+		return client.IgnoreNotFound(c.k8sGardenClient.Client().Delete(ctx, existing.DeepCopy()))
+	}
+
+	if err == nil && !controlled {
+		return fmt.Errorf("cannot export shoot template: configmap %s/%s exists and is not controlled by the plant", plant.Namespace, name)
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: plant.Namespace,
+		},
+	}
+
+	shootTemplate, err := yaml.Marshal(ShootTemplate(plant, cloudInfo))
+	if err != nil {
+		return err
+	}
+
+	return kutil.CreateOrUpdate(ctx, c.k8sGardenClient.Client(), configMap, func() error {
+		configMap.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(plant, gardencorev1alpha1.SchemeGroupVersion.WithKind("Plant"))}
+		configMap.Data = map[string]string{ShootTemplateConfigMapKey: string(shootTemplate)}
+		return nil
+	})
+}
+
+// appendOptionalConditions appends the given optional conditions to the list of conditions if they are set.
+func appendOptionalConditions(conditions []gardencorev1alpha1.Condition, optionalConditions ...*gardencorev1alpha1.Condition) []gardencorev1alpha1.Condition {
+	for _, condition := range optionalConditions {
+		if condition != nil {
+			conditions = append(conditions, *condition)
+		}
+	}
+	return conditions
+}
+
+// checkKubernetesVersion compares the Kubernetes version of the Plant cluster with the versions offered by the
+// CloudProfile with the given name.
+func (c *defaultPlantControl) checkKubernetesVersion(healthChecker *HealthChecker, condition gardencorev1alpha1.Condition, cloudProfileName, kubernetesVersion string) gardencorev1alpha1.Condition {
+	cloudProfile, err := c.cloudProfileLister.Get(cloudProfileName)
+	if err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(condition, fmt.Sprintf("Could not get CloudProfile %s: %v", cloudProfileName, err))
+	}
+	return healthChecker.CheckKubernetesVersion(condition, cloudProfile, kubernetesVersion)
 }
 
-func (c *defaultPlantControl) updateStatusToUnknown(ctx context.Context, plant *gardencorev1alpha1.Plant, message string, conditionAPIServerAvailable, conditionEveryNodeReady gardencorev1alpha1.Condition) error {
+func (c *defaultPlantControl) updateStatusToUnknown(ctx context.Context, plant *gardencorev1alpha1.Plant, message string, conditionAPIServerAvailable, conditionEveryNodeReady gardencorev1alpha1.Condition, otherConditions ...gardencorev1alpha1.Condition) error {
 	conditionAPIServerAvailable = gardencorev1alpha1helper.UpdatedCondition(conditionAPIServerAvailable, gardencorev1alpha1.ConditionFalse, "APIServerDown", message)
 	conditionEveryNodeReady = gardencorev1alpha1helper.UpdatedCondition(conditionEveryNodeReady, gardencorev1alpha1.ConditionFalse, "Nodes not reachable", message)
-	return c.updateStatus(ctx, plant, &StatusCloudInfo{}, conditionAPIServerAvailable, conditionEveryNodeReady)
+
+	conditions := []gardencorev1alpha1.Condition{conditionAPIServerAvailable, conditionEveryNodeReady}
+	for _, condition := range otherConditions {
+		conditions = append(conditions, gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(condition, message))
+	}
+
+	return c.updateStatus(ctx, plant, &StatusCloudInfo{}, conditions...)
 }
 
 func (c *defaultPlantControl) updateStatus(ctx context.Context, plant *gardencorev1alpha1.Plant, cloudInfo *StatusCloudInfo, conditions ...gardencorev1alpha1.Condition) error {
@@ -246,6 +350,7 @@ func (c *defaultPlantControl) updateStatus(ctx context.Context, plant *gardencor
 	updatePlant.Status.ClusterInfo.Cloud.Type = cloudInfo.CloudType
 	updatePlant.Status.ClusterInfo.Cloud.Region = cloudInfo.Region
 	updatePlant.Status.ClusterInfo.Kubernetes.Version = cloudInfo.K8sVersion
+	updatePlant.Status.ClusterInfo.NodePools = cloudInfo.NodePools
 	updatePlant.Status.Conditions = conditions
 
 	if !equality.Semantic.DeepEqual(plant, updatePlant) {
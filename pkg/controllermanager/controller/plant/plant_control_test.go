@@ -0,0 +1,136 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plant
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Control", func() {
+	Describe("#reconcileShootTemplate", func() {
+		var (
+			ctx       = context.TODO()
+			namespace = "garden-foo"
+
+			ctrl            *gomock.Controller
+			k8sGardenClient *mockkubernetes.MockInterface
+			c               client.Client
+			indexer         cache.Indexer
+
+			plant   *gardencorev1alpha1.Plant
+			control *defaultPlantControl
+
+			newConfigMap = func(ownerReferences ...metav1.OwnerReference) *corev1.ConfigMap {
+				return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ShootTemplateConfigMapName(plant.Name), Namespace: namespace, OwnerReferences: ownerReferences}}
+			}
+			controllerRef = func() metav1.OwnerReference {
+				return *metav1.NewControllerRef(plant, gardencorev1alpha1.SchemeGroupVersion.WithKind("Plant"))
+			}
+			setup = func(configMaps ...*corev1.ConfigMap) {
+				var objs []runtime.Object
+				for _, configMap := range configMaps {
+					Expect(indexer.Add(configMap)).To(Succeed())
+					objs = append(objs, configMap.DeepCopy())
+				}
+				c = fake.NewFakeClient(objs...)
+				k8sGardenClient.EXPECT().Client().Return(c).AnyTimes()
+			}
+			get = func() (*corev1.ConfigMap, error) {
+				configMap := &corev1.ConfigMap{}
+				return configMap, c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ShootTemplateConfigMapName(plant.Name)}, configMap)
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			k8sGardenClient = mockkubernetes.NewMockInterface(ctrl)
+			indexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+
+			plant = &gardencorev1alpha1.Plant{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: namespace, UID: "1234"}}
+			control = &defaultPlantControl{k8sGardenClient: k8sGardenClient, configMapLister: kubecorev1listers.NewConfigMapLister(indexer)}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should export the shoot template if the plant is annotated", func() {
+			setup()
+			metav1.SetMetaDataAnnotation(&plant.ObjectMeta, v1alpha1constants.AnnotationPlantExportShootTemplate, "true")
+
+			Expect(control.reconcileShootTemplate(ctx, plant, &StatusCloudInfo{})).To(Succeed())
+
+			configMap, err := get()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metav1.IsControlledBy(configMap, plant)).To(BeTrue())
+			Expect(configMap.Data).To(HaveKey(ShootTemplateConfigMapKey))
+		})
+
+		It("should not overwrite a configmap which is not controlled by the plant", func() {
+			setup(newConfigMap())
+			metav1.SetMetaDataAnnotation(&plant.ObjectMeta, v1alpha1constants.AnnotationPlantExportShootTemplate, "true")
+
+			Expect(control.reconcileShootTemplate(ctx, plant, &StatusCloudInfo{})).NotTo(Succeed())
+
+			configMap, err := get()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configMap.Data).To(BeEmpty())
+		})
+
+		It("should delete the exported shoot template if the plant is no longer annotated", func() {
+			setup(newConfigMap(controllerRef()))
+
+			Expect(control.reconcileShootTemplate(ctx, plant, &StatusCloudInfo{})).To(Succeed())
+
+			_, err := get()
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should not delete a configmap which is not controlled by the plant", func() {
+			setup(newConfigMap())
+
+			Expect(control.reconcileShootTemplate(ctx, plant, &StatusCloudInfo{})).To(Succeed())
+
+			_, err := get()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not send a delete request if the configmap does not exist in the cache", func() {
+			setup()
+			Expect(c.Create(ctx, newConfigMap(controllerRef()))).To(Succeed())
+
+			Expect(control.reconcileShootTemplate(ctx, plant, &StatusCloudInfo{})).To(Succeed())
+
+			_, err := get()
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -16,22 +16,94 @@ package plant
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// certificateExpirationThreshold is the duration before the expiration of a kubeconfig certificate from which on
+	// the certificate is considered as about to expire.
+	certificateExpirationThreshold = 30 * 24 * time.Hour
+	// endpointRequestTimeout is the timeout for requests against the endpoints listed in the Plant specification.
+	endpointRequestTimeout = 10 * time.Second
+	// endpointsCheckTimeout is the overall timeout for checking all endpoints listed in the Plant specification.
+	endpointsCheckTimeout = 30 * time.Second
+)
+
+var (
+	// EndpointAddressAllowed checks whether the endpoints listed in the Plant specification may be probed at the given
+	// IP address. Exposed for testing.
+	EndpointAddressAllowed = isPublicAddress
+
+	// nonPublicNetworks are the networks which are not reachable for the endpoint probes, as the endpoints are provided by
+	// users and must not be used to send requests into the network of the Gardener (e.g. to in-cluster services or the
+	// metadata endpoints of the cloud providers).
+	nonPublicNetworks = mustParseCIDRs(
+		"10.0.0.0/8",
+		"100.64.0.0/10",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"fc00::/7",
+	)
+)
+
 // NewHealthChecker creates a new health checker.
 func NewHealthChecker(plantClient client.Client, discoveryClient discovery.DiscoveryInterface) *HealthChecker {
 	return &HealthChecker{
 		plantClient:     plantClient,
 		discoveryClient: discoveryClient,
+		httpClient:      newEndpointHTTPClient(),
+	}
+}
+
+// newEndpointHTTPClient returns an HTTP client which refuses to connect to non-public IP addresses. The addresses are
+// checked when the connection is established, i.e. after name resolution and for every redirect.
+func newEndpointHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: endpointRequestTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !EndpointAddressAllowed(ip) {
+				return fmt.Errorf("connecting to address %s is not allowed", host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: endpointRequestTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: endpointRequestTimeout,
+		},
+		CheckRedirect: func(request *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return fmt.Errorf("stopped after %d redirects", len(via))
+			}
+			return validateEndpointURL(request.URL)
+		},
 	}
 }
 
@@ -39,6 +111,7 @@ func NewHealthChecker(plantClient client.Client, discoveryClient discovery.Disco
 type HealthChecker struct {
 	plantClient     client.Client
 	discoveryClient discovery.DiscoveryInterface
+	httpClient      *http.Client
 }
 
 // CheckPlantClusterNodes checks whether cluster nodes in the given listers are complete and healthy.
@@ -73,3 +146,204 @@ func (h *HealthChecker) checkNodes(condition gardencorev1alpha1.Condition, nodeL
 	}
 	return condition, nil
 }
+
+// CheckSystemComponents checks whether all deployments and daemon sets in the kube-system namespace of the Plant
+// cluster are healthy.
+func (h *HealthChecker) CheckSystemComponents(ctx context.Context, condition gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
+	deploymentList := &appsv1.DeploymentList{}
+	if err := h.plantClient.List(ctx, deploymentList, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+	}
+
+	for _, deployment := range deploymentList.Items {
+		if err := health.CheckDeployment(&deployment); err != nil {
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "DeploymentUnhealthy", fmt.Sprintf("Deployment %s is unhealthy: %v", deployment.Name, err))
+		}
+	}
+
+	daemonSetList := &appsv1.DaemonSetList{}
+	if err := h.plantClient.List(ctx, daemonSetList, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+	}
+
+	for _, daemonSet := range daemonSetList.Items {
+		if err := health.CheckDaemonSet(&daemonSet); err != nil {
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "DaemonSetUnhealthy", fmt.Sprintf("DaemonSet %s is unhealthy: %v", daemonSet.Name, err))
+		}
+	}
+
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "SystemComponentsRunning", "All system components are healthy.")
+}
+
+// CheckKubernetesVersion checks whether the given Kubernetes version of the Plant cluster is offered by the given
+// CloudProfile and whether it is not yet expired.
+func (h *HealthChecker) CheckKubernetesVersion(condition gardencorev1alpha1.Condition, cloudProfile *gardencorev1alpha1.CloudProfile, kubernetesVersion string) gardencorev1alpha1.Condition {
+	ok, version, err := gardencorev1alpha1helper.KubernetesVersionExistsInCloudProfile(cloudProfile, kubernetesVersion)
+	if err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+	}
+	if !ok {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "KubernetesVersionNotOffered", fmt.Sprintf("Kubernetes version %s is not offered by CloudProfile %s.", kubernetesVersion, cloudProfile.Name))
+	}
+	if version.ExpirationDate != nil && version.ExpirationDate.Time.Before(time.Now()) {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "KubernetesVersionExpired", fmt.Sprintf("Kubernetes version %s has expired on %s in CloudProfile %s.", kubernetesVersion, version.ExpirationDate.Time.UTC().Format(time.RFC3339), cloudProfile.Name))
+	}
+
+	newerPatchVersionFound, latestPatchVersion, err := gardencorev1alpha1helper.DetermineLatestKubernetesPatchVersion(cloudProfile, version.Version)
+	if err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+	}
+	if newerPatchVersionFound {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "NewerPatchVersionAvailable", fmt.Sprintf("Kubernetes version %s is offered by CloudProfile %s, but a newer patch version %s is available.", kubernetesVersion, cloudProfile.Name, latestPatchVersion))
+	}
+
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "KubernetesVersionOffered", fmt.Sprintf("Kubernetes version %s is offered by CloudProfile %s.", kubernetesVersion, cloudProfile.Name))
+}
+
+// CheckCertificates checks whether the certificate authority and client certificate contained in the given
+// kubeconfig are valid and do not expire soon.
+func (h *HealthChecker) CheckCertificates(condition gardencorev1alpha1.Condition, kubeconfig []byte) gardencorev1alpha1.Condition {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+	}
+
+	var certificates []*x509.Certificate
+	for _, data := range [][]byte{config.TLSClientConfig.CAData, config.TLSClientConfig.CertData} {
+		certs, err := decodeCertificates(data)
+		if err != nil {
+			return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+		}
+		certificates = append(certificates, certs...)
+	}
+
+	if len(certificates) == 0 {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "NoCertificatesFound", "The kubeconfig of the Plant does not contain any certificates.")
+	}
+
+	now := time.Now()
+	for _, certificate := range certificates {
+		if now.After(certificate.NotAfter) {
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "CertificateExpired", fmt.Sprintf("Certificate %q has expired on %s.", certificate.Subject.CommonName, certificate.NotAfter.UTC().Format(time.RFC3339)))
+		}
+		if now.Add(certificateExpirationThreshold).After(certificate.NotAfter) {
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "CertificateExpiresSoon", fmt.Sprintf("Certificate %q expires on %s.", certificate.Subject.CommonName, certificate.NotAfter.UTC().Format(time.RFC3339)))
+		}
+	}
+
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "CertificatesValid", "All certificates of the kubeconfig are valid.")
+}
+
+// CheckEndpoints checks whether all given endpoints are reachable. Any HTTP response which does not indicate a
+// server error is considered as reachable. The endpoints are checked in parallel, only http(s) URLs pointing to
+// public IP addresses are probed.
+func (h *HealthChecker) CheckEndpoints(ctx context.Context, condition gardencorev1alpha1.Condition, endpoints []gardencorev1alpha1.Endpoint) gardencorev1alpha1.Condition {
+	ctx, cancel := context.WithTimeout(ctx, endpointsCheckTimeout)
+	defer cancel()
+
+	var (
+		wg     sync.WaitGroup
+		errors = make([]error, len(endpoints))
+	)
+
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint gardencorev1alpha1.Endpoint) {
+			defer wg.Done()
+			errors[i] = h.checkEndpoint(ctx, endpoint.URL)
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	var unreachable []string
+	for i, err := range errors {
+		if err != nil {
+			unreachable = append(unreachable, fmt.Sprintf("%s (%v)", endpoints[i].Name, err))
+		}
+	}
+
+	if len(unreachable) > 0 {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "EndpointsUnreachable", fmt.Sprintf("Endpoints are not reachable: %s", strings.Join(unreachable, ", ")))
+	}
+
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "EndpointsReachable", "All endpoints are reachable.")
+}
+
+func (h *HealthChecker) checkEndpoint(ctx context.Context, rawURL string) error {
+	endpointURL, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if err := validateEndpointURL(endpointURL); err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodGet, endpointURL.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := h.httpClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("status code %d", response.StatusCode)
+	}
+	return nil
+}
+
+func validateEndpointURL(endpointURL *url.URL) error {
+	if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", endpointURL.Scheme)
+	}
+	if len(endpointURL.Hostname()) == 0 {
+		return fmt.Errorf("missing host")
+	}
+	return nil
+}
+
+// isPublicAddress returns true if the given IP address is neither a loopback, link-local, multicast, unspecified nor
+// private (cluster-internal) address.
+func isPublicAddress(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+func decodeCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	return certificates, nil
+}
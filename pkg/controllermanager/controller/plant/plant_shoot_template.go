@@ -0,0 +1,160 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plant
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	"github.com/Masterminds/semver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// ShootTemplateConfigMapSuffix is the suffix of the name of the ConfigMap the Shoot template of a Plant is
+	// exported to.
+	ShootTemplateConfigMapSuffix = ".shoot-template"
+	// ShootTemplateConfigMapKey is the data key of the ConfigMap the Shoot template of a Plant is exported to.
+	ShootTemplateConfigMapKey = "shoot.yaml"
+
+	// maxWorkerNameLength is the maximum length of a worker pool name in a Shoot.
+	maxWorkerNameLength = 15
+)
+
+var (
+	// providerTypes maps the provider of a node's provider ID to the Gardener provider type.
+	providerTypes = map[string]string{
+		"gce": "gcp",
+	}
+
+	invalidWorkerNameCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+// ShootTemplateConfigMapName returns the name of the ConfigMap the Shoot template of the given Plant is exported to.
+func ShootTemplateConfigMapName(plantName string) string {
+	return plantName + ShootTemplateConfigMapSuffix
+}
+
+// ShootTemplate computes a Shoot manifest template out of the given Plant and the cloud information detected in the
+// Plant cluster. Values which cannot be detected are set to Unknown and must be completed before the Shoot can be
+// created.
+func ShootTemplate(plant *gardencorev1alpha1.Plant, cloudInfo *StatusCloudInfo) *gardencorev1alpha1.Shoot {
+	cloudProfileName := Unknown
+	if plant.Spec.CloudProfileName != nil {
+		cloudProfileName = *plant.Spec.CloudProfileName
+	}
+
+	return &gardencorev1alpha1.Shoot{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gardencorev1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Shoot",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      plant.Name,
+			Namespace: plant.Namespace,
+		},
+		Spec: gardencorev1alpha1.ShootSpec{
+			CloudProfileName: cloudProfileName,
+			Kubernetes: gardencorev1alpha1.Kubernetes{
+				Version: shootKubernetesVersion(cloudInfo.K8sVersion),
+			},
+			Networking: gardencorev1alpha1.Networking{
+				Type:  Unknown,
+				Nodes: Unknown,
+			},
+			Provider: gardencorev1alpha1.Provider{
+				Type:    shootProviderType(cloudInfo.CloudType),
+				Workers: shootWorkers(cloudInfo.NodePools),
+			},
+			Region:            cloudInfo.Region,
+			SecretBindingName: Unknown,
+		},
+	}
+}
+
+// shootProviderType returns the Gardener provider type for the given cloud type detected from the nodes' provider IDs.
+func shootProviderType(cloudType string) string {
+	if len(cloudType) == 0 {
+		return Unknown
+	}
+	if providerType, ok := providerTypes[cloudType]; ok {
+		return providerType
+	}
+	return cloudType
+}
+
+// shootKubernetesVersion returns the semantic version without any vendor-specific suffixes for the given Kubernetes
+// version (e.g. "v1.15.4-gke.18" becomes "1.15.4").
+func shootKubernetesVersion(version string) string {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return Unknown
+	}
+	return fmt.Sprintf("%d.%d.%d", v.Major(), v.Minor(), v.Patch())
+}
+
+// shootWorkers returns a worker pool for each of the given node pools. The worker names are valid and unique.
+func shootWorkers(nodePools []gardencorev1alpha1.NodePoolInfo) []gardencorev1alpha1.Worker {
+	var (
+		workers = make([]gardencorev1alpha1.Worker, 0, len(nodePools))
+		names   = sets.NewString()
+	)
+
+	for _, pool := range nodePools {
+		name := shootWorkerName(pool.Name, names)
+		names.Insert(name)
+
+		workers = append(workers, gardencorev1alpha1.Worker{
+			Name: name,
+			Machine: gardencorev1alpha1.Machine{
+				Type: pool.MachineType,
+			},
+			Minimum: pool.Count,
+			Maximum: pool.Count,
+			Zones:   pool.Zones,
+		})
+	}
+
+	return workers
+}
+
+// shootWorkerName sanitizes the given node pool name so that it is a valid worker name which is not contained in the
+// set of already used names.
+func shootWorkerName(poolName string, usedNames sets.String) string {
+	name := strings.Trim(invalidWorkerNameCharacters.ReplaceAllString(strings.ToLower(poolName), "-"), "-")
+	if len(name) == 0 {
+		name = defaultNodePoolName
+	}
+	if len(name) > maxWorkerNameLength {
+		name = strings.TrimRight(name[:maxWorkerNameLength], "-")
+	}
+
+	for i, candidate := 1, name; ; i++ {
+		if !usedNames.Has(candidate) {
+			return candidate
+		}
+
+		suffix := fmt.Sprintf("-%d", i)
+		prefix := name
+		if len(prefix)+len(suffix) > maxWorkerNameLength {
+			prefix = strings.TrimRight(prefix[:maxWorkerNameLength-len(suffix)], "-")
+		}
+		candidate = prefix + suffix
+	}
+}
@@ -15,11 +15,21 @@ package plant_test
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"math/big"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -35,6 +45,7 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -60,6 +71,49 @@ func makeNodeWithProvider(provider string, withLabels map[string]string) corev1.
 	}
 }
 
+func makeCertificatePEM(notAfter time.Time) []byte {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             notAfter.Add(-24 * time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
+
+func makeKubeconfig(caPEM []byte) []byte {
+	return []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: plant
+clusters:
+- name: plant
+  cluster:
+    server: https://plant
+    certificate-authority-data: %s
+contexts:
+- name: plant
+  context:
+    cluster: plant
+    user: plant
+users:
+- name: plant
+  user:
+    token: token
+`, base64.StdEncoding.EncodeToString(caPEM)))
+}
+
 func hasConditonTrue(cond gardencorev1alpha1.Condition) bool {
 	return cond.Status == gardencorev1alpha1.ConditionTrue
 }
@@ -106,10 +160,110 @@ var _ = Describe("Plant", func() {
 			Expect(statusInfo).To(Equal(expectedInfo))
 		},
 			Entry("It should return unknown if provider is not listed",
-				makeNodeWithProvider("", map[string]string{labelZoneRegion: region}), BeNil(), &plant.StatusCloudInfo{CloudType: unKnown, K8sVersion: k8sVersion, Region: region}),
+				makeNodeWithProvider("", map[string]string{labelZoneRegion: region}), BeNil(), &plant.StatusCloudInfo{CloudType: unKnown, K8sVersion: k8sVersion, Region: region, NodePools: []gardencorev1alpha1.NodePoolInfo{{Name: "worker", MachineType: unKnown, Count: 1}}}),
 			Entry("It should return the provider successfully",
-				makeNodeWithProvider("aws://zones.something", map[string]string{labelZoneRegion: region}), BeNil(), &plant.StatusCloudInfo{CloudType: "aws", K8sVersion: k8sVersion, Region: region}),
+				makeNodeWithProvider("aws://zones.something", map[string]string{labelZoneRegion: region}), BeNil(), &plant.StatusCloudInfo{CloudType: "aws", K8sVersion: k8sVersion, Region: region, NodePools: []gardencorev1alpha1.NodePoolInfo{{Name: "worker", MachineType: unKnown, Count: 1}}}),
 		)
+
+		It("should group the nodes into node pools", func() {
+			var (
+				discoveryMockclient = mockdiscovery.NewMockDiscoveryInterface(ctrl)
+				runtimeClient       = mockclient.NewMockClient(ctrl)
+				testLogger          = logger.NewFieldLogger(logger.NewLogger("info"), "test", "test-plant")
+				nodeInfo            = corev1.NodeSystemInfo{OSImage: "Container-Optimized OS from Google", KubeletVersion: "v1.13.1-gke.1"}
+			)
+
+			nodes := []corev1.Node{
+				makeNodeWithProvider("gce://project/europe-west1-b/node-1", map[string]string{labelZoneRegion: "europe-west1", corev1.LabelZoneFailureDomain: "europe-west1-b", "cloud.google.com/gke-nodepool": "default-pool", corev1.LabelInstanceType: "n1-standard-2"}),
+				makeNodeWithProvider("gce://project/europe-west1-c/node-2", map[string]string{labelZoneRegion: "europe-west1", corev1.LabelZoneFailureDomain: "europe-west1-c", "cloud.google.com/gke-nodepool": "default-pool", corev1.LabelInstanceType: "n1-standard-2"}),
+				makeNodeWithProvider("gce://project/europe-west1-b/node-3", map[string]string{labelZoneRegion: "europe-west1", corev1.LabelZoneFailureDomain: "europe-west1-b", "cloud.google.com/gke-nodepool": "default-pool", corev1.LabelInstanceType: "n1-standard-2"}),
+				makeNodeWithProvider("gce://project/europe-west1-b/node-4", map[string]string{labelZoneRegion: "europe-west1", corev1.LabelZoneFailureDomain: "europe-west1-b", "cloud.google.com/gke-nodepool": "big-pool", "node.kubernetes.io/instance-type": "n1-highmem-8"}),
+			}
+			for i := range nodes {
+				nodes[i].Status.NodeInfo = nodeInfo
+			}
+
+			runtimeClient.EXPECT().List(context.TODO(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOptionFunc) error {
+				list.(*corev1.NodeList).Items = nodes
+				return nil
+			})
+			discoveryMockclient.EXPECT().ServerVersion().Return(&version.Info{GitVersion: "v1.13.1-gke.1"}, nil)
+
+			statusInfo, err := plant.FetchCloudInfo(context.TODO(), runtimeClient, discoveryMockclient, testLogger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statusInfo.CloudType).To(Equal("gce"))
+			Expect(statusInfo.NodePools).To(Equal([]gardencorev1alpha1.NodePoolInfo{
+				{Name: "big-pool", MachineType: "n1-highmem-8", OSImage: nodeInfo.OSImage, KubeletVersion: nodeInfo.KubeletVersion, Zones: []string{"europe-west1-b"}, Count: 1},
+				{Name: "default-pool", MachineType: "n1-standard-2", OSImage: nodeInfo.OSImage, KubeletVersion: nodeInfo.KubeletVersion, Zones: []string{"europe-west1-b", "europe-west1-c"}, Count: 3},
+			}))
+		})
+	})
+
+	Context("ShootTemplate", func() {
+		var plantObj *gardencorev1alpha1.Plant
+
+		BeforeEach(func() {
+			plantObj = &gardencorev1alpha1.Plant{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "garden-dev"},
+			}
+		})
+
+		It("should compute the Shoot template from the detected cloud info", func() {
+			cloudProfileName := "gcp"
+			plantObj.Spec.CloudProfileName = &cloudProfileName
+
+			shoot := plant.ShootTemplate(plantObj, &plant.StatusCloudInfo{
+				CloudType:  "gce",
+				Region:     "europe-west1",
+				K8sVersion: "v1.15.4-gke.18",
+				NodePools: []gardencorev1alpha1.NodePoolInfo{
+					{Name: "default-pool", MachineType: "n1-standard-2", Zones: []string{"europe-west1-b", "europe-west1-c"}, Count: 3},
+				},
+			})
+
+			Expect(shoot.APIVersion).To(Equal("core.gardener.cloud/v1alpha1"))
+			Expect(shoot.Kind).To(Equal("Shoot"))
+			Expect(shoot.ObjectMeta).To(Equal(metav1.ObjectMeta{Name: "my-cluster", Namespace: "garden-dev"}))
+			Expect(shoot.Spec.CloudProfileName).To(Equal("gcp"))
+			Expect(shoot.Spec.Region).To(Equal("europe-west1"))
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.15.4"))
+			Expect(shoot.Spec.Provider.Type).To(Equal("gcp"))
+			Expect(shoot.Spec.Provider.Workers).To(Equal([]gardencorev1alpha1.Worker{
+				{
+					Name:    "default-pool",
+					Machine: gardencorev1alpha1.Machine{Type: "n1-standard-2"},
+					Minimum: 3,
+					Maximum: 3,
+					Zones:   []string{"europe-west1-b", "europe-west1-c"},
+				},
+			}))
+			Expect(shoot.Spec.SecretBindingName).To(Equal(plant.Unknown))
+		})
+
+		It("should set unknown values if nothing could be detected", func() {
+			shoot := plant.ShootTemplate(plantObj, &plant.StatusCloudInfo{})
+
+			Expect(shoot.Spec.CloudProfileName).To(Equal(plant.Unknown))
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal(plant.Unknown))
+			Expect(shoot.Spec.Provider.Type).To(Equal(plant.Unknown))
+			Expect(shoot.Spec.Provider.Workers).To(BeEmpty())
+		})
+
+		It("should compute valid and unique worker names", func() {
+			shoot := plant.ShootTemplate(plantObj, &plant.StatusCloudInfo{
+				NodePools: []gardencorev1alpha1.NodePoolInfo{
+					{Name: "Very_Long_Node_Pool_Name", MachineType: "m5.large", Count: 1},
+					{Name: "very-long-node-pool", MachineType: "m5.xlarge", Count: 2},
+					{Name: "___", MachineType: "m5.large", Count: 1},
+				},
+			})
+
+			var names []string
+			for _, worker := range shoot.Spec.Provider.Workers {
+				names = append(names, worker.Name)
+			}
+			Expect(names).To(Equal([]string{"very-long-node", "very-long-nod-1", "worker"}))
+		})
 	})
 	Context("HealthChecker", func() {
 		var (
@@ -192,5 +346,162 @@ var _ = Describe("Plant", func() {
 			},
 			Entry("no healthy cluster nodes", BeTrue()),
 		)
+
+		DescribeTable("checkSystemComponents",
+			func(deployment appsv1.Deployment, daemonSet appsv1.DaemonSet, caseMatcher types.GomegaMatcher) {
+				var (
+					conditionSystemComponentsHealthy = gardencorev1alpha1helper.InitCondition(gardencorev1alpha1.PlantSystemComponentsHealthy)
+					runtimeClient                    = mockclient.NewMockClient(ctrl)
+				)
+
+				healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&appsv1.DeploymentList{}), gomock.Any()).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOptionFunc) error {
+					list.(*appsv1.DeploymentList).Items = []appsv1.Deployment{deployment}
+					return nil
+				})
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&appsv1.DaemonSetList{}), gomock.Any()).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOptionFunc) error {
+					list.(*appsv1.DaemonSetList).Items = []appsv1.DaemonSet{daemonSet}
+					return nil
+				}).AnyTimes()
+
+				condition := healthChecker.CheckSystemComponents(context.TODO(), conditionSystemComponentsHealthy)
+				Expect(hasConditonTrue(condition)).To(caseMatcher)
+			},
+			Entry("healthy system components", appsv1.Deployment{
+				Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}}},
+			}, appsv1.DaemonSet{}, BeTrue()),
+			Entry("unhealthy deployment", appsv1.Deployment{}, appsv1.DaemonSet{}, BeFalse()),
+			Entry("unhealthy daemon set", appsv1.Deployment{
+				Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}}},
+			}, appsv1.DaemonSet{
+				Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, CurrentNumberScheduled: 1},
+			}, BeFalse()),
+		)
+
+		DescribeTable("checkKubernetesVersion",
+			func(versions []gardencorev1alpha1.ExpirableVersion, kubernetesVersion string, expectedStatus gardencorev1alpha1.ConditionStatus, expectedReason string) {
+				var (
+					conditionVersionSupported = gardencorev1alpha1helper.InitCondition(gardencorev1alpha1.PlantKubernetesVersionSupported)
+					cloudProfile              = &gardencorev1alpha1.CloudProfile{
+						ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+						Spec: gardencorev1alpha1.CloudProfileSpec{
+							Kubernetes: gardencorev1alpha1.KubernetesSettings{Versions: versions},
+						},
+					}
+				)
+
+				healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+
+				condition := healthChecker.CheckKubernetesVersion(conditionVersionSupported, cloudProfile, kubernetesVersion)
+				Expect(condition.Status).To(Equal(expectedStatus))
+				Expect(condition.Reason).To(Equal(expectedReason))
+			},
+			Entry("version offered", []gardencorev1alpha1.ExpirableVersion{{Version: "1.15.4"}}, "v1.15.4", gardencorev1alpha1.ConditionTrue, "KubernetesVersionOffered"),
+			Entry("version offered with provider suffix", []gardencorev1alpha1.ExpirableVersion{{Version: "1.15.4"}}, "v1.15.4-gke.22", gardencorev1alpha1.ConditionTrue, "KubernetesVersionOffered"),
+			Entry("newer patch version available", []gardencorev1alpha1.ExpirableVersion{{Version: "1.15.4"}, {Version: "1.15.5"}}, "v1.15.4", gardencorev1alpha1.ConditionTrue, "NewerPatchVersionAvailable"),
+			Entry("version not offered", []gardencorev1alpha1.ExpirableVersion{{Version: "1.15.4"}}, "v1.14.1", gardencorev1alpha1.ConditionFalse, "KubernetesVersionNotOffered"),
+			Entry("version expired", []gardencorev1alpha1.ExpirableVersion{{Version: "1.15.4", ExpirationDate: &metav1.Time{Time: time.Now().Add(-time.Hour)}}}, "v1.15.4", gardencorev1alpha1.ConditionFalse, "KubernetesVersionExpired"),
+		)
+
+		DescribeTable("checkCertificates",
+			func(validity *time.Duration, expectedStatus gardencorev1alpha1.ConditionStatus, expectedReason string) {
+				var (
+					conditionCertificatesValid = gardencorev1alpha1helper.InitCondition(gardencorev1alpha1.PlantCertificatesValid)
+					caPEM                      []byte
+				)
+
+				if validity != nil {
+					caPEM = makeCertificatePEM(time.Now().Add(*validity))
+				}
+
+				healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+
+				condition := healthChecker.CheckCertificates(conditionCertificatesValid, makeKubeconfig(caPEM))
+				Expect(condition.Status).To(Equal(expectedStatus))
+				Expect(condition.Reason).To(Equal(expectedReason))
+			},
+			Entry("valid certificate", durationPtr(365*24*time.Hour), gardencorev1alpha1.ConditionTrue, "CertificatesValid"),
+			Entry("certificate expires soon", durationPtr(7*24*time.Hour), gardencorev1alpha1.ConditionFalse, "CertificateExpiresSoon"),
+			Entry("expired certificate", durationPtr(-time.Hour), gardencorev1alpha1.ConditionFalse, "CertificateExpired"),
+			Entry("no certificates", nil, gardencorev1alpha1.ConditionTrue, "NoCertificatesFound"),
+		)
+
+		It("checkCertificates - should return unknown for an invalid kubeconfig", func() {
+			conditionCertificatesValid := gardencorev1alpha1helper.InitCondition(gardencorev1alpha1.PlantCertificatesValid)
+
+			healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+
+			condition := healthChecker.CheckCertificates(conditionCertificatesValid, []byte("foo"))
+			Expect(hasConditionUnknown(condition)).To(BeTrue())
+		})
+
+		DescribeTable("checkEndpoints",
+			func(statusCode int, caseMatcher types.GomegaMatcher) {
+				var (
+					conditionEndpointsReachable = gardencorev1alpha1helper.InitCondition(gardencorev1alpha1.PlantEndpointsReachable)
+					server                      = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(statusCode)
+					}))
+				)
+				defer server.Close()
+				defer allowEndpointAddresses()()
+
+				healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+
+				condition := healthChecker.CheckEndpoints(context.TODO(), conditionEndpointsReachable, []gardencorev1alpha1.Endpoint{{Name: "dashboard", URL: server.URL, Purpose: "monitoring"}})
+				Expect(hasConditonTrue(condition)).To(caseMatcher)
+			},
+			Entry("reachable endpoint", http.StatusOK, BeTrue()),
+			Entry("reachable endpoint requiring authentication", http.StatusUnauthorized, BeTrue()),
+			Entry("endpoint with server error", http.StatusServiceUnavailable, BeFalse()),
+		)
+
+		It("checkEndpoints - should report unreachable endpoints", func() {
+			conditionEndpointsReachable := gardencorev1alpha1helper.InitCondition(gardencorev1alpha1.PlantEndpointsReachable)
+
+			healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+
+			condition := healthChecker.CheckEndpoints(context.TODO(), conditionEndpointsReachable, []gardencorev1alpha1.Endpoint{{Name: "dashboard", URL: "http://127.0.0.1:0"}})
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Message).To(ContainSubstring("dashboard"))
+		})
+
+		It("checkEndpoints - should not probe non-public addresses", func() {
+			var (
+				conditionEndpointsReachable = gardencorev1alpha1helper.InitCondition(gardencorev1alpha1.PlantEndpointsReachable)
+				requested                   bool
+				server                      = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requested = true
+				}))
+			)
+			defer server.Close()
+
+			healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+
+			condition := healthChecker.CheckEndpoints(context.TODO(), conditionEndpointsReachable, []gardencorev1alpha1.Endpoint{
+				{Name: "loopback", URL: server.URL},
+				{Name: "metadata", URL: "http://169.254.169.254/latest/meta-data"},
+			})
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Message).To(And(ContainSubstring("loopback"), ContainSubstring("metadata")))
+			Expect(requested).To(BeFalse())
+		})
+
+		It("checkEndpoints - should not probe endpoints with schemes other than http(s)", func() {
+			conditionEndpointsReachable := gardencorev1alpha1helper.InitCondition(gardencorev1alpha1.PlantEndpointsReachable)
+			defer allowEndpointAddresses()()
+
+			healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+
+			condition := healthChecker.CheckEndpoints(context.TODO(), conditionEndpointsReachable, []gardencorev1alpha1.Endpoint{{Name: "file", URL: "file:///etc/passwd"}})
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Message).To(ContainSubstring("unsupported scheme"))
+		})
 	})
 })
+
+func allowEndpointAddresses() func() {
+	oldEndpointAddressAllowed := plant.EndpointAddressAllowed
+	plant.EndpointAddressAllowed = func(net.IP) bool { return true }
+	return func() { plant.EndpointAddressAllowed = oldEndpointAddressAllowed }
+}
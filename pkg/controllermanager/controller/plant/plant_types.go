@@ -15,6 +15,7 @@
 package plant
 
 import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
@@ -24,11 +25,13 @@ import (
 )
 
 type defaultPlantControl struct {
-	k8sGardenClient kubernetes.Interface
-	plantLister     gardencorelisters.PlantLister
-	secretsLister   kubecorev1listers.SecretLister
-	recorder        record.EventRecorder
-	config          *config.ControllerManagerConfiguration
+	k8sGardenClient    kubernetes.Interface
+	plantLister        gardencorelisters.PlantLister
+	secretsLister      kubecorev1listers.SecretLister
+	cloudProfileLister gardencorelisters.CloudProfileLister
+	configMapLister    kubecorev1listers.ConfigMapLister
+	recorder           record.EventRecorder
+	config             *config.ControllerManagerConfiguration
 }
 
 // StatusCloudInfo contains the cloud info for the plant status
@@ -36,4 +39,5 @@ type StatusCloudInfo struct {
 	CloudType  string
 	Region     string
 	K8sVersion string
+	NodePools  []gardencorev1alpha1.NodePoolInfo
 }
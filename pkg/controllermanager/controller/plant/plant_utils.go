@@ -16,13 +16,14 @@ package plant
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
-	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -30,6 +31,25 @@ import (
 // Unknown is a constant to be used for unknown cloud info
 const Unknown = "<unknown>"
 
+const (
+	// labelInstanceType is the GA label for the instance type of a node.
+	labelInstanceType = "node.kubernetes.io/instance-type"
+	// defaultNodePoolName is the name of the node pool for nodes which cannot be assigned to a pool otherwise.
+	defaultNodePoolName = "worker"
+)
+
+// nodePoolLabels are well-known node labels of managed Kubernetes offerings and installers whose value is the name of
+// the node pool the node belongs to.
+var nodePoolLabels = []string{
+	"worker.gardener.cloud/pool",
+	"cloud.google.com/gke-nodepool",
+	"eks.amazonaws.com/nodegroup",
+	"alpha.eksctl.io/nodegroup-name",
+	"kubernetes.azure.com/agentpool",
+	"agentpool",
+	"kops.k8s.io/instancegroup",
+}
+
 // FetchCloudInfo deduces the cloud info from the plant cluster
 func FetchCloudInfo(ctx context.Context, plantClient client.Client, discoveryClient discovery.DiscoveryInterface, logger logrus.FieldLogger) (*StatusCloudInfo, error) {
 	cloudInfo, err := getClusterInfo(ctx, plantClient, logger)
@@ -46,10 +66,11 @@ func FetchCloudInfo(ctx context.Context, plantClient client.Client, discoveryCli
 	return cloudInfo, nil
 }
 
-// getClusterInfo gets the kubernetes cluster zones and Region by inspecting labels on nodes in the cluster.
+// getClusterInfo gets the kubernetes cluster zones and Region by inspecting labels on nodes in the cluster. It also
+// groups the nodes into node pools.
 func getClusterInfo(ctx context.Context, cl client.Client, logger logrus.FieldLogger) (*StatusCloudInfo, error) {
 	nodes := &corev1.NodeList{}
-	if err := cl.List(ctx, nodes, kutil.Limit(1)); err != nil {
+	if err := cl.List(ctx, nodes); err != nil {
 		return nil, err
 	}
 
@@ -69,9 +90,81 @@ func getClusterInfo(ctx context.Context, cl client.Client, logger logrus.FieldLo
 	return &StatusCloudInfo{
 		Region:    region,
 		CloudType: provider,
+		NodePools: getNodePools(nodes.Items),
 	}, nil
 }
 
+// getNodePools groups the given nodes by their node pool and machine type. The pools are sorted by name.
+func getNodePools(nodes []corev1.Node) []gardencorev1alpha1.NodePoolInfo {
+	type poolKey struct {
+		name        string
+		machineType string
+	}
+
+	var (
+		keys  []poolKey
+		pools = map[poolKey]*gardencorev1alpha1.NodePoolInfo{}
+		zones = map[poolKey]sets.String{}
+	)
+
+	for _, node := range nodes {
+		key := poolKey{getNodePoolNameForNode(node), getMachineTypeForNode(node)}
+
+		pool, ok := pools[key]
+		if !ok {
+			pool = &gardencorev1alpha1.NodePoolInfo{
+				Name:           key.name,
+				MachineType:    key.machineType,
+				OSImage:        node.Status.NodeInfo.OSImage,
+				KubeletVersion: node.Status.NodeInfo.KubeletVersion,
+			}
+			pools[key] = pool
+			zones[key] = sets.NewString()
+			keys = append(keys, key)
+		}
+
+		pool.Count++
+		if zone, ok := node.Labels[corev1.LabelZoneFailureDomain]; ok {
+			zones[key].Insert(zone)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		return keys[i].machineType < keys[j].machineType
+	})
+
+	nodePools := make([]gardencorev1alpha1.NodePoolInfo, 0, len(keys))
+	for _, key := range keys {
+		pool := pools[key]
+		if zones[key].Len() > 0 {
+			pool.Zones = zones[key].List()
+		}
+		nodePools = append(nodePools, *pool)
+	}
+	return nodePools
+}
+
+func getNodePoolNameForNode(node corev1.Node) string {
+	for _, label := range nodePoolLabels {
+		if value, ok := node.Labels[label]; ok && len(value) > 0 {
+			return value
+		}
+	}
+	return defaultNodePoolName
+}
+
+func getMachineTypeForNode(node corev1.Node) string {
+	for _, label := range []string{labelInstanceType, corev1.LabelInstanceType} {
+		if value, ok := node.Labels[label]; ok && len(value) > 0 {
+			return value
+		}
+	}
+	return Unknown
+}
+
 func getCloudProviderForNode(providerID string) string {
 	provider := strings.Split(providerID, "://")
 	if len(provider) == 1 && len(providerID) == 0 {
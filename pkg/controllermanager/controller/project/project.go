@@ -22,6 +22,7 @@ import (
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 
@@ -37,12 +38,20 @@ type Controller struct {
 	k8sGardenClient        kubernetes.Interface
 	k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory
 
-	control  ControlInterface
-	recorder record.EventRecorder
+	config *config.ProjectControllerConfiguration
 
-	projectLister gardencorelisters.ProjectLister
-	projectQueue  workqueue.RateLimitingInterface
-	projectSynced cache.InformerSynced
+	control      ControlInterface
+	staleControl StaleControlInterface
+	recorder     record.EventRecorder
+
+	projectLister     gardencorelisters.ProjectLister
+	projectQueue      workqueue.RateLimitingInterface
+	projectStaleQueue workqueue.RateLimitingInterface
+	projectSynced     cache.InformerSynced
+
+	shootSynced         cache.InformerSynced
+	plantSynced         cache.InformerSynced
+	secretBindingSynced cache.InformerSynced
 
 	namespaceLister kubecorev1listers.NamespaceLister
 	namespaceQueue  workqueue.RateLimitingInterface
@@ -53,9 +62,9 @@ type Controller struct {
 }
 
 // NewProjectController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a struct
-// holding information about the acting Gardener, a <projectInformer>, and a <recorder> for
+// holding information about the acting Gardener, a <projectInformer>, the controller <config>, and a <recorder> for
 // event recording. It creates a new Gardener controller.
-func NewProjectController(k8sGardenClient kubernetes.Interface, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, recorder record.EventRecorder) *Controller {
+func NewProjectController(k8sGardenClient kubernetes.Interface, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, config *config.ProjectControllerConfiguration, recorder record.EventRecorder) *Controller {
 	var (
 		gardenCoreV1alpha1Informer = gardenCoreInformerFactory.Core().V1alpha1()
 		corev1Informer             = kubeInformerFactory.Core().V1()
@@ -66,16 +75,28 @@ func NewProjectController(k8sGardenClient kubernetes.Interface, gardenCoreInform
 		namespaceInformer = corev1Informer.Namespaces()
 		namespaceLister   = namespaceInformer.Lister()
 
+		shootInformer = gardenCoreV1alpha1Informer.Shoots()
+		shootLister   = shootInformer.Lister()
+
+		plantInformer = gardenCoreV1alpha1Informer.Plants()
+		plantLister   = plantInformer.Lister()
+
+		secretBindingInformer = gardenCoreV1alpha1Informer.SecretBindings()
+		secretBindingLister   = secretBindingInformer.Lister()
+
 		projectUpdater = NewRealUpdater(k8sGardenClient, projectLister)
 	)
 
 	projectController := &Controller{
 		k8sGardenClient:        k8sGardenClient,
 		k8sGardenCoreInformers: gardenCoreInformerFactory,
-		control:                NewDefaultControl(k8sGardenClient, gardenCoreInformerFactory, recorder, projectUpdater, namespaceLister),
+		config:                 config,
+		control:                NewDefaultControl(k8sGardenClient, gardenCoreInformerFactory, config, recorder, projectUpdater, namespaceLister),
+		staleControl:           NewDefaultStaleControl(k8sGardenClient, config, recorder, shootLister, plantLister, secretBindingLister),
 		recorder:               recorder,
 		projectLister:          projectLister,
 		projectQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Project"),
+		projectStaleQueue:      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "project-stale"),
 		namespaceLister:        namespaceLister,
 		namespaceQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Namespace"),
 		workerCh:               make(chan int),
@@ -86,8 +107,14 @@ func NewProjectController(k8sGardenClient kubernetes.Interface, gardenCoreInform
 		UpdateFunc: projectController.projectUpdate,
 		DeleteFunc: projectController.projectDelete,
 	})
+	projectInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: projectController.projectStaleAdd,
+	})
 	projectController.projectSynced = projectInformer.Informer().HasSynced
 	projectController.namespaceSynced = namespaceInformer.Informer().HasSynced
+	projectController.shootSynced = shootInformer.Informer().HasSynced
+	projectController.plantSynced = plantInformer.Informer().HasSynced
+	projectController.secretBindingSynced = secretBindingInformer.Informer().HasSynced
 
 	return projectController
 }
@@ -96,7 +123,7 @@ func NewProjectController(k8sGardenClient kubernetes.Interface, gardenCoreInform
 func (c *Controller) Run(ctx context.Context, workers int) {
 	var waitGroup sync.WaitGroup
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.projectSynced, c.namespaceSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.projectSynced, c.namespaceSynced, c.shootSynced, c.plantSynced, c.secretBindingSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
@@ -116,18 +143,21 @@ func (c *Controller) Run(ctx context.Context, workers int) {
 
 	for i := 0; i < workers; i++ {
 		controllerutils.DeprecatedCreateWorker(ctx, c.projectQueue, "Project", c.reconcileProjectKey, &waitGroup, c.workerCh)
+		controllerutils.DeprecatedCreateWorker(ctx, c.projectStaleQueue, "Project Stale", c.reconcileProjectStaleKey, &waitGroup, c.workerCh)
 	}
 
 	// Shutdown handling
 	<-ctx.Done()
 	c.projectQueue.ShutDown()
+	c.projectStaleQueue.ShutDown()
 
 	for {
-		if c.projectQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+		queueLengths := c.projectQueue.Len() + c.projectStaleQueue.Len()
+		if queueLengths == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Debug("No running Project worker and no items left in the queues. Terminated Project controller...")
 			break
 		}
-		logger.Logger.Debugf("Waiting for %d Project worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.projectQueue.Len())
+		logger.Logger.Debugf("Waiting for %d Project worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, queueLengths)
 		time.Sleep(5 * time.Second)
 	}
 
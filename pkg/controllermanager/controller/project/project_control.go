@@ -22,6 +22,7 @@ import (
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	kutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -103,13 +104,14 @@ type ControlInterface interface {
 // implements the documented semantics for Projects. updater is the UpdaterInterface used
 // to update the status of Projects. You should use an instance returned from NewDefaultControl() for any
 // scenario other than testing.
-func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, updater UpdaterInterface, namespaceLister kubecorev1listers.NamespaceLister) ControlInterface {
-	return &defaultControl{k8sGardenClient, k8sGardenCoreInformers, recorder, updater, namespaceLister}
+func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, config *config.ProjectControllerConfiguration, recorder record.EventRecorder, updater UpdaterInterface, namespaceLister kubecorev1listers.NamespaceLister) ControlInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenCoreInformers, config, recorder, updater, namespaceLister}
 }
 
 type defaultControl struct {
 	k8sGardenClient        kubernetes.Interface
 	k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory
+	config                 *config.ProjectControllerConfiguration
 	recorder               record.EventRecorder
 	updater                UpdaterInterface
 	namespaceLister        kubecorev1listers.NamespaceLister
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -33,8 +34,12 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func (c *defaultControl) reconcile(project *gardencorev1alpha1.Project, projectLogger logrus.FieldLogger) error {
@@ -114,30 +119,47 @@ func (c *defaultControl) reconcile(project *gardencorev1alpha1.Project, projectL
 	}
 	chartApplier := kubernetes.NewChartApplier(chartRenderer, applier)
 
+	// Create the service accounts declared in the project specification and delete those which are no longer declared.
+	if err := c.reconcileServiceAccounts(ctx, project, namespace.Name); err != nil {
+		c.reportEvent(project, true, gardencorev1alpha1.ProjectEventNamespaceReconcileFailed, "Error while reconciling service accounts in namespace %q: %+v", namespace.Name, err)
+		c.updateProjectStatus(project.ObjectMeta, setProjectPhase(gardencorev1alpha1.ProjectFailed))
+		return err
+	}
+
+	// Maintain the ResourceQuota and LimitRange in the project namespace according to the quota configuration.
+	if err := c.reconcileQuotas(ctx, project, namespace.Name); err != nil {
+		c.reportEvent(project, true, gardencorev1alpha1.ProjectEventNamespaceReconcileFailed, "Error while reconciling quotas in namespace %q: %+v", namespace.Name, err)
+		c.updateProjectStatus(project.ObjectMeta, setProjectPhase(gardencorev1alpha1.ProjectFailed))
+		return err
+	}
+
 	// Create RBAC rules to allow project owner and project members to read, update, and delete the project.
 	// We also create a RoleBinding in the namespace that binds all members to the garden.sapcloud.io:system:project-member
-	// role to ensure access for listing shoots, creating secrets, etc.
+	// role to ensure access for listing shoots, creating secrets, etc. Members with extension roles are bound to a
+	// ClusterRole aggregating all ClusterRoles provided by extensions for the respective role.
 	var (
-		admins  []rbacv1.Subject
-		viewers []rbacv1.Subject
+		subjects       = subjectsByRole(project.Spec.Members, project.Spec.ServiceAccounts, namespace.Name)
+		extensionRoles []map[string]interface{}
 	)
 
-	for _, member := range project.Spec.Members {
-		if member.Role == gardencorev1alpha1.ProjectMemberAdmin {
-			admins = append(admins, member.Subject)
-		}
-		if member.Role == gardencorev1alpha1.ProjectMemberViewer {
-			viewers = append(viewers, member.Subject)
-		}
+	for _, name := range extensionRoleNames(subjects) {
+		extensionRoles = append(extensionRoles, map[string]interface{}{
+			"name":     name,
+			"subjects": subjects[gardencorev1alpha1.ProjectMemberExtensionPrefix+name],
+		})
 	}
 
 	if err := chartApplier.ApplyChart(context.TODO(), filepath.Join(common.ChartPath, "garden-project", "charts", "project-rbac"), namespace.Name, "project-rbac", map[string]interface{}{
 		"project": map[string]interface{}{
-			"name":    project.Name,
-			"uid":     project.UID,
-			"owner":   project.Spec.Owner,
-			"members": admins,
-			"viewers": viewers,
+			"name":                   project.Name,
+			"uid":                    project.UID,
+			"owner":                  project.Spec.Owner,
+			"members":                subjects[gardencorev1alpha1.ProjectMemberAdmin],
+			"viewers":                subjects[gardencorev1alpha1.ProjectMemberViewer],
+			"uams":                   subjects[gardencorev1alpha1.ProjectMemberUserAccessManager],
+			"serviceAccountManagers": subjects[gardencorev1alpha1.ProjectMemberServiceAccountManager],
+			"tokenServiceAccounts":   tokenServiceAccountNames(project.Spec.ServiceAccounts),
+			"extensionRoles":         extensionRoles,
 		},
 	}, nil); err != nil {
 		c.reportEvent(project, true, gardencorev1alpha1.ProjectEventNamespaceReconcileFailed, "Error while creating RBAC rules for namespace %q: %+v", namespace.Name, err)
@@ -145,8 +167,18 @@ func (c *defaultControl) reconcile(project *gardencorev1alpha1.Project, projectL
 		return err
 	}
 
+	if err := c.deleteStaleExtensionRoles(ctx, project, namespace.Name, sets.NewString(extensionRoleNames(subjects)...)); err != nil {
+		c.reportEvent(project, true, gardencorev1alpha1.ProjectEventNamespaceReconcileFailed, "Error while deleting stale extension roles for namespace %q: %+v", namespace.Name, err)
+		c.updateProjectStatus(project.ObjectMeta, setProjectPhase(gardencorev1alpha1.ProjectFailed))
+		return err
+	}
+
 	// Update the project status to mark it as 'ready'.
 	if _, err := c.updateProjectStatus(project.ObjectMeta, func(project *gardencorev1alpha1.Project) (*gardencorev1alpha1.Project, error) {
+		if project.Status.ObservedGeneration != generation {
+			// Changes to the specification (e.g., to the members) count as activity in the project.
+			project.Status.LastActivityTimestamp = &metav1.Time{Time: time.Now()}
+		}
 		project.Status.Phase = gardencorev1alpha1.ProjectReady
 		project.Status.ObservedGeneration = generation
 		return project, nil
@@ -213,3 +245,158 @@ func (c *defaultControl) reconcileNamespaceForProject(project *gardencorev1alpha
 
 	return namespace, nil
 }
+
+// deleteStaleExtensionRoles deletes the ClusterRoles and RoleBindings of extension roles which are no longer assigned
+// to any member of the project.
+func (c *defaultControl) deleteStaleExtensionRoles(ctx context.Context, project *gardencorev1alpha1.Project, namespace string, wantedRoles sets.String) error {
+	selector := client.MatchingLabels(map[string]string{common.ProjectName: project.Name})
+
+	roleBindingList := &rbacv1.RoleBindingList{}
+	if err := c.k8sGardenClient.Client().List(ctx, roleBindingList, client.InNamespace(namespace), selector); err != nil {
+		return err
+	}
+	for _, roleBinding := range roleBindingList.Items {
+		role, ok := roleBinding.Labels[common.LabelExtensionProjectRole]
+		if !ok || wantedRoles.Has(role) {
+			continue
+		}
+		if err := c.k8sGardenClient.Client().Delete(ctx, roleBinding.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	clusterRoleList := &rbacv1.ClusterRoleList{}
+	if err := c.k8sGardenClient.Client().List(ctx, clusterRoleList, selector); err != nil {
+		return err
+	}
+	for _, clusterRole := range clusterRoleList.Items {
+		role, ok := clusterRole.Labels[common.LabelExtensionProjectRole]
+		if !ok || wantedRoles.Has(role) {
+			continue
+		}
+		if err := c.k8sGardenClient.Client().Delete(ctx, clusterRole.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileServiceAccounts ensures that the service accounts declared in the project specification exist in the project
+// namespace and are controlled by the project. Service accounts controlled by the project which are no longer declared
+// are deleted.
+func (c *defaultControl) reconcileServiceAccounts(ctx context.Context, project *gardencorev1alpha1.Project, namespace string) error {
+	var (
+		wanted   = sets.NewString()
+		ownerRef = metav1.NewControllerRef(project, gardencorev1alpha1.SchemeGroupVersion.WithKind("Project"))
+	)
+	for _, projectServiceAccount := range project.Spec.ServiceAccounts {
+		wanted.Insert(projectServiceAccount.Name)
+
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      projectServiceAccount.Name,
+				Namespace: namespace,
+			},
+		}
+		if err := kutils.CreateOrUpdate(ctx, c.k8sGardenClient.Client(), serviceAccount, func() error {
+			serviceAccount.Labels = utils.MergeStringMaps(serviceAccount.Labels, map[string]string{
+				common.ProjectName:                project.Name,
+				common.LabelProjectServiceAccount: "true",
+			})
+			if controllerRef := metav1.GetControllerOf(serviceAccount); controllerRef == nil {
+				serviceAccount.OwnerReferences = append(serviceAccount.OwnerReferences, *ownerRef)
+			} else if controllerRef.UID != project.UID {
+				return fmt.Errorf("service account %q is already controlled by %s %q", serviceAccount.Name, controllerRef.Kind, controllerRef.Name)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	serviceAccountList := &corev1.ServiceAccountList{}
+	if err := c.k8sGardenClient.Client().List(ctx, serviceAccountList, client.InNamespace(namespace), client.MatchingLabels(map[string]string{common.LabelProjectServiceAccount: "true"})); err != nil {
+		return err
+	}
+	for _, serviceAccount := range serviceAccountList.Items {
+		// Only delete service accounts which have been created for the project, the label alone can be set by anyone
+		// who is allowed to manage service accounts in the namespace.
+		if wanted.Has(serviceAccount.Name) || !metav1.IsControlledBy(&serviceAccount, project) {
+			continue
+		}
+		if err := c.k8sGardenClient.Client().Delete(ctx, serviceAccount.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileQuotas ensures that the ResourceQuota and LimitRange of the quota configuration matching the project exist
+// in the project namespace. If no configuration matches, or if it does not specify them, they are deleted. Only objects
+// labelled as managed by the project controller are deleted. The garden namespace is never touched.
+func (c *defaultControl) reconcileQuotas(ctx context.Context, project *gardencorev1alpha1.Project, namespace string) error {
+	if namespace == v1alpha1constants.GardenNamespace {
+		return nil
+	}
+
+	quotaConfig, err := quotaConfigurationForProject(c.config.Quotas, project)
+	if err != nil {
+		return err
+	}
+
+	resourceQuota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      projectQuotaName,
+			Namespace: namespace,
+		},
+	}
+	if quotaConfig != nil && quotaConfig.ResourceQuota != nil {
+		if err := kutils.CreateOrUpdate(ctx, c.k8sGardenClient.Client(), resourceQuota, func() error {
+			resourceQuota.Labels = utils.MergeStringMaps(resourceQuota.Labels, quotaLabels(project))
+			resourceQuota.Spec = *quotaConfig.ResourceQuota.DeepCopy()
+			return nil
+		}); err != nil {
+			return err
+		}
+	} else if err := c.deleteManagedQuotaObject(ctx, resourceQuota); err != nil {
+		return err
+	}
+
+	limitRange := &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      projectQuotaName,
+			Namespace: namespace,
+		},
+	}
+	if quotaConfig != nil && quotaConfig.LimitRange != nil {
+		return kutils.CreateOrUpdate(ctx, c.k8sGardenClient.Client(), limitRange, func() error {
+			limitRange.Labels = utils.MergeStringMaps(limitRange.Labels, quotaLabels(project))
+			limitRange.Spec = *quotaConfig.LimitRange.DeepCopy()
+			return nil
+		})
+	}
+	return c.deleteManagedQuotaObject(ctx, limitRange)
+}
+
+// deleteManagedQuotaObject deletes the given ResourceQuota or LimitRange if it exists and is labelled as managed by the
+// project controller. Objects with the same name which have been created by others are left untouched.
+func (c *defaultControl) deleteManagedQuotaObject(ctx context.Context, obj runtime.Object) error {
+	key, err := client.ObjectKeyFromObject(obj)
+	if err != nil {
+		return err
+	}
+	if err := c.k8sGardenClient.Client().Get(ctx, key, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	if !isManagedQuotaObject(accessor) {
+		return nil
+	}
+	return client.IgnoreNotFound(c.k8sGardenClient.Client().Delete(ctx, obj))
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Reconcile", func() {
+	Describe("#reconcileQuotas", func() {
+		var (
+			ctx       = context.TODO()
+			namespace = "garden-foo"
+
+			ctrl            *gomock.Controller
+			k8sGardenClient *mockkubernetes.MockInterface
+			c               client.Client
+
+			project *gardencorev1alpha1.Project
+			control *defaultControl
+
+			newResourceQuota = func(labels map[string]string) *corev1.ResourceQuota {
+				return &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: projectQuotaName, Namespace: namespace, Labels: labels}}
+			}
+			newLimitRange = func(labels map[string]string) *corev1.LimitRange {
+				return &corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: projectQuotaName, Namespace: namespace, Labels: labels}}
+			}
+			exists = func(obj runtime.Object) bool {
+				key, err := client.ObjectKeyFromObject(obj)
+				Expect(err).NotTo(HaveOccurred())
+				err = c.Get(ctx, key, obj)
+				if apierrors.IsNotFound(err) {
+					return false
+				}
+				Expect(err).NotTo(HaveOccurred())
+				return true
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			k8sGardenClient = mockkubernetes.NewMockInterface(ctrl)
+
+			project = &gardencorev1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+			control = &defaultControl{k8sGardenClient: k8sGardenClient, config: &config.ProjectControllerConfiguration{}}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		JustBeforeEach(func() {
+			k8sGardenClient.EXPECT().Client().Return(c).AnyTimes()
+		})
+
+		Context("without quota configuration", func() {
+			BeforeEach(func() {
+				c = fake.NewFakeClient(
+					newResourceQuota(map[string]string{common.LabelProjectQuota: "true"}),
+					newLimitRange(map[string]string{common.LabelProjectQuota: "true"}),
+				)
+			})
+
+			It("should delete the labelled objects", func() {
+				Expect(control.reconcileQuotas(ctx, project, namespace)).To(Succeed())
+
+				Expect(exists(newResourceQuota(nil))).To(BeFalse())
+				Expect(exists(newLimitRange(nil))).To(BeFalse())
+			})
+
+			It("should not delete labelled objects in the garden namespace", func() {
+				resourceQuota := newResourceQuota(map[string]string{common.LabelProjectQuota: "true"})
+				resourceQuota.Namespace = v1alpha1constants.GardenNamespace
+				Expect(c.Create(ctx, resourceQuota)).To(Succeed())
+
+				Expect(control.reconcileQuotas(ctx, project, v1alpha1constants.GardenNamespace)).To(Succeed())
+
+				Expect(exists(resourceQuota)).To(BeTrue())
+			})
+		})
+
+		Context("with objects not managed by the project controller", func() {
+			BeforeEach(func() {
+				c = fake.NewFakeClient(newResourceQuota(nil), newLimitRange(map[string]string{"foo": "bar"}))
+			})
+
+			It("should not delete them", func() {
+				Expect(control.reconcileQuotas(ctx, project, namespace)).To(Succeed())
+
+				Expect(exists(newResourceQuota(nil))).To(BeTrue())
+				Expect(exists(newLimitRange(nil))).To(BeTrue())
+			})
+		})
+
+		Context("with quota configuration", func() {
+			BeforeEach(func() {
+				c = fake.NewFakeClient()
+				control.config.Quotas = []config.ProjectQuotaConfiguration{{
+					ResourceQuota: &corev1.ResourceQuotaSpec{
+						Hard: corev1.ResourceList{"count/shoots.core.gardener.cloud": resource.MustParse("10")},
+					},
+					LimitRange: &corev1.LimitRangeSpec{
+						Limits: []corev1.LimitRangeItem{{Type: corev1.LimitTypeContainer}},
+					},
+				}}
+			})
+
+			It("should create the labelled objects", func() {
+				Expect(control.reconcileQuotas(ctx, project, namespace)).To(Succeed())
+
+				resourceQuota := newResourceQuota(nil)
+				Expect(exists(resourceQuota)).To(BeTrue())
+				Expect(resourceQuota.Labels).To(Equal(map[string]string{common.ProjectName: "foo", common.LabelProjectQuota: "true"}))
+				Expect(resourceQuota.Spec).To(Equal(*control.config.Quotas[0].ResourceQuota))
+
+				limitRange := newLimitRange(nil)
+				Expect(exists(limitRange)).To(BeTrue())
+				Expect(limitRange.Labels).To(Equal(map[string]string{common.ProjectName: "foo", common.LabelProjectQuota: "true"}))
+				Expect(limitRange.Spec).To(Equal(*control.config.Quotas[0].LimitRange))
+			})
+
+			It("should not create them in the garden namespace", func() {
+				Expect(control.reconcileQuotas(ctx, project, v1alpha1constants.GardenNamespace)).To(Succeed())
+
+				resourceQuota := newResourceQuota(nil)
+				resourceQuota.Namespace = v1alpha1constants.GardenNamespace
+				Expect(exists(resourceQuota)).To(BeFalse())
+				limitRange := newLimitRange(nil)
+				limitRange.Namespace = v1alpha1constants.GardenNamespace
+				Expect(exists(limitRange)).To(BeFalse())
+			})
+		})
+	})
+})
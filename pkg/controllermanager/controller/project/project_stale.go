@@ -0,0 +1,312 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+)
+
+func (c *Controller) projectStaleAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	c.projectStaleQueue.Add(key)
+}
+
+func (c *Controller) reconcileProjectStaleKey(key string) error {
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	project, err := c.projectLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[PROJECT STALE] %s - stopping stale checks because Project has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[PROJECT STALE] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+
+	if err := c.staleControl.CheckProject(project.DeepCopy()); err != nil {
+		return err
+	}
+
+	c.projectStaleQueue.AddAfter(key, c.config.StaleSyncPeriod.Duration)
+	return nil
+}
+
+// StaleControlInterface implements the control logic for detecting stale Projects. It is implemented as an interface
+// to allow for extensions that provide different semantics. Currently, there is only one implementation.
+type StaleControlInterface interface {
+	// CheckProject determines whether the given project is stale, records the result in its status and deletes it
+	// once its auto deletion grace period has passed (only if auto deletion is configured).
+	CheckProject(project *gardencorev1alpha1.Project) error
+}
+
+// NewDefaultStaleControl returns a new instance of the default implementation StaleControlInterface that
+// implements the documented semantics for detecting stale Projects. You should use an instance returned from
+// NewDefaultStaleControl() for any scenario other than testing.
+func NewDefaultStaleControl(k8sGardenClient kubernetes.Interface, config *config.ProjectControllerConfiguration, recorder record.EventRecorder, shootLister gardencorelisters.ShootLister, plantLister gardencorelisters.PlantLister, secretBindingLister gardencorelisters.SecretBindingLister) StaleControlInterface {
+	return &defaultStaleControl{k8sGardenClient, config, recorder, shootLister, plantLister, secretBindingLister}
+}
+
+type defaultStaleControl struct {
+	k8sGardenClient     kubernetes.Interface
+	config              *config.ProjectControllerConfiguration
+	recorder            record.EventRecorder
+	shootLister         gardencorelisters.ShootLister
+	plantLister         gardencorelisters.PlantLister
+	secretBindingLister gardencorelisters.SecretBindingLister
+}
+
+// projectResources contains the resources in a project namespace which count as usage of the project.
+type projectResources struct {
+	shoots         []*gardencorev1alpha1.Shoot
+	plants         []*gardencorev1alpha1.Plant
+	secretBindings []*gardencorev1alpha1.SecretBinding
+}
+
+func (r projectResources) empty() bool {
+	return len(r.shoots) == 0 && len(r.plants) == 0 && len(r.secretBindings) == 0
+}
+
+func (c *defaultStaleControl) CheckProject(project *gardencorev1alpha1.Project) error {
+	if project.DeletionTimestamp != nil || project.Spec.Namespace == nil || c.config.StalePeriodDays == nil {
+		return nil
+	}
+
+	projectLogger := newProjectLogger(project)
+
+	resources, err := c.listProjectResources(*project.Spec.Namespace)
+	if err != nil {
+		return err
+	}
+
+	var (
+		now       = time.Now()
+		oldStatus = project.Status
+		newStatus = computeStaleStatus(project, resources, now, daysToDuration(*c.config.StalePeriodDays), c.autoDeleteGracePeriod())
+	)
+
+	if !oldStatus.LastActivityTimestamp.Equal(newStatus.LastActivityTimestamp) ||
+		!oldStatus.StaleSinceTimestamp.Equal(newStatus.StaleSinceTimestamp) ||
+		!oldStatus.StaleAutoDeleteTimestamp.Equal(newStatus.StaleAutoDeleteTimestamp) {
+		updated, err := kutils.TryUpdateProjectStatus(c.k8sGardenClient.GardenCore(), retry.DefaultRetry, project.ObjectMeta, func(project *gardencorev1alpha1.Project) (*gardencorev1alpha1.Project, error) {
+			// The cached project might be outdated, hence, the status is computed again based on the current project
+			// so that a later last activity which has been recorded in the meantime is kept.
+			status := computeStaleStatus(project, resources, now, daysToDuration(*c.config.StalePeriodDays), c.autoDeleteGracePeriod())
+			project.Status.LastActivityTimestamp = status.LastActivityTimestamp
+			project.Status.StaleSinceTimestamp = status.StaleSinceTimestamp
+			project.Status.StaleAutoDeleteTimestamp = status.StaleAutoDeleteTimestamp
+			return project, nil
+		})
+		if err != nil {
+			projectLogger.Errorf("Error updating the stale status of the project: %q", err.Error())
+			return err
+		}
+		project = updated
+		newStatus = updated.Status
+	}
+
+	switch {
+	case oldStatus.StaleSinceTimestamp == nil && newStatus.StaleSinceTimestamp != nil:
+		c.reportEvent(project, true, gardencorev1alpha1.ProjectEventStale, "Project has not seen any activity since %s and is considered stale", newStatus.LastActivityTimestamp.UTC().Format(time.RFC3339))
+	case oldStatus.StaleSinceTimestamp != nil && newStatus.StaleSinceTimestamp == nil:
+		c.reportEvent(project, false, gardencorev1alpha1.ProjectEventNotStale, "Project is no longer considered stale")
+	}
+
+	if c.autoDeleteGracePeriod() == nil || newStatus.StaleAutoDeleteTimestamp == nil || now.Before(newStatus.StaleAutoDeleteTimestamp.Time) || !resources.empty() {
+		return nil
+	}
+
+	// The caches might not contain the most recent resources, hence, we check again with the API server before deleting
+	// the project.
+	inUse, err := c.projectInUse(*project.Spec.Namespace)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		projectLogger.Infof("Stale project is not deleted because its namespace %q is still in use", *project.Spec.Namespace)
+		return nil
+	}
+
+	if err := c.deleteProject(project); err != nil {
+		c.reportEvent(project, true, gardencorev1alpha1.ProjectEventAutoDeletionFailed, "Error while deleting stale project: %+v", err)
+		return err
+	}
+	c.reportEvent(project, false, gardencorev1alpha1.ProjectEventAutoDeleted, "Stale project has been deleted automatically because its grace period expired at %s", newStatus.StaleAutoDeleteTimestamp.UTC().Format(time.RFC3339))
+	return nil
+}
+
+func (c *defaultStaleControl) listProjectResources(namespace string) (projectResources, error) {
+	shoots, err := c.shootLister.Shoots(namespace).List(labels.Everything())
+	if err != nil {
+		return projectResources{}, err
+	}
+	plants, err := c.plantLister.Plants(namespace).List(labels.Everything())
+	if err != nil {
+		return projectResources{}, err
+	}
+	secretBindings, err := c.secretBindingLister.SecretBindings(namespace).List(labels.Everything())
+	if err != nil {
+		return projectResources{}, err
+	}
+	return projectResources{shoots, plants, secretBindings}, nil
+}
+
+// projectInUse checks with the API server whether the given project namespace contains any Shoots, Plants or
+// SecretBindings.
+func (c *defaultStaleControl) projectInUse(namespace string) (bool, error) {
+	var (
+		coreClient  = c.k8sGardenClient.GardenCore().CoreV1alpha1()
+		listOptions = metav1.ListOptions{Limit: 1}
+	)
+
+	shoots, err := coreClient.Shoots(namespace).List(listOptions)
+	if err != nil || len(shoots.Items) > 0 {
+		return true, err
+	}
+	plants, err := coreClient.Plants(namespace).List(listOptions)
+	if err != nil || len(plants.Items) > 0 {
+		return true, err
+	}
+	secretBindings, err := coreClient.SecretBindings(namespace).List(listOptions)
+	if err != nil || len(secretBindings.Items) > 0 {
+		return true, err
+	}
+	return false, nil
+}
+
+// deleteProject confirms the deletion of the given project via annotation and deletes it afterwards.
+func (c *defaultStaleControl) deleteProject(project *gardencorev1alpha1.Project) error {
+	projectCopy := project.DeepCopy()
+	if projectCopy.Annotations == nil {
+		projectCopy.Annotations = map[string]string{}
+	}
+	projectCopy.Annotations[common.ConfirmationDeletion] = "true"
+
+	if _, err := c.k8sGardenClient.GardenCore().CoreV1alpha1().Projects().Update(projectCopy); err != nil {
+		return err
+	}
+	return c.k8sGardenClient.GardenCore().CoreV1alpha1().Projects().Delete(projectCopy.Name, &metav1.DeleteOptions{})
+}
+
+func (c *defaultStaleControl) autoDeleteGracePeriod() *time.Duration {
+	if c.config.StaleAutoDeleteGracePeriodDays == nil {
+		return nil
+	}
+	gracePeriod := daysToDuration(*c.config.StaleAutoDeleteGracePeriodDays)
+	return &gracePeriod
+}
+
+func (c *defaultStaleControl) reportEvent(project *gardencorev1alpha1.Project, isWarning bool, eventReason, messageFmt string, args ...interface{}) {
+	var (
+		eventType     = corev1.EventTypeNormal
+		projectLogger = newProjectLogger(project)
+	)
+
+	if isWarning {
+		eventType = corev1.EventTypeWarning
+	}
+	projectLogger.Infof(messageFmt, args...)
+
+	c.recorder.Eventf(project, eventType, eventReason, messageFmt, args...)
+}
+
+// computeStaleStatus computes the activity related status fields of the given project. The last activity is the most
+// recent point in time at which the project or one of the given resources has been created, at which the last
+// operation of one of the shoots has been updated, or which has been recorded in the project status before. The
+// project is stale if the last activity is older than <stalePeriod>. If <autoDeleteGracePeriod> is set, the project
+// does not contain any resources and it may be deleted automatically then the auto deletion timestamp is computed
+// based on the point in time since when the project is stale.
+func computeStaleStatus(project *gardencorev1alpha1.Project, resources projectResources, now time.Time, stalePeriod time.Duration, autoDeleteGracePeriod *time.Duration) gardencorev1alpha1.ProjectStatus {
+	var (
+		status       = *project.Status.DeepCopy()
+		lastActivity = project.CreationTimestamp.Time
+	)
+
+	latest := func(t time.Time) {
+		if t.After(lastActivity) {
+			lastActivity = t
+		}
+	}
+
+	if status.LastActivityTimestamp != nil {
+		latest(status.LastActivityTimestamp.Time)
+	}
+	for _, shoot := range resources.shoots {
+		latest(shoot.CreationTimestamp.Time)
+		if shoot.Status.LastOperation != nil {
+			latest(shoot.Status.LastOperation.LastUpdateTime.Time)
+		}
+	}
+	for _, plant := range resources.plants {
+		latest(plant.CreationTimestamp.Time)
+	}
+	for _, secretBinding := range resources.secretBindings {
+		latest(secretBinding.CreationTimestamp.Time)
+	}
+	status.LastActivityTimestamp = &metav1.Time{Time: lastActivity}
+
+	if now.Sub(lastActivity) <= stalePeriod {
+		status.StaleSinceTimestamp = nil
+		status.StaleAutoDeleteTimestamp = nil
+		return status
+	}
+
+	if status.StaleSinceTimestamp == nil {
+		status.StaleSinceTimestamp = &metav1.Time{Time: now}
+	}
+
+	if autoDeleteGracePeriod == nil || !resources.empty() || !autoDeletionAllowed(project) {
+		status.StaleAutoDeleteTimestamp = nil
+		return status
+	}
+	status.StaleAutoDeleteTimestamp = &metav1.Time{Time: status.StaleSinceTimestamp.Add(*autoDeleteGracePeriod)}
+	return status
+}
+
+// autoDeletionAllowed returns whether the given project may be deleted automatically once it is stale. The project
+// owning the garden namespace and projects annotated with the skip annotation are never deleted automatically.
+func autoDeletionAllowed(project *gardencorev1alpha1.Project) bool {
+	if project.Spec.Namespace != nil && *project.Spec.Namespace == v1alpha1constants.GardenNamespace {
+		return false
+	}
+	return project.Annotations[common.ProjectSkipStaleAutoDeletion] != "true"
+}
+
+func daysToDuration(days int) time.Duration {
+	return time.Duration(days) * 24 * time.Hour
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	fakegardencore "github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/logger"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Stale", func() {
+	Describe("#computeStaleStatus", func() {
+		var (
+			now         = time.Date(2020, 1, 31, 12, 0, 0, 0, time.UTC)
+			stalePeriod = 10 * 24 * time.Hour
+			gracePeriod = 5 * 24 * time.Hour
+
+			daysAgo = func(days int) metav1.Time {
+				return metav1.Time{Time: now.Add(-time.Duration(days) * 24 * time.Hour)}
+			}
+			timePtr = func(t metav1.Time) *metav1.Time {
+				return &t
+			}
+
+			project *gardencorev1alpha1.Project
+		)
+
+		BeforeEach(func() {
+			project = &gardencorev1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: daysAgo(30)},
+			}
+		})
+
+		It("should consider a project stale if there was no activity within the stale period", func() {
+			status := computeStaleStatus(project, projectResources{}, now, stalePeriod, nil)
+
+			Expect(status.LastActivityTimestamp).To(Equal(timePtr(daysAgo(30))))
+			Expect(status.StaleSinceTimestamp).To(Equal(timePtr(metav1.Time{Time: now})))
+			Expect(status.StaleAutoDeleteTimestamp).To(BeNil())
+		})
+
+		It("should not consider a project stale if a shoot operation was updated recently", func() {
+			project.Status.StaleSinceTimestamp = timePtr(daysAgo(1))
+			project.Status.StaleAutoDeleteTimestamp = timePtr(daysAgo(-4))
+			shoots := []*gardencorev1alpha1.Shoot{
+				{
+					ObjectMeta: metav1.ObjectMeta{CreationTimestamp: daysAgo(20)},
+					Status: gardencorev1alpha1.ShootStatus{
+						LastOperation: &gardencorev1alpha1.LastOperation{LastUpdateTime: daysAgo(2)},
+					},
+				},
+			}
+
+			status := computeStaleStatus(project, projectResources{shoots: shoots}, now, stalePeriod, &gracePeriod)
+
+			Expect(status.LastActivityTimestamp).To(Equal(timePtr(daysAgo(2))))
+			Expect(status.StaleSinceTimestamp).To(BeNil())
+			Expect(status.StaleAutoDeleteTimestamp).To(BeNil())
+		})
+
+		It("should consider the recorded last activity", func() {
+			project.Status.LastActivityTimestamp = timePtr(daysAgo(3))
+
+			status := computeStaleStatus(project, projectResources{}, now, stalePeriod, &gracePeriod)
+
+			Expect(status.LastActivityTimestamp).To(Equal(timePtr(daysAgo(3))))
+			Expect(status.StaleSinceTimestamp).To(BeNil())
+		})
+
+		It("should compute the auto deletion timestamp based on the stale since timestamp", func() {
+			project.Status.StaleSinceTimestamp = timePtr(daysAgo(7))
+
+			status := computeStaleStatus(project, projectResources{}, now, stalePeriod, &gracePeriod)
+
+			Expect(status.StaleSinceTimestamp).To(Equal(timePtr(daysAgo(7))))
+			Expect(status.StaleAutoDeleteTimestamp).To(Equal(timePtr(daysAgo(2))))
+		})
+
+		It("should not compute an auto deletion timestamp if the project still contains shoots", func() {
+			project.Status.StaleSinceTimestamp = timePtr(daysAgo(7))
+			project.Status.StaleAutoDeleteTimestamp = timePtr(daysAgo(2))
+			shoots := []*gardencorev1alpha1.Shoot{
+				{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: daysAgo(25)}},
+			}
+
+			status := computeStaleStatus(project, projectResources{shoots: shoots}, now, stalePeriod, &gracePeriod)
+
+			Expect(status.LastActivityTimestamp).To(Equal(timePtr(daysAgo(25))))
+			Expect(status.StaleSinceTimestamp).To(Equal(timePtr(daysAgo(7))))
+			Expect(status.StaleAutoDeleteTimestamp).To(BeNil())
+		})
+
+		It("should consider the creation of plants and secret bindings as activity", func() {
+			resources := projectResources{
+				plants:         []*gardencorev1alpha1.Plant{{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: daysAgo(4)}}},
+				secretBindings: []*gardencorev1alpha1.SecretBinding{{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: daysAgo(6)}}},
+			}
+
+			status := computeStaleStatus(project, resources, now, stalePeriod, &gracePeriod)
+
+			Expect(status.LastActivityTimestamp).To(Equal(timePtr(daysAgo(4))))
+			Expect(status.StaleSinceTimestamp).To(BeNil())
+		})
+
+		It("should not compute an auto deletion timestamp if the project still contains plants or secret bindings", func() {
+			project.Status.StaleSinceTimestamp = timePtr(daysAgo(7))
+
+			for _, resources := range []projectResources{
+				{plants: []*gardencorev1alpha1.Plant{{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: daysAgo(25)}}}},
+				{secretBindings: []*gardencorev1alpha1.SecretBinding{{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: daysAgo(25)}}}},
+			} {
+				status := computeStaleStatus(project, resources, now, stalePeriod, &gracePeriod)
+
+				Expect(status.StaleSinceTimestamp).To(Equal(timePtr(daysAgo(7))))
+				Expect(status.StaleAutoDeleteTimestamp).To(BeNil())
+			}
+		})
+
+		It("should only mark the project as stale if auto deletion is not configured", func() {
+			project.Status.StaleSinceTimestamp = timePtr(daysAgo(7))
+			project.Status.StaleAutoDeleteTimestamp = timePtr(daysAgo(2))
+
+			status := computeStaleStatus(project, projectResources{}, now, stalePeriod, nil)
+
+			Expect(status.StaleSinceTimestamp).To(Equal(timePtr(daysAgo(7))))
+			Expect(status.StaleAutoDeleteTimestamp).To(BeNil())
+		})
+
+		It("should not compute an auto deletion timestamp if the project must not be deleted automatically", func() {
+			project.Status.StaleSinceTimestamp = timePtr(daysAgo(7))
+			project.Annotations = map[string]string{common.ProjectSkipStaleAutoDeletion: "true"}
+
+			status := computeStaleStatus(project, projectResources{}, now, stalePeriod, &gracePeriod)
+
+			Expect(status.StaleSinceTimestamp).To(Equal(timePtr(daysAgo(7))))
+			Expect(status.StaleAutoDeleteTimestamp).To(BeNil())
+		})
+	})
+
+	Describe("#autoDeletionAllowed", func() {
+		var (
+			namespace = "garden-foo"
+			project   *gardencorev1alpha1.Project
+		)
+
+		BeforeEach(func() {
+			project = &gardencorev1alpha1.Project{
+				Spec: gardencorev1alpha1.ProjectSpec{Namespace: &namespace},
+			}
+		})
+
+		It("should allow the auto deletion of a project", func() {
+			Expect(autoDeletionAllowed(project)).To(BeTrue())
+		})
+
+		It("should not allow the auto deletion of the project owning the garden namespace", func() {
+			gardenNamespace := v1alpha1constants.GardenNamespace
+			project.Spec.Namespace = &gardenNamespace
+
+			Expect(autoDeletionAllowed(project)).To(BeFalse())
+		})
+
+		It("should not allow the auto deletion of a project annotated to skip it", func() {
+			project.Annotations = map[string]string{common.ProjectSkipStaleAutoDeletion: "true"}
+
+			Expect(autoDeletionAllowed(project)).To(BeFalse())
+		})
+	})
+
+	Describe("#CheckProject", func() {
+		var (
+			namespace       = "garden-foo"
+			stalePeriodDays = 7
+
+			ctrl             *gomock.Controller
+			k8sGardenClient  *mockkubernetes.MockInterface
+			gardenCoreClient *fakegardencore.Clientset
+			control          StaleControlInterface
+
+			daysAgo = func(days int) *metav1.Time {
+				return &metav1.Time{Time: time.Now().Add(-time.Duration(days) * 24 * time.Hour).Truncate(time.Second)}
+			}
+
+			project *gardencorev1alpha1.Project
+		)
+
+		BeforeEach(func() {
+			logger.AddWriter(logger.NewLogger("info"), GinkgoWriter)
+
+			ctrl = gomock.NewController(GinkgoT())
+			k8sGardenClient = mockkubernetes.NewMockInterface(ctrl)
+
+			project = &gardencorev1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", CreationTimestamp: *daysAgo(30)},
+				Spec:       gardencorev1alpha1.ProjectSpec{Namespace: &namespace},
+				Status:     gardencorev1alpha1.ProjectStatus{LastActivityTimestamp: daysAgo(10)},
+			}
+
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			control = NewDefaultStaleControl(
+				k8sGardenClient,
+				&config.ProjectControllerConfiguration{StalePeriodDays: &stalePeriodDays},
+				record.NewFakeRecorder(10),
+				gardencorelisters.NewShootLister(indexer),
+				gardencorelisters.NewPlantLister(indexer),
+				gardencorelisters.NewSecretBindingLister(indexer),
+			)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should keep a later last activity which is not yet contained in the cached project", func() {
+			current := project.DeepCopy()
+			current.Status.LastActivityTimestamp = daysAgo(1)
+			gardenCoreClient = fakegardencore.NewSimpleClientset(current)
+			k8sGardenClient.EXPECT().GardenCore().Return(gardenCoreClient).AnyTimes()
+
+			Expect(control.CheckProject(project)).To(Succeed())
+
+			updated, err := gardenCoreClient.CoreV1alpha1().Projects().Get(project.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Status.LastActivityTimestamp).To(Equal(current.Status.LastActivityTimestamp))
+			Expect(updated.Status.StaleSinceTimestamp).To(BeNil())
+		})
+
+		It("should mark the project as stale if there was no later activity", func() {
+			gardenCoreClient = fakegardencore.NewSimpleClientset(project.DeepCopy())
+			k8sGardenClient.EXPECT().GardenCore().Return(gardenCoreClient).AnyTimes()
+
+			Expect(control.CheckProject(project)).To(Succeed())
+
+			updated, err := gardenCoreClient.CoreV1alpha1().Projects().Get(project.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Status.LastActivityTimestamp).To(Equal(project.Status.LastActivityTimestamp))
+			Expect(updated.Status.StaleSinceTimestamp).NotTo(BeNil())
+		})
+	})
+})
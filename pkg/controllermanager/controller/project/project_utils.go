@@ -15,11 +15,22 @@
 package project
 
 import (
+	"sort"
+	"strings"
+
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/operation/common"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
+// projectQuotaName is the name of the ResourceQuota and the LimitRange maintained in the project namespace.
+const projectQuotaName = "gardener"
+
 func setProjectPhase(phase gardencorev1alpha1.ProjectPhase) func(*gardencorev1alpha1.Project) (*gardencorev1alpha1.Project, error) {
 	return func(project *gardencorev1alpha1.Project) (*gardencorev1alpha1.Project, error) {
 		project.Status.Phase = phase
@@ -39,3 +50,90 @@ func namespaceAnnotationsFromProject(project *gardencorev1alpha1.Project) map[st
 		common.NamespaceProject: string(project.UID),
 	}
 }
+
+// subjectsByRole returns the subjects of the given project members and service accounts grouped by their roles. Both
+// the primary role and the additional roles are considered. The service accounts are expected in the given namespace.
+func subjectsByRole(members []gardencorev1alpha1.ProjectMember, serviceAccounts []gardencorev1alpha1.ProjectServiceAccount, namespace string) map[string][]rbacv1.Subject {
+	out := make(map[string][]rbacv1.Subject)
+	for _, member := range members {
+		for _, role := range append([]string{member.Role}, member.Roles...) {
+			out[role] = append(out[role], member.Subject)
+		}
+	}
+	for _, serviceAccount := range serviceAccounts {
+		subject := rbacv1.Subject{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccount.Name,
+			Namespace: namespace,
+		}
+		for _, role := range append([]string{serviceAccount.Role}, serviceAccount.Roles...) {
+			out[role] = append(out[role], subject)
+		}
+	}
+	return out
+}
+
+// extensionRoleNames returns the sorted names of the extension roles contained in the given subjects map.
+func extensionRoleNames(subjectsByRole map[string][]rbacv1.Subject) []string {
+	var names []string
+	for role := range subjectsByRole {
+		if strings.HasPrefix(role, gardencorev1alpha1.ProjectMemberExtensionPrefix) {
+			names = append(names, strings.TrimPrefix(role, gardencorev1alpha1.ProjectMemberExtensionPrefix))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// tokenServiceAccountNames returns the sorted names of the given service accounts for which service account managers
+// may create tokens. These are the service accounts which have no other roles than viewer and service account manager,
+// hence, a token does not grant more permissions than service account managers already have.
+func tokenServiceAccountNames(serviceAccounts []gardencorev1alpha1.ProjectServiceAccount) []string {
+	var names []string
+	for _, serviceAccount := range serviceAccounts {
+		allowed := true
+		for _, role := range append([]string{serviceAccount.Role}, serviceAccount.Roles...) {
+			if role != gardencorev1alpha1.ProjectMemberViewer && role != gardencorev1alpha1.ProjectMemberServiceAccountManager {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			names = append(names, serviceAccount.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// quotaLabels returns the labels of the ResourceQuota and LimitRange maintained in the namespace of the given project.
+func quotaLabels(project *gardencorev1alpha1.Project) map[string]string {
+	return map[string]string{
+		common.ProjectName:       project.Name,
+		common.LabelProjectQuota: "true",
+	}
+}
+
+// isManagedQuotaObject returns whether the given ResourceQuota or LimitRange is managed by the project controller.
+func isManagedQuotaObject(obj metav1.Object) bool {
+	return obj.GetLabels()[common.LabelProjectQuota] == "true"
+}
+
+// quotaConfigurationForProject returns the first of the given quota configurations whose project selector matches the
+// given project, or nil if none matches. A configuration without project selector matches all projects.
+func quotaConfigurationForProject(quotaConfigs []config.ProjectQuotaConfiguration, project *gardencorev1alpha1.Project) (*config.ProjectQuotaConfiguration, error) {
+	for i, quotaConfig := range quotaConfigs {
+		if quotaConfig.ProjectSelector == nil {
+			return &quotaConfigs[i], nil
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(quotaConfig.ProjectSelector)
+		if err != nil {
+			return nil, err
+		}
+		if selector.Matches(labels.Set(project.Labels)) {
+			return &quotaConfigs[i], nil
+		}
+	}
+	return nil, nil
+}
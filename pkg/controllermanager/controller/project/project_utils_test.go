@@ -0,0 +1,121 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Utils", func() {
+	var (
+		alice = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice"}
+		bob   = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "bob"}
+		ci    = rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "ci", Namespace: "garden-foo"}
+	)
+
+	Describe("#subjectsByRole", func() {
+		It("should group the members and service accounts by their roles", func() {
+			subjects := subjectsByRole(
+				[]gardencorev1alpha1.ProjectMember{
+					{Subject: alice, Role: gardencorev1alpha1.ProjectMemberAdmin, Roles: []string{gardencorev1alpha1.ProjectMemberUserAccessManager}},
+					{Subject: bob, Role: gardencorev1alpha1.ProjectMemberViewer, Roles: []string{"extension:foo"}},
+				},
+				[]gardencorev1alpha1.ProjectServiceAccount{
+					{Name: "ci", Role: gardencorev1alpha1.ProjectMemberAdmin, Roles: []string{"extension:foo"}},
+				},
+				"garden-foo",
+			)
+
+			Expect(subjects).To(Equal(map[string][]rbacv1.Subject{
+				gardencorev1alpha1.ProjectMemberAdmin:             {alice, ci},
+				gardencorev1alpha1.ProjectMemberUserAccessManager: {alice},
+				gardencorev1alpha1.ProjectMemberViewer:            {bob},
+				"extension:foo":                                   {bob, ci},
+			}))
+		})
+	})
+
+	Describe("#extensionRoleNames", func() {
+		It("should return the sorted names of the extension roles", func() {
+			Expect(extensionRoleNames(map[string][]rbacv1.Subject{
+				gardencorev1alpha1.ProjectMemberAdmin: {alice},
+				"extension:foo":                       {bob},
+				"extension:bar":                       {bob},
+			})).To(Equal([]string{"bar", "foo"}))
+		})
+	})
+
+	Describe("#tokenServiceAccountNames", func() {
+		It("should return the sorted names of the service accounts not exceeding the service account manager permissions", func() {
+			Expect(tokenServiceAccountNames([]gardencorev1alpha1.ProjectServiceAccount{
+				{Name: "viewer", Role: gardencorev1alpha1.ProjectMemberViewer},
+				{Name: "admin", Role: gardencorev1alpha1.ProjectMemberAdmin},
+				{Name: "manager", Role: gardencorev1alpha1.ProjectMemberViewer, Roles: []string{gardencorev1alpha1.ProjectMemberServiceAccountManager}},
+				{Name: "uam", Role: gardencorev1alpha1.ProjectMemberViewer, Roles: []string{gardencorev1alpha1.ProjectMemberUserAccessManager}},
+				{Name: "extension", Role: gardencorev1alpha1.ProjectMemberViewer, Roles: []string{"extension:foo"}},
+			})).To(Equal([]string{"manager", "viewer"}))
+		})
+
+		It("should return nil if there are no service accounts", func() {
+			Expect(tokenServiceAccountNames(nil)).To(BeNil())
+		})
+	})
+
+	Describe("#quotaConfigurationForProject", func() {
+		var (
+			project = &gardencorev1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "foo",
+					Labels: map[string]string{"tier": "trial"},
+				},
+			}
+
+			trialConfig = config.ProjectQuotaConfiguration{
+				ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "trial"}},
+			}
+			otherConfig = config.ProjectQuotaConfiguration{
+				ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "other"}},
+			}
+			defaultConfig = config.ProjectQuotaConfiguration{}
+		)
+
+		It("should return nil if no configuration matches", func() {
+			Expect(quotaConfigurationForProject([]config.ProjectQuotaConfiguration{otherConfig}, project)).To(BeNil())
+		})
+
+		It("should return the first matching configuration", func() {
+			Expect(quotaConfigurationForProject([]config.ProjectQuotaConfiguration{otherConfig, trialConfig, defaultConfig}, project)).To(Equal(&trialConfig))
+		})
+
+		It("should return a configuration without selector for all projects", func() {
+			Expect(quotaConfigurationForProject([]config.ProjectQuotaConfiguration{otherConfig, defaultConfig, trialConfig}, project)).To(Equal(&defaultConfig))
+		})
+
+		It("should return an error if the selector is invalid", func() {
+			invalidConfig := config.ProjectQuotaConfiguration{
+				ProjectSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "invalid"}}},
+			}
+
+			_, err := quotaConfigurationForProject([]config.ProjectQuotaConfiguration{invalidConfig}, project)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
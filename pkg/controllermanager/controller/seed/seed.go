@@ -53,6 +53,9 @@ type Controller struct {
 
 	shootLister gardencorelisters.ShootLister
 
+	controllerInstallationLister gardencorelisters.ControllerInstallationLister
+	controllerInstallationSynced cache.InformerSynced
+
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
@@ -79,18 +82,24 @@ func NewSeedController(
 		seedUpdater  = NewRealUpdater(k8sGardenClient, seedLister)
 		secretLister = corev1Informer.Secrets().Lister()
 		shootLister  = gardenCoreV1alpha1Informer.Shoots().Lister()
+
+		controllerInstallationInformer = gardenCoreV1alpha1Informer.ControllerInstallations()
+		controllerInstallationLister   = controllerInstallationInformer.Lister()
 	)
 
 	seedController := &Controller{
 		k8sGardenClient:        k8sGardenClient,
 		k8sGardenCoreInformers: gardenCoreInformerFactory,
-		control:                NewDefaultControl(k8sGardenClient, gardenCoreInformerFactory, secrets, imageVector, identity, recorder, seedUpdater, config, secretLister, shootLister),
+		control:                NewDefaultControl(k8sGardenClient, gardenCoreInformerFactory, secrets, imageVector, identity, recorder, seedUpdater, config, secretLister, shootLister, controllerInstallationLister),
 		config:                 config,
 		recorder:               recorder,
 		seedLister:             seedLister,
 		seedQueue:              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed"),
 		shootLister:            shootLister,
 		workerCh:               make(chan int),
+
+		controllerInstallationLister: controllerInstallationLister,
+		controllerInstallationSynced: controllerInstallationInformer.Informer().HasSynced,
 	}
 
 	seedInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -107,7 +116,7 @@ func NewSeedController(
 func (c *Controller) Run(ctx context.Context, workers int) {
 	var waitGroup sync.WaitGroup
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.seedSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.seedSynced, c.controllerInstallationSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -35,10 +36,12 @@ import (
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -128,6 +131,7 @@ func NewDefaultControl(
 	config *config.ControllerManagerConfiguration,
 	secretLister kubecorev1listers.SecretLister,
 	shootLister gardencorelisters.ShootLister,
+	controllerInstallationLister gardencorelisters.ControllerInstallationLister,
 ) ControlInterface {
 	return &defaultControl{k8sGardenClient,
 		k8sGardenCoreInformers,
@@ -139,6 +143,9 @@ func NewDefaultControl(
 		config,
 		secretLister,
 		shootLister,
+		controllerInstallationLister,
+		make(map[string]time.Time),
+		sync.Mutex{},
 	}
 }
 
@@ -153,6 +160,11 @@ type defaultControl struct {
 	config                 *config.ControllerManagerConfiguration
 	secretLister           kubecorev1listers.SecretLister
 	shootLister            gardencorelisters.ShootLister
+
+	controllerInstallationLister gardencorelisters.ControllerInstallationLister
+
+	lastGarbageCollections     map[string]time.Time
+	lastGarbageCollectionsLock sync.Mutex
 }
 
 func (c *defaultControl) ReconcileSeed(obj *gardencorev1alpha1.Seed, key string) error {
@@ -317,7 +329,10 @@ func (c *defaultControl) ReconcileSeed(obj *gardencorev1alpha1.Seed, key string)
 	}
 
 	conditionSeedAvailable = gardencorev1alpha1helper.UpdatedCondition(conditionSeedAvailable, gardencorev1alpha1.ConditionTrue, "Passed", "all checks passed")
-	c.updateSeedStatus(seed, conditionSeedAvailable)
+
+	// Check the health of the Seed cluster and compute its capacity.
+	allocatable, used, healthConditions := c.checkSeed(ctx, seedObj, len(associatedShoots), seedLogger)
+	c.updateSeedStatusWithCapacity(seed, allocatable, used, seedObj.ReservedExcessCapacity(), append(healthConditions, conditionSeedAvailable)...)
 
 	if seed.Spec.Backup != nil {
 		// This should be post updating the seed is available. Since, scheduler will then mostly use
@@ -328,14 +343,78 @@ func (c *defaultControl) ReconcileSeed(obj *gardencorev1alpha1.Seed, key string)
 		}
 	}
 
+	c.collectGarbage(ctx, seedObj, seedLogger)
+
 	return nil
 }
 
+// checkSeed checks the health of the Seed cluster and computes its allocatable and used resources. If the Seed
+// cluster cannot be reached, the previously computed resources are returned.
+func (c *defaultControl) checkSeed(ctx context.Context, seedObj *seedpkg.Seed, numberOfAssociatedShoots int, seedLogger logrus.FieldLogger) (corev1.ResourceList, corev1.ResourceList, []gardencorev1alpha1.Condition) {
+	var (
+		seed                                = seedObj.Info
+		conditionAPIServerAvailable         = gardencorev1alpha1helper.GetOrInitCondition(seed.Status.Conditions, gardencorev1alpha1.SeedAPIServerAvailable)
+		conditionBootstrapComponentsHealthy = gardencorev1alpha1helper.GetOrInitCondition(seed.Status.Conditions, gardencorev1alpha1.SeedBootstrapComponentsHealthy)
+		conditionDNSProviderReady           = gardencorev1alpha1helper.GetOrInitCondition(seed.Status.Conditions, gardencorev1alpha1.SeedDNSProviderReady)
+		conditionExtensionsReady            = gardencorev1alpha1helper.GetOrInitCondition(seed.Status.Conditions, gardencorev1alpha1.SeedExtensionsReady)
+	)
+
+	controllerInstallations, err := c.controllerInstallationLister.List(labels.Everything())
+	if err != nil {
+		conditionExtensionsReady = gardencorev1alpha1helper.UpdatedConditionUnknownError(conditionExtensionsReady, err)
+	} else {
+		var seedControllerInstallations []*gardencorev1alpha1.ControllerInstallation
+		for _, controllerInstallation := range controllerInstallations {
+			if controllerInstallation.Spec.SeedRef.Name == seed.Name {
+				seedControllerInstallations = append(seedControllerInstallations, controllerInstallation)
+			}
+		}
+		conditionExtensionsReady = seedpkg.CheckExtensions(conditionExtensionsReady, seedControllerInstallations)
+	}
+
+	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(seedObj.Secret,
+		kubernetes.WithClientConnectionOptions(c.config.SeedClientConnection),
+		kubernetes.WithClientOptions(client.Options{
+			Scheme: kubernetes.SeedScheme,
+		}),
+	)
+	if err != nil {
+		message := fmt.Sprintf("Could not create a client for the Seed cluster: %v", err)
+		seedLogger.Error(message)
+		return seed.Status.Allocatable, seed.Status.Used, []gardencorev1alpha1.Condition{
+			gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionAPIServerAvailable, message),
+			gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionBootstrapComponentsHealthy, message),
+			gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionDNSProviderReady, message),
+			conditionExtensionsReady,
+		}
+	}
+
+	conditionAPIServerAvailable = seedpkg.CheckAPIServerAvailability(conditionAPIServerAvailable, k8sSeedClient.Kubernetes().Discovery().RESTClient())
+	conditionBootstrapComponentsHealthy = seedObj.CheckBootstrapComponents(ctx, k8sSeedClient.Client(), conditionBootstrapComponentsHealthy)
+	conditionDNSProviderReady = seedpkg.CheckDNSProviders(ctx, k8sSeedClient.Client(), conditionDNSProviderReady)
+	conditions := []gardencorev1alpha1.Condition{conditionAPIServerAvailable, conditionBootstrapComponentsHealthy, conditionDNSProviderReady, conditionExtensionsReady}
+
+	allocatable, used, err := seedpkg.ComputeCapacity(ctx, k8sSeedClient.Client(), numberOfAssociatedShoots)
+	if err != nil {
+		seedLogger.Errorf("Could not compute the capacity of the Seed cluster: %v", err)
+		return seed.Status.Allocatable, seed.Status.Used, conditions
+	}
+
+	return allocatable, used, conditions
+}
+
 func (c *defaultControl) updateSeedStatus(seed *gardencorev1alpha1.Seed, updateConditions ...gardencorev1alpha1.Condition) error {
+	return c.updateSeedStatusWithCapacity(seed, seed.Status.Allocatable, seed.Status.Used, seed.Status.ReservedExcessCapacity, updateConditions...)
+}
+
+func (c *defaultControl) updateSeedStatusWithCapacity(seed *gardencorev1alpha1.Seed, allocatable, used, reservedExcessCapacity corev1.ResourceList, updateConditions ...gardencorev1alpha1.Condition) error {
 	newStatus := gardencorev1alpha1.SeedStatus{
-		Conditions:         gardencorev1alpha1helper.MergeConditions(seed.Status.Conditions, updateConditions...),
-		ObservedGeneration: seed.Generation,
-		Gardener:           *c.identity,
+		Conditions:             gardencorev1alpha1helper.MergeConditions(seed.Status.Conditions, updateConditions...),
+		ObservedGeneration:     seed.Generation,
+		Gardener:               *c.identity,
+		Allocatable:            allocatable,
+		Used:                   used,
+		ReservedExcessCapacity: reservedExcessCapacity,
 	}
 
 	if apiequality.Semantic.DeepEqual(seed.Status, newStatus) {
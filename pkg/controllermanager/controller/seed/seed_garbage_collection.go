@@ -0,0 +1,135 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+	"github.com/gardener/gardener/pkg/operation/garbagecollection"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxObjectsInGarbageCollectionEvent is the maximum number of objects which are listed in a garbage collection event.
+const maxObjectsInGarbageCollectionEvent = 10
+
+// collectGarbage performs the garbage collection in the given Seed cluster (and for the Seed in the Garden cluster)
+// according to the configured policies. It is performed at most once per configured period. Errors are only reported
+// as they must not block the reconciliation of the Seed.
+func (c *defaultControl) collectGarbage(ctx context.Context, seedObj *seedpkg.Seed, seedLogger logrus.FieldLogger) {
+	gcConfig := c.config.Controllers.Seed.GarbageCollection
+	if gcConfig == nil || !c.isGarbageCollectionDue(seedObj.Info.Name, gcConfig) {
+		return
+	}
+
+	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(seedObj.Secret,
+		kubernetes.WithClientConnectionOptions(c.config.SeedClientConnection),
+		kubernetes.WithClientOptions(client.Options{
+			Scheme: kubernetes.SeedScheme,
+		}),
+	)
+	if err != nil {
+		seedLogger.Errorf("Could not create a client for the garbage collection in the Seed cluster: %v", err)
+		return
+	}
+
+	var (
+		seed          = seedObj.Info
+		seedClient    = k8sSeedClient.Client()
+		projectLister = c.k8sGardenCoreInformers.Core().V1alpha1().Projects().Lister()
+		policies      []garbagecollection.Policy
+		dryRun        = gcConfig.DryRun == nil || *gcConfig.DryRun
+	)
+
+	if p := gcConfig.OrphanedShootNamespaces; isPolicyEnabled(p) {
+		policies = append(policies, garbagecollection.NewOrphanedShootNamespacesPolicy(seedClient, c.shootLister, projectLister, minimumAge(p)))
+	}
+	if p := gcConfig.OrphanedExtensionResources; isPolicyEnabled(p) {
+		policies = append(policies, garbagecollection.NewOrphanedExtensionResourcesPolicy(seedClient, c.shootLister, projectLister, minimumAge(p)))
+	}
+	if p := gcConfig.StaleManagedResources; isPolicyEnabled(p) {
+		policies = append(policies, garbagecollection.NewStaleManagedResourcesPolicy(seedClient, minimumAge(p)))
+	}
+	if p := gcConfig.TerraformerResources; isPolicyEnabled(p) {
+		policies = append(policies, garbagecollection.NewTerraformerResourcesPolicy(seedClient, minimumAge(p)))
+	}
+	if p := gcConfig.OrphanedBackupEntries; isPolicyEnabled(p) {
+		policies = append(policies, garbagecollection.NewOrphanedBackupEntriesPolicy(c.k8sGardenClient.Client(), c.shootLister, seed.Name, minimumAge(p)))
+	}
+
+	for _, report := range garbagecollection.NewCollector(seedLogger, dryRun).Collect(ctx, policies...) {
+		if report.Err != nil {
+			seedLogger.Errorf("Garbage collection failed: %v", report.Err)
+			gardenmetrics.GarbageCollectionFailures.With(prometheus.Labels{"seed": seed.Name, "policy": report.Policy}).Inc()
+		}
+		if len(report.Objects) == 0 {
+			continue
+		}
+
+		gardenmetrics.GarbageCollectedObjects.With(prometheus.Labels{"seed": seed.Name, "policy": report.Policy, "dry_run": strconv.FormatBool(report.DryRun)}).Add(float64(len(report.Objects)))
+
+		if report.DryRun {
+			c.recorder.Eventf(seed, corev1.EventTypeNormal, "GarbageCollectionDryRun", "Garbage collection policy %s would delete %d object(s): %s", report.Policy, len(report.Objects), summarizeObjects(report.Objects))
+		} else {
+			c.recorder.Eventf(seed, corev1.EventTypeNormal, "GarbageCollected", "Garbage collection policy %s deleted %d object(s): %s", report.Policy, len(report.Objects), summarizeObjects(report.Objects))
+		}
+	}
+}
+
+// isGarbageCollectionDue returns true if the last garbage collection of the Seed with the given name is longer ago
+// than the configured period. In this case it records the current time as time of the last garbage collection.
+func (c *defaultControl) isGarbageCollectionDue(seedName string, gcConfig *config.SeedGarbageCollectionConfiguration) bool {
+	c.lastGarbageCollectionsLock.Lock()
+	defer c.lastGarbageCollectionsLock.Unlock()
+
+	now := time.Now()
+	if last, ok := c.lastGarbageCollections[seedName]; ok && gcConfig.Period != nil && now.Sub(last) < gcConfig.Period.Duration {
+		return false
+	}
+
+	c.lastGarbageCollections[seedName] = now
+	return true
+}
+
+// summarizeObjects returns a comma-separated list of the given objects which is truncated after
+// maxObjectsInGarbageCollectionEvent objects.
+func summarizeObjects(objects []string) string {
+	if len(objects) <= maxObjectsInGarbageCollectionEvent {
+		return strings.Join(objects, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(objects[:maxObjectsInGarbageCollectionEvent], ", "), len(objects)-maxObjectsInGarbageCollectionEvent)
+}
+
+func isPolicyEnabled(policy *config.GarbageCollectionPolicy) bool {
+	return policy != nil && policy.Enabled
+}
+
+func minimumAge(policy *config.GarbageCollectionPolicy) time.Duration {
+	if policy.MinimumAge == nil {
+		return 0
+	}
+	return policy.MinimumAge.Duration
+}
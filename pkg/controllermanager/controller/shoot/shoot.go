@@ -83,6 +83,7 @@ type Controller struct {
 	namespaceSynced              cache.InformerSynced
 	configMapSynced              cache.InformerSynced
 	controllerInstallationSynced cache.InformerSynced
+	controllerRegistrationSynced cache.InformerSynced
 
 	numberOfRunningWorkers int
 	workerCh               chan int
@@ -196,6 +197,7 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenCoreInfor
 	shootController.namespaceSynced = namespaceInformer.Informer().HasSynced
 	shootController.configMapSynced = configMapInformer.Informer().HasSynced
 	shootController.controllerInstallationSynced = controllerInstallationInformer.Informer().HasSynced
+	shootController.controllerRegistrationSynced = gardenCoreV1alpha1Informer.ControllerRegistrations().Informer().HasSynced
 
 	return shootController
 }
@@ -204,7 +206,7 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenCoreInfor
 func (c *Controller) Run(ctx context.Context, shootWorkers, shootCareWorkers, shootMaintenanceWorkers, shootQuotaWorkers, shootHibernationWorkers int) {
 	var waitGroup sync.WaitGroup
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.shootSynced, c.seedSynced, c.cloudProfileSynced, c.secretBindingSynced, c.quotaSynced, c.projectSynced, c.namespaceSynced, c.configMapSynced, c.controllerInstallationSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.shootSynced, c.seedSynced, c.cloudProfileSynced, c.secretBindingSynced, c.quotaSynced, c.projectSynced, c.namespaceSynced, c.configMapSynced, c.controllerInstallationSynced, c.controllerRegistrationSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
@@ -15,6 +15,7 @@
 package shoot
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -166,6 +167,11 @@ func (c *defaultCareControl) Care(shootObj *gardencorev1alpha1.Shoot, key string
 		conditionSystemComponentsHealthy,
 	)
 
+	// Refresh the status of the extension resources
+	if err := botanist.UpdateShootExtensionStatus(context.TODO()); err != nil {
+		botanist.Logger.Errorf("Could not update the extension status of Shoot: %+v", err)
+	}
+
 	// Update Shoot status
 	shoot, err = c.updateShootConditions(shoot, conditionAPIServerAvailable, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy)
 	if err != nil {
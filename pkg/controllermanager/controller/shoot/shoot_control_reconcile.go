@@ -299,6 +299,12 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 	)
 
 	err = f.Run(flow.Opts{Logger: o.Logger, ProgressReporter: o.ReportShootProgress})
+
+	// Copy the status of the extension resources into the Shoot status, in particular if one of them failed.
+	if err := botanist.UpdateShootExtensionStatus(context.TODO()); err != nil {
+		o.Logger.Errorf("Could not update the extension status of Shoot %q: %+v", o.Shoot.Info.Name, err)
+	}
+
 	if err != nil {
 		o.Logger.Errorf("Failed to reconcile Shoot %q: %+v", o.Shoot.Info.Name, err)
 		return gardencorev1alpha1helper.LastError(gardencorev1alpha1helper.FormatLastErrDescription(err), gardencorev1alpha1helper.ExtractErrorCodes(flow.Causes(err))...)
@@ -30,6 +30,19 @@ var (
 		Name: "garden_scrape_failure_total",
 		Help: "Total count of scraping failures, grouped by kind/group of metric(s)",
 	}, []string{"kind"})
+
+	// GarbageCollectedObjects is a metric which counts the objects which have been garbage collected (or which would
+	// have been garbage collected in dry-run mode) grouped by seed and policy.
+	GarbageCollectedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "garden_garbage_collection_objects_total",
+		Help: "Total count of objects garbage collected in the seed clusters, grouped by seed, policy and dry-run mode",
+	}, []string{"seed", "policy", "dry_run"})
+
+	// GarbageCollectionFailures is a metric which counts the failed garbage collections grouped by seed and policy.
+	GarbageCollectionFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "garden_garbage_collection_failure_total",
+		Help: "Total count of failed garbage collections in the seed clusters, grouped by seed and policy",
+	}, []string{"seed", "policy"})
 )
 
 // RegisterControllerMetrics initializes the collection of Controller related metrics.
@@ -42,6 +55,9 @@ func RegisterControllerMetrics(controllers ...ControllerMetricsCollector) {
 	// Register scrape failure metric.
 	prometheus.MustRegister(ScrapeFailures)
 
+	// Register garbage collection metrics.
+	prometheus.MustRegister(GarbageCollectedObjects, GarbageCollectionFailures)
+
 	// Create a controllerCollector, pass the metrics descriptors for metrics which should be registered
 	// and the collectors which should collect the metrics. At the end register the collector.
 	collector = controllerCollector{
@@ -244,6 +244,21 @@ func (mr *MockShootInterfaceMockRecorder) Create(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShootInterface)(nil).Create), arg0)
 }
 
+// CreateAdminKubeconfigRequest mocks base method
+func (m *MockShootInterface) CreateAdminKubeconfigRequest(arg0 string, arg1 *v1alpha1.AdminKubeconfigRequest) (*v1alpha1.AdminKubeconfigRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdminKubeconfigRequest", arg0, arg1)
+	ret0, _ := ret[0].(*v1alpha1.AdminKubeconfigRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdminKubeconfigRequest indicates an expected call of CreateAdminKubeconfigRequest
+func (mr *MockShootInterfaceMockRecorder) CreateAdminKubeconfigRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdminKubeconfigRequest", reflect.TypeOf((*MockShootInterface)(nil).CreateAdminKubeconfigRequest), arg0, arg1)
+}
+
 // Delete mocks base method
 func (m *MockShootInterface) Delete(arg0 string, arg1 *v1.DeleteOptions) error {
 	m.ctrl.T.Helper()
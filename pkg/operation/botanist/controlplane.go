@@ -32,7 +32,6 @@ import (
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
-	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	"github.com/gardener/gardener/pkg/utils/retry"
 
 	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
@@ -409,23 +408,13 @@ func (b *Botanist) WaitUntilControlPlaneReady(ctx context.Context) error {
 
 // waitUntilControlPlaneReady waits until the control plane resource has been reconciled successfully.
 func (b *Botanist) waitUntilControlPlaneReady(ctx context.Context, name string) error {
-	if err := retry.UntilTimeout(ctx, DefaultInterval, ControlPlaneDefaultTimeout, func(ctx context.Context) (bool, error) {
-		cp := &extensionsv1alpha1.ControlPlane{}
-		if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: name, Namespace: b.Shoot.SeedNamespace}, cp); err != nil {
-			return retry.SevereError(err)
-		}
-
-		if err := health.CheckExtensionObject(cp); err != nil {
-			b.Logger.WithError(err).Error("Control plane did not get ready yet")
-			return retry.MinorError(err)
-		}
-
+	cp := &extensionsv1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: b.Shoot.SeedNamespace}}
+	if err := b.waitUntilExtensionObjectReady(ctx, cp, extensionsv1alpha1.ControlPlaneResource, ControlPlaneDefaultTimeout, func() {
 		if cp.Status.ProviderStatus != nil {
 			b.Shoot.ControlPlaneStatus = cp.Status.ProviderStatus.Raw
 		}
-		return retry.Ok()
 	}); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed to create control plane: %v", err))
+		return extensionObjectError("failed to create control plane", err)
 	}
 	return nil
 }
@@ -28,7 +28,6 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	utilclient "github.com/gardener/gardener/pkg/utils/kubernetes/client"
-	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	"github.com/gardener/gardener/pkg/utils/retry"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -99,30 +98,19 @@ func (b *Botanist) DeleteStaleExtensionResources(ctx context.Context) error {
 }
 
 // WaitUntilExtensionResourcesReady waits until all extension resources report `Succeeded` in their last operation state.
-// The state must be reported before the passed context is cancelled or an extension's timeout has been reached.
-// As soon as one timeout has been overstepped the function returns an error, further waits on extensions will be aborted.
+// The state must be reported before the passed context is cancelled or an extension gets stuck (see
+// waitUntilExtensionObjectReady). As soon as one extension is stuck the function returns an error, further waits on
+// extensions will be aborted.
 func (b *Botanist) WaitUntilExtensionResourcesReady(ctx context.Context) error {
 	fns := make([]flow.TaskFn, 0, len(b.Shoot.Extensions))
 	for _, extension := range b.Shoot.Extensions {
 		var (
-			name      = extension.Name
-			namespace = extension.Namespace
+			name = extension.Name
+			obj  = &extensionsv1alpha1.Extension{ObjectMeta: metav1.ObjectMeta{Name: extension.Name, Namespace: extension.Namespace}}
 		)
 		fns = append(fns, func(ctx context.Context) error {
-			if err := retry.UntilTimeout(ctx, DefaultInterval, shoot.ExtensionDefaultTimeout, func(ctx context.Context) (bool, error) {
-				req := &extensionsv1alpha1.Extension{}
-				if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(namespace, name), req); err != nil {
-					return retry.SevereError(err)
-				}
-
-				if err := health.CheckExtensionObject(req); err != nil {
-					b.Logger.WithError(err).Errorf("Extension %s/%s did not get ready yet", namespace, name)
-					return retry.MinorError(err)
-				}
-
-				return retry.Ok()
-			}); err != nil {
-				return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed waiting for extension %s to be ready: %v", name, err))
+			if err := b.waitUntilExtensionObjectReady(ctx, obj, extensionsv1alpha1.ExtensionResource, shoot.ExtensionDefaultTimeout, nil); err != nil {
+				return extensionObjectError(fmt.Sprintf("failed waiting for extension %s to be ready", name), err)
 			}
 			return nil
 		})
@@ -0,0 +1,223 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// extensionObjectMaxWaitFactor limits how long Gardener waits for an extension object which keeps making progress
+// without getting ready, relative to its reconcile timeout.
+const extensionObjectMaxWaitFactor = 3
+
+// extensionObject is an extension resource which can be read with a controller-runtime client.
+type extensionObject interface {
+	runtime.Object
+	extensionsv1alpha1.Object
+}
+
+// ExtensionObjectProgress tracks the progress of the last operation of an extension object in order to detect
+// whether it is stuck.
+type ExtensionObjectProgress struct {
+	timeout          time.Duration
+	lastState        string
+	lastProgressTime time.Time
+}
+
+// NewExtensionObjectProgress returns a new ExtensionObjectProgress which considers extension objects as stuck if their
+// last operation did not make progress within the given timeout.
+func NewExtensionObjectProgress(timeout time.Duration) *ExtensionObjectProgress {
+	return &ExtensionObjectProgress{timeout: timeout}
+}
+
+// Observe records the current state of the given extension object at the given time and returns true if it did not
+// change within the timeout. The observed generation, the type, state and progress of the last operation and the
+// presence of the operation annotation constitute the state; the update time of the last operation does not, as
+// controllers which fail repeatedly would otherwise never be considered stuck.
+func (p *ExtensionObjectProgress) Observe(obj extensionsv1alpha1.Object, now time.Time) bool {
+	var (
+		status = obj.GetExtensionStatus()
+		state  = fmt.Sprintf("%d", status.GetObservedGeneration())
+	)
+
+	if lastOperation := status.GetLastOperation(); lastOperation != nil {
+		state += fmt.Sprintf("/%s/%s/%d", lastOperation.GetType(), lastOperation.GetState(), lastOperation.GetProgress())
+	}
+	if operation, ok := obj.GetAnnotations()[v1alpha1constants.GardenerOperation]; ok {
+		state += "/" + operation
+	}
+
+	if p.lastProgressTime.IsZero() || state != p.lastState {
+		p.lastState = state
+		p.lastProgressTime = now
+		return false
+	}
+	return now.Sub(p.lastProgressTime) >= p.timeout
+}
+
+// Reset restarts the timeout at the given time.
+func (p *ExtensionObjectProgress) Reset(now time.Time) {
+	p.lastProgressTime = now
+}
+
+// ReconcileTimeoutForResource returns the reconcile timeout of the given resource kind and type which is configured in
+// the ControllerRegistration supporting it, or the given default timeout.
+func ReconcileTimeoutForResource(controllerRegistrations []*gardencorev1alpha1.ControllerRegistration, kind, extensionType string, defaultTimeout time.Duration) time.Duration {
+	for _, controllerRegistration := range controllerRegistrations {
+		for _, resource := range controllerRegistration.Spec.Resources {
+			if resource.Kind == kind && resource.Type == extensionType && resource.ReconcileTimeout != nil {
+				return resource.ReconcileTimeout.Duration
+			}
+		}
+	}
+	return defaultTimeout
+}
+
+// ResponsibleControllerInstallation returns the name of the ControllerInstallation on the given seed whose
+// ControllerRegistration supports the given resource kind and type. It returns an empty string if there is none.
+func ResponsibleControllerInstallation(controllerRegistrations []*gardencorev1alpha1.ControllerRegistration, controllerInstallations []*gardencorev1alpha1.ControllerInstallation, kind, extensionType, seedName string) string {
+	for _, controllerRegistration := range controllerRegistrations {
+		for _, resource := range controllerRegistration.Spec.Resources {
+			if resource.Kind != kind || resource.Type != extensionType {
+				continue
+			}
+			for _, controllerInstallation := range controllerInstallations {
+				if controllerInstallation.Spec.RegistrationRef.Name == controllerRegistration.Name && controllerInstallation.Spec.SeedRef.Name == seedName {
+					return controllerInstallation.Name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// waitUntilExtensionObjectReady waits until the given extension object has been reconciled successfully. The object
+// is stuck if its last operation did not make progress within the reconcile timeout of the ControllerRegistration
+// supporting it (or the given default timeout). If configured, stuck objects are annotated once to retrigger their
+// reconciliation, otherwise an error which names the responsible ControllerInstallation is returned. The given
+// function is called with the ready object.
+func (b *Botanist) waitUntilExtensionObjectReady(ctx context.Context, obj extensionObject, kind string, defaultTimeout time.Duration, postReadyFunc func()) error {
+	var (
+		name      = obj.GetName()
+		namespace = obj.GetNamespace()
+
+		controllerRegistrations []*gardencorev1alpha1.ControllerRegistration
+		progress                *ExtensionObjectProgress
+		timeout                 time.Duration
+		start                   = time.Now()
+		retriggered             bool
+	)
+
+	if b.K8sGardenCoreInformers != nil {
+		var err error
+		if controllerRegistrations, err = b.K8sGardenCoreInformers.ControllerRegistrations().Lister().List(labels.Everything()); err != nil {
+			return err
+		}
+	}
+
+	return retry.Until(ctx, DefaultInterval, func(ctx context.Context) (bool, error) {
+		if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(namespace, name), obj); err != nil {
+			return retry.SevereError(err)
+		}
+
+		if progress == nil {
+			timeout = ReconcileTimeoutForResource(controllerRegistrations, kind, obj.GetExtensionSpec().GetExtensionType(), defaultTimeout)
+			progress = NewExtensionObjectProgress(timeout)
+		}
+
+		err := health.CheckExtensionObject(obj)
+		if err == nil {
+			if postReadyFunc != nil {
+				postReadyFunc()
+			}
+			return retry.Ok()
+		}
+		b.Logger.WithError(err).Errorf("%s %s/%s did not get ready yet", kind, namespace, name)
+
+		now := time.Now()
+		if !progress.Observe(obj, now) {
+			if now.Sub(start) >= extensionObjectMaxWaitFactor*timeout {
+				return retry.SevereError(fmt.Errorf("%s %s/%s did not get ready within %s: %v", kind, namespace, name, extensionObjectMaxWaitFactor*timeout, err))
+			}
+			return retry.MinorError(err)
+		}
+
+		if !retriggered && b.Config != nil && b.Config.Controllers.Shoot.RetriggerStuckExtensionResources != nil && *b.Config.Controllers.Shoot.RetriggerStuckExtensionResources {
+			b.Logger.Infof("%s %s/%s did not make progress for %s, retriggering its reconciliation", kind, namespace, name, timeout)
+			if err := b.retriggerExtensionObject(ctx, obj); err != nil {
+				return retry.SevereError(err)
+			}
+			retriggered = true
+			progress.Reset(now)
+			return retry.MinorError(err)
+		}
+
+		return retry.SevereError(b.newExtensionObjectStuckError(obj, kind, timeout, controllerRegistrations, err))
+	})
+}
+
+func (b *Botanist) retriggerExtensionObject(ctx context.Context, obj extensionObject) error {
+	patch := client.MergeFrom(obj.DeepCopyObject())
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[v1alpha1constants.GardenerOperation] = v1alpha1constants.GardenerOperationReconcile
+	obj.SetAnnotations(annotations)
+	return b.K8sSeedClient.Client().Patch(ctx, obj, patch)
+}
+
+// newExtensionObjectStuckError returns an error with code ErrorExtensionStuck which names the ControllerInstallation
+// that is responsible for the given stuck extension object.
+func (b *Botanist) newExtensionObjectStuckError(obj extensionObject, kind string, timeout time.Duration, controllerRegistrations []*gardencorev1alpha1.ControllerRegistration, cause error) error {
+	var (
+		extensionType = obj.GetExtensionSpec().GetExtensionType()
+		message       = fmt.Sprintf("%s %s/%s of type %q is stuck, its last operation did not make progress for %s: %v", kind, obj.GetNamespace(), obj.GetName(), extensionType, timeout, cause)
+	)
+
+	if b.K8sGardenCoreInformers != nil && b.Seed != nil {
+		controllerInstallations, err := b.K8sGardenCoreInformers.ControllerInstallations().Lister().List(labels.Everything())
+		if err == nil {
+			if name := ResponsibleControllerInstallation(controllerRegistrations, controllerInstallations, kind, extensionType, b.Seed.Info.Name); len(name) > 0 {
+				message += fmt.Sprintf(" (responsible ControllerInstallation: %s)", name)
+			}
+		}
+	}
+
+	return gardencorev1alpha1helper.NewErrorWithCode(gardencorev1alpha1.ErrorExtensionStuck, message)
+}
+
+// extensionObjectError prefixes the given error returned by waitUntilExtensionObjectReady with the given message. The
+// error code of stuck extension objects is retained, otherwise it is determined from the message.
+func extensionObjectError(message string, err error) error {
+	if coder, ok := err.(gardencorev1alpha1helper.Coder); ok {
+		return gardencorev1alpha1helper.NewErrorWithCode(coder.Code(), fmt.Sprintf("%s: %v", message, err))
+	}
+	return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("%s: %v", message, err))
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("extension objects", func() {
+	Describe("#ExtensionObjectProgress", func() {
+		var (
+			now      = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+			timeout  = 5 * time.Minute
+			progress *ExtensionObjectProgress
+			obj      *extensionsv1alpha1.Infrastructure
+		)
+
+		BeforeEach(func() {
+			progress = NewExtensionObjectProgress(timeout)
+			obj = &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						ObservedGeneration: 2,
+						LastOperation: &gardencorev1alpha1.LastOperation{
+							Type:           gardencorev1alpha1.LastOperationTypeReconcile,
+							State:          gardencorev1alpha1.LastOperationStateProcessing,
+							Progress:       10,
+							LastUpdateTime: metav1.NewTime(now),
+						},
+					},
+				},
+			}
+		})
+
+		It("should not consider the object stuck within the timeout", func() {
+			Expect(progress.Observe(obj, now)).To(BeFalse())
+			Expect(progress.Observe(obj, now.Add(timeout-time.Second))).To(BeFalse())
+		})
+
+		It("should consider the object stuck if it does not make progress within the timeout", func() {
+			Expect(progress.Observe(obj, now)).To(BeFalse())
+			Expect(progress.Observe(obj, now.Add(timeout))).To(BeTrue())
+		})
+
+		It("should restart the timeout if the progress of the last operation changes", func() {
+			Expect(progress.Observe(obj, now)).To(BeFalse())
+
+			obj.Status.LastOperation.Progress = 50
+			Expect(progress.Observe(obj, now.Add(timeout))).To(BeFalse())
+			Expect(progress.Observe(obj, now.Add(2*timeout-time.Second))).To(BeFalse())
+			Expect(progress.Observe(obj, now.Add(2*timeout))).To(BeTrue())
+		})
+
+		It("should restart the timeout if the operation annotation is removed", func() {
+			obj.Annotations = map[string]string{v1alpha1constants.GardenerOperation: v1alpha1constants.GardenerOperationReconcile}
+			Expect(progress.Observe(obj, now)).To(BeFalse())
+
+			obj.Annotations = nil
+			Expect(progress.Observe(obj, now.Add(timeout))).To(BeFalse())
+		})
+
+		It("should not restart the timeout if only the update time of the last operation changes", func() {
+			Expect(progress.Observe(obj, now)).To(BeFalse())
+
+			obj.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateError
+			Expect(progress.Observe(obj, now.Add(time.Minute))).To(BeFalse())
+
+			obj.Status.LastOperation.LastUpdateTime = metav1.NewTime(now.Add(timeout))
+			Expect(progress.Observe(obj, now.Add(time.Minute+timeout))).To(BeTrue())
+		})
+
+		It("should restart the timeout when it is reset", func() {
+			Expect(progress.Observe(obj, now)).To(BeFalse())
+
+			progress.Reset(now.Add(timeout))
+			Expect(progress.Observe(obj, now.Add(timeout))).To(BeFalse())
+			Expect(progress.Observe(obj, now.Add(2*timeout))).To(BeTrue())
+		})
+	})
+
+	Context("ControllerRegistrations", func() {
+		var (
+			controllerRegistrations = []*gardencorev1alpha1.ControllerRegistration{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "provider-aws"},
+					Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+						Resources: []gardencorev1alpha1.ControllerResource{
+							{Kind: extensionsv1alpha1.InfrastructureResource, Type: "aws", ReconcileTimeout: &metav1.Duration{Duration: 10 * time.Minute}},
+							{Kind: extensionsv1alpha1.ControlPlaneResource, Type: "aws"},
+						},
+					},
+				},
+			}
+			controllerInstallations = []*gardencorev1alpha1.ControllerInstallation{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "provider-aws-abcde"},
+					Spec: gardencorev1alpha1.ControllerInstallationSpec{
+						RegistrationRef: corev1.ObjectReference{Name: "provider-aws"},
+						SeedRef:         corev1.ObjectReference{Name: "seed-1"},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "provider-aws-fghij"},
+					Spec: gardencorev1alpha1.ControllerInstallationSpec{
+						RegistrationRef: corev1.ObjectReference{Name: "provider-aws"},
+						SeedRef:         corev1.ObjectReference{Name: "seed-2"},
+					},
+				},
+			}
+		)
+
+		Describe("#ReconcileTimeoutForResource", func() {
+			It("should return the configured reconcile timeout", func() {
+				Expect(ReconcileTimeoutForResource(controllerRegistrations, extensionsv1alpha1.InfrastructureResource, "aws", time.Minute)).To(Equal(10 * time.Minute))
+			})
+
+			It("should return the default timeout if no reconcile timeout is configured", func() {
+				Expect(ReconcileTimeoutForResource(controllerRegistrations, extensionsv1alpha1.ControlPlaneResource, "aws", time.Minute)).To(Equal(time.Minute))
+				Expect(ReconcileTimeoutForResource(controllerRegistrations, extensionsv1alpha1.InfrastructureResource, "gcp", time.Minute)).To(Equal(time.Minute))
+			})
+		})
+
+		Describe("#ResponsibleControllerInstallation", func() {
+			It("should return the ControllerInstallation of the seed", func() {
+				Expect(ResponsibleControllerInstallation(controllerRegistrations, controllerInstallations, extensionsv1alpha1.ControlPlaneResource, "aws", "seed-2")).To(Equal("provider-aws-fghij"))
+			})
+
+			It("should return an empty string if there is no responsible ControllerInstallation", func() {
+				Expect(ResponsibleControllerInstallation(controllerRegistrations, controllerInstallations, extensionsv1alpha1.ControlPlaneResource, "aws", "seed-3")).To(BeEmpty())
+				Expect(ResponsibleControllerInstallation(controllerRegistrations, controllerInstallations, extensionsv1alpha1.ControlPlaneResource, "gcp", "seed-1")).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,124 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"sort"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ShootExtensionStatus returns the status of the given extension object of the given kind as it is reported in the
+// Shoot status.
+func ShootExtensionStatus(kind string, obj extensionsv1alpha1.Object) gardencorev1alpha1.ShootExtensionStatus {
+	status := gardencorev1alpha1.ShootExtensionStatus{
+		Kind: kind,
+		Name: obj.GetName(),
+		Type: obj.GetExtensionSpec().GetExtensionType(),
+	}
+
+	switch o := obj.(type) {
+	case *extensionsv1alpha1.ControlPlane:
+		if o.Spec.Purpose != nil {
+			purpose := string(*o.Spec.Purpose)
+			status.Purpose = &purpose
+		}
+	case *extensionsv1alpha1.OperatingSystemConfig:
+		if len(o.Spec.Purpose) > 0 {
+			purpose := string(o.Spec.Purpose)
+			status.Purpose = &purpose
+		}
+	}
+
+	if lastOperation := obj.GetExtensionStatus().GetLastOperation(); lastOperation != nil {
+		status.LastOperation = &gardencorev1alpha1.LastOperation{
+			Description:    lastOperation.GetDescription(),
+			LastUpdateTime: lastOperation.GetLastUpdateTime(),
+			Progress:       lastOperation.GetProgress(),
+			State:          lastOperation.GetState(),
+			Type:           lastOperation.GetType(),
+		}
+	}
+	if lastError := obj.GetExtensionStatus().GetLastError(); lastError != nil {
+		status.LastError = &gardencorev1alpha1.LastError{
+			Description:    lastError.GetDescription(),
+			Codes:          lastError.GetCodes(),
+			LastUpdateTime: lastError.GetLastUpdateTime(),
+		}
+	}
+
+	return status
+}
+
+// UpdateShootExtensionStatus copies the status of the extension resources in the Seed namespace of the Shoot into the
+// Shoot status so that it is visible to users without access to the Seed.
+func (b *Botanist) UpdateShootExtensionStatus(ctx context.Context) error {
+	var extensions []gardencorev1alpha1.ShootExtensionStatus
+
+	for kind, listObj := range map[string]runtime.Object{
+		extensionsv1alpha1.ControlPlaneResource:          &extensionsv1alpha1.ControlPlaneList{},
+		extensionsv1alpha1.ExtensionResource:             &extensionsv1alpha1.ExtensionList{},
+		extensionsv1alpha1.InfrastructureResource:        &extensionsv1alpha1.InfrastructureList{},
+		extensionsv1alpha1.NetworkResource:               &extensionsv1alpha1.NetworkList{},
+		extensionsv1alpha1.OperatingSystemConfigResource: &extensionsv1alpha1.OperatingSystemConfigList{},
+		extensionsv1alpha1.WorkerResource:                &extensionsv1alpha1.WorkerList{},
+	} {
+		if err := b.K8sSeedClient.Client().List(ctx, listObj, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+			return err
+		}
+
+		if err := meta.EachListItem(listObj, func(obj runtime.Object) error {
+			acc, ok := obj.(extensionsv1alpha1.Object)
+			if !ok {
+				return nil
+			}
+			extensions = append(extensions, ShootExtensionStatus(kind, acc))
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	sort.Slice(extensions, func(i, j int) bool {
+		if extensions[i].Kind != extensions[j].Kind {
+			return extensions[i].Kind < extensions[j].Kind
+		}
+		return extensions[i].Name < extensions[j].Name
+	})
+
+	if apiequality.Semantic.DeepEqual(b.Shoot.Info.Status.Extensions, extensions) {
+		return nil
+	}
+
+	newShoot, err := kutil.TryUpdateShootStatus(b.K8sGardenClient.GardenCore(), retry.DefaultRetry, b.Shoot.Info.ObjectMeta, func(shoot *gardencorev1alpha1.Shoot) (*gardencorev1alpha1.Shoot, error) {
+		shoot.Status.Extensions = extensions
+		return shoot, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	b.Shoot.Info = newShoot
+	return nil
+}
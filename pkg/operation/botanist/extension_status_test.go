@@ -0,0 +1,95 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("extension status", func() {
+	Describe("#ShootExtensionStatus", func() {
+		var now = metav1.NewTime(time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))
+
+		It("should copy the last operation and the last error", func() {
+			infrastructure := &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "aws"},
+				},
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						LastOperation: &gardencorev1alpha1.LastOperation{
+							Type:           gardencorev1alpha1.LastOperationTypeReconcile,
+							State:          gardencorev1alpha1.LastOperationStateError,
+							Progress:       50,
+							Description:    "reconciling",
+							LastUpdateTime: now,
+						},
+						LastError: &gardencorev1alpha1.LastError{
+							Description:    "quota exceeded",
+							Codes:          []gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraQuotaExceeded},
+							LastUpdateTime: &now,
+						},
+					},
+				},
+			}
+
+			Expect(ShootExtensionStatus(extensionsv1alpha1.InfrastructureResource, infrastructure)).To(Equal(gardencorev1alpha1.ShootExtensionStatus{
+				Kind:          extensionsv1alpha1.InfrastructureResource,
+				Name:          "foo",
+				Type:          "aws",
+				LastOperation: infrastructure.Status.LastOperation,
+				LastError:     infrastructure.Status.LastError,
+			}))
+		})
+
+		It("should set the purpose of control planes", func() {
+			purpose := extensionsv1alpha1.Exposure
+			controlPlane := &extensionsv1alpha1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo-exposure"},
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "aws"},
+					Purpose:     &purpose,
+				},
+			}
+
+			status := ShootExtensionStatus(extensionsv1alpha1.ControlPlaneResource, controlPlane)
+			Expect(status.Purpose).To(PointTo(Equal("exposure")))
+			Expect(status.LastOperation).To(BeNil())
+			Expect(status.LastError).To(BeNil())
+		})
+
+		It("should set the purpose of operating system configs", func() {
+			osc := &extensionsv1alpha1.OperatingSystemConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "coreos"},
+					Purpose:     extensionsv1alpha1.OperatingSystemConfigPurposeReconcile,
+				},
+			}
+
+			Expect(ShootExtensionStatus(extensionsv1alpha1.OperatingSystemConfigResource, osc).Purpose).To(PointTo(Equal("reconcile")))
+		})
+	})
+})
@@ -26,7 +26,6 @@ import (
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/operation/common"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
-	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 
@@ -96,23 +95,13 @@ func (b *Botanist) DestroyInfrastructure(ctx context.Context) error {
 
 // WaitUntilInfrastructureReady waits until the infrastructure resource has been reconciled successfully.
 func (b *Botanist) WaitUntilInfrastructureReady(ctx context.Context) error {
-	if err := retry.UntilTimeout(ctx, DefaultInterval, InfrastructureDefaultTimeout, func(ctx context.Context) (bool, error) {
-		infrastructure := &extensionsv1alpha1.Infrastructure{}
-		if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: b.Shoot.Info.Name, Namespace: b.Shoot.SeedNamespace}, infrastructure); err != nil {
-			return retry.SevereError(err)
-		}
-
-		if err := health.CheckExtensionObject(infrastructure); err != nil {
-			b.Logger.WithError(err).Error("Infrastructure did not get ready yet")
-			return retry.MinorError(err)
-		}
-
+	infrastructure := &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: b.Shoot.Info.Name, Namespace: b.Shoot.SeedNamespace}}
+	if err := b.waitUntilExtensionObjectReady(ctx, infrastructure, extensionsv1alpha1.InfrastructureResource, InfrastructureDefaultTimeout, func() {
 		if infrastructure.Status.ProviderStatus != nil {
 			b.Shoot.InfrastructureStatus = infrastructure.Status.ProviderStatus.Raw
 		}
-		return retry.Ok()
 	}); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed to create infrastructure: %v", err))
+		return extensionObjectError("failed to create infrastructure", err)
 	}
 	return nil
 }
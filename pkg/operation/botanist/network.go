@@ -25,7 +25,6 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
-	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	"github.com/gardener/gardener/pkg/utils/retry"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -73,18 +72,9 @@ func (b *Botanist) DestroyNetwork(ctx context.Context) error {
 
 // WaitUntilNetworkIsReady waits until the network resource has been reconciled successfully.
 func (b *Botanist) WaitUntilNetworkIsReady(ctx context.Context) error {
-	if err := retry.UntilTimeout(ctx, DefaultInterval, NetworkDefaultTimeout, func(ctx context.Context) (bool, error) {
-		network := &extensionsv1alpha1.Network{}
-		if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: b.Shoot.Info.Name, Namespace: b.Shoot.SeedNamespace}, network); err != nil {
-			return retry.SevereError(err)
-		}
-		if err := health.CheckExtensionObject(network); err != nil {
-			b.Logger.WithError(err).Error("Network did not get ready yet")
-			return retry.MinorError(err)
-		}
-		return retry.Ok()
-	}); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed to create network: %v", err))
+	network := &extensionsv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Name: b.Shoot.Info.Name, Namespace: b.Shoot.SeedNamespace}}
+	if err := b.waitUntilExtensionObjectReady(ctx, network, extensionsv1alpha1.NetworkResource, NetworkDefaultTimeout, nil); err != nil {
+		return extensionObjectError("failed to create network", err)
 	}
 	return nil
 }
@@ -25,7 +25,6 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
-	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 
@@ -116,21 +115,11 @@ func (b *Botanist) DestroyWorker(ctx context.Context) error {
 
 // WaitUntilWorkerReady waits until the worker extension resource has been successfully reconciled.
 func (b *Botanist) WaitUntilWorkerReady(ctx context.Context) error {
-	if err := retry.UntilTimeout(ctx, DefaultInterval, WorkerDefaultTimeout, func(ctx context.Context) (bool, error) {
-		worker := &extensionsv1alpha1.Worker{}
-		if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: b.Shoot.Info.Name, Namespace: b.Shoot.SeedNamespace}, worker); err != nil {
-			return retry.SevereError(err)
-		}
-
-		if err := health.CheckExtensionObject(worker); err != nil {
-			b.Logger.WithError(err).Error("Worker did not get ready yet")
-			return retry.MinorError(err)
-		}
-
+	worker := &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Name: b.Shoot.Info.Name, Namespace: b.Shoot.SeedNamespace}}
+	if err := b.waitUntilExtensionObjectReady(ctx, worker, extensionsv1alpha1.WorkerResource, WorkerDefaultTimeout, func() {
 		b.Shoot.MachineDeployments = worker.Status.MachineDeployments
-		return retry.Ok()
 	}); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Error while waiting for worker object to become ready: %v", err))
+		return extensionObjectError("Error while waiting for worker object to become ready", err)
 	}
 	return nil
 }
@@ -241,6 +241,22 @@ const (
 	// NamespaceProject is they key of a label on namespace whose value holds the project uid.
 	NamespaceProject = "namespace.garden.sapcloud.io/project"
 
+	// LabelExtensionProjectRole is the key of a label on the ClusterRoles and RoleBindings created for extension roles
+	// of project members. Its value holds the name of the extension role.
+	LabelExtensionProjectRole = "rbac.gardener.cloud/extension-project-role"
+
+	// LabelProjectQuota is the key of a label on the ResourceQuota and LimitRange in project namespaces which are
+	// managed by the project controller according to the quota configuration. Only labelled objects are deleted.
+	LabelProjectQuota = "project.garden.sapcloud.io/quota"
+
+	// ProjectSkipStaleAutoDeletion is the key of an annotation on Projects. If its value is "true" then the Project is
+	// never deleted automatically, even if it is stale.
+	ProjectSkipStaleAutoDeletion = "project.garden.sapcloud.io/skip-stale-auto-deletion"
+
+	// LabelProjectServiceAccount is the key of a label on ServiceAccounts in project namespaces which are managed by
+	// the project controller because they are declared in the Project specification.
+	LabelProjectServiceAccount = "project.garden.sapcloud.io/service-account"
+
 	// SecretRefChecksumAnnotation is the annotation key for checksum of referred secret in resource spec.
 	SecretRefChecksumAnnotation = "checksum/secret.data"
 
@@ -326,6 +342,11 @@ const (
 	// delete)).
 	ShootIgnore = "shoot.garden.sapcloud.io/ignore"
 
+	// ShootLocked is a constant for an annotation on a Shoot which may be used to lock the Shoot against changes. If its value is
+	// "true" then all changes to the specification of the Shoot (including hibernation) are rejected, unless they are performed by
+	// a member of the shoot lock administrators group. The annotation must be removed in a separate request to unlock the Shoot.
+	ShootLocked = "shoot.garden.sapcloud.io/locked"
+
 	// AnnotatePersistentVolumeMinimumSize is used to specify the minimum size of persistent volume in the cluster
 	AnnotatePersistentVolumeMinimumSize = "persistentvolume.garden.sapcloud.io/minimumSize"
 
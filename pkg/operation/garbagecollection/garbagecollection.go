@@ -0,0 +1,153 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package garbagecollection
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Now returns the current time. It is a variable so that it can be overwritten in tests.
+var Now = time.Now
+
+// Policy is a garbage collection policy. It determines the objects which are considered garbage.
+type Policy interface {
+	// Name returns the name of the policy.
+	Name() string
+	// Garbage returns the objects which are considered garbage by the policy.
+	Garbage(ctx context.Context) ([]Garbage, error)
+}
+
+// Garbage is an object which is considered garbage by a policy.
+type Garbage struct {
+	// Client is the client which is used to delete the object.
+	Client client.Client
+	// Object is the object which is considered garbage.
+	Object runtime.Object
+	// Reason is a human-readable description why the object is considered garbage.
+	Reason string
+	// DeleteOptions are the options which are used to delete the object.
+	DeleteOptions []client.DeleteOptionFunc
+}
+
+// Report contains the result of a garbage collection for a single policy.
+type Report struct {
+	// Policy is the name of the policy.
+	Policy string
+	// DryRun indicates whether the objects have only been reported instead of being deleted.
+	DryRun bool
+	// Objects are the keys of the objects which have been deleted (or which would have been deleted in dry-run mode).
+	Objects []string
+	// Err is the error which occurred while determining or deleting the garbage of the policy.
+	Err error
+}
+
+// Collector collects the garbage determined by policies.
+type Collector struct {
+	logger logrus.FieldLogger
+	dryRun bool
+}
+
+// NewCollector creates a new Collector. If <dryRun> is true, then the garbage is only reported but not deleted.
+func NewCollector(logger logrus.FieldLogger, dryRun bool) *Collector {
+	return &Collector{
+		logger: logger,
+		dryRun: dryRun,
+	}
+}
+
+// Collect determines the garbage of all given policies and deletes it. It returns a report for each policy.
+func (c *Collector) Collect(ctx context.Context, policies ...Policy) []Report {
+	reports := make([]Report, 0, len(policies))
+
+	for _, policy := range policies {
+		reports = append(reports, c.collect(ctx, policy))
+	}
+
+	return reports
+}
+
+func (c *Collector) collect(ctx context.Context, policy Policy) Report {
+	report := Report{
+		Policy: policy.Name(),
+		DryRun: c.dryRun,
+	}
+
+	garbage, err := policy.Garbage(ctx)
+	if err != nil {
+		report.Err = fmt.Errorf("could not determine garbage of policy %q: %v", policy.Name(), err)
+		return report
+	}
+
+	var result error
+	for _, g := range garbage {
+		key, err := objectKey(g.Object)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		if c.dryRun {
+			c.logger.Infof("[DRY RUN] Garbage collection policy %q would delete %s: %s", policy.Name(), key, g.Reason)
+			report.Objects = append(report.Objects, key)
+			continue
+		}
+
+		c.logger.Infof("Garbage collection policy %q deletes %s: %s", policy.Name(), key, g.Reason)
+		if err := g.Client.Delete(ctx, g.Object, g.DeleteOptions...); client.IgnoreNotFound(err) != nil {
+			result = multierror.Append(result, fmt.Errorf("could not delete %s: %v", key, err))
+			continue
+		}
+		report.Objects = append(report.Objects, key)
+	}
+
+	report.Err = result
+	return report
+}
+
+// objectKey returns a human-readable key (kind and namespace/name) of the given object.
+func objectKey(obj runtime.Object) (string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+
+	name := accessor.GetName()
+	if namespace := accessor.GetNamespace(); len(namespace) > 0 {
+		name = namespace + "/" + name
+	}
+
+	kind := reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+	if gvk := obj.GetObjectKind().GroupVersionKind(); len(gvk.Kind) > 0 {
+		kind = gvk.Kind
+	}
+
+	return fmt.Sprintf("%s %s", kind, name), nil
+}
+
+// isCollectable returns true if the given object is not already being deleted and is older than the given minimum
+// age.
+func isCollectable(obj metav1.Object, minimumAge time.Duration) bool {
+	return obj.GetDeletionTimestamp() == nil && obj.GetCreationTimestamp().Time.Before(Now().Add(-minimumAge))
+}
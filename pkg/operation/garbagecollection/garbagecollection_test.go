@@ -0,0 +1,287 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package garbagecollection_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	"github.com/gardener/gardener/pkg/logger"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	. "github.com/gardener/gardener/pkg/operation/garbagecollection"
+
+	resourcesv1alpha1 "github.com/gardener/gardener-resource-manager/pkg/apis/resources/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type fakePolicy struct {
+	garbage []Garbage
+	err     error
+}
+
+func (p *fakePolicy) Name() string { return "Fake" }
+
+func (p *fakePolicy) Garbage(_ context.Context) ([]Garbage, error) { return p.garbage, p.err }
+
+var _ = Describe("garbagecollection", func() {
+	var (
+		ctrl          *gomock.Controller
+		runtimeClient *mockclient.MockClient
+		ctx           = context.TODO()
+		testLogger    = logger.NewFieldLogger(logger.AddWriter(logger.NewLogger("info"), GinkgoWriter), "test", "garbagecollection")
+
+		now        = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+		old        = metav1.NewTime(now.Add(-48 * time.Hour))
+		young      = metav1.NewTime(now.Add(-time.Hour))
+		minimumAge = 24 * time.Hour
+
+		projectNamespace = "garden-foo"
+
+		gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		runtimeClient = mockclient.NewMockClient(ctrl)
+		Now = func() time.Time { return now }
+
+		gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+		Expect(gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&gardencorev1alpha1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "garden-foo", Name: "bar", UID: "1234"},
+			Status:     gardencorev1alpha1.ShootStatus{TechnicalID: "shoot--foo--bar"},
+		})).To(Succeed())
+		Expect(gardenCoreInformerFactory.Core().V1alpha1().Projects().Informer().GetStore().Add(&gardencorev1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1alpha1.ProjectSpec{Namespace: &projectNamespace},
+		})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		Now = time.Now
+	})
+
+	Describe("Collector", func() {
+		var (
+			secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"}}
+			policy *fakePolicy
+		)
+
+		BeforeEach(func() {
+			policy = &fakePolicy{garbage: []Garbage{{Client: runtimeClient, Object: secret, Reason: "test"}}}
+		})
+
+		It("should only report the garbage in dry-run mode", func() {
+			reports := NewCollector(testLogger, true).Collect(ctx, policy)
+
+			Expect(reports).To(ConsistOf(Report{Policy: "Fake", DryRun: true, Objects: []string{"Secret foo/bar"}}))
+		})
+
+		It("should delete the garbage", func() {
+			runtimeClient.EXPECT().Delete(ctx, secret)
+
+			reports := NewCollector(testLogger, false).Collect(ctx, policy)
+
+			Expect(reports).To(ConsistOf(Report{Policy: "Fake", Objects: []string{"Secret foo/bar"}}))
+		})
+
+		It("should ignore garbage which is already gone", func() {
+			runtimeClient.EXPECT().Delete(ctx, secret).Return(apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "bar"))
+
+			reports := NewCollector(testLogger, false).Collect(ctx, policy)
+
+			Expect(reports).To(HaveLen(1))
+			Expect(reports[0].Err).NotTo(HaveOccurred())
+		})
+
+		It("should report errors", func() {
+			runtimeClient.EXPECT().Delete(ctx, secret).Return(errors.New("fake"))
+
+			reports := NewCollector(testLogger, false).Collect(ctx, policy, &fakePolicy{err: errors.New("fake")})
+
+			Expect(reports).To(HaveLen(2))
+			Expect(reports[0].Objects).To(BeEmpty())
+			Expect(reports[0].Err).To(HaveOccurred())
+			Expect(reports[1].Err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#NewOrphanedShootNamespacesPolicy", func() {
+		It("should consider old namespaces of non-existing shoots garbage", func() {
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.NamespaceList{}), gomock.Any()).DoAndReturn(func(_ context.Context, list *corev1.NamespaceList, _ ...client.ListOptionFunc) error {
+				list.Items = []corev1.Namespace{
+					{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar", CreationTimestamp: old}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--orphaned", CreationTimestamp: old}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--young", CreationTimestamp: young}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--terminating", CreationTimestamp: old, DeletionTimestamp: &young}},
+				}
+				return nil
+			})
+
+			garbage, err := NewOrphanedShootNamespacesPolicy(runtimeClient, gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Projects().Lister(), minimumAge).Garbage(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(garbage).To(HaveLen(1))
+			Expect(garbage[0].Object.(*corev1.Namespace).Name).To(Equal("shoot--foo--orphaned"))
+		})
+
+		It("should not consider namespaces of shoots without technical id garbage", func() {
+			Expect(gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&gardencorev1alpha1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "garden-foo", Name: "new"},
+			})).To(Succeed())
+
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.NamespaceList{}), gomock.Any()).DoAndReturn(func(_ context.Context, list *corev1.NamespaceList, _ ...client.ListOptionFunc) error {
+				list.Items = []corev1.Namespace{
+					{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--new", CreationTimestamp: old}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--orphaned", CreationTimestamp: old}},
+				}
+				return nil
+			})
+
+			garbage, err := NewOrphanedShootNamespacesPolicy(runtimeClient, gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Projects().Lister(), minimumAge).Garbage(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(garbage).To(HaveLen(1))
+			Expect(garbage[0].Object.(*corev1.Namespace).Name).To(Equal("shoot--foo--orphaned"))
+		})
+
+		It("should not collect any garbage if the technical id of a shoot cannot be determined", func() {
+			Expect(gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&gardencorev1alpha1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "garden-unknown", Name: "new"},
+			})).To(Succeed())
+
+			garbage, err := NewOrphanedShootNamespacesPolicy(runtimeClient, gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Projects().Lister(), minimumAge).Garbage(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(garbage).To(BeEmpty())
+		})
+	})
+
+	Describe("#NewOrphanedExtensionResourcesPolicy", func() {
+		It("should consider old extension resources of non-existing shoots garbage", func() {
+			runtimeClient.EXPECT().List(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, list runtime.Object, _ ...client.ListOptionFunc) error {
+				switch l := list.(type) {
+				case *extensionsv1alpha1.ClusterList:
+					l.Items = []extensionsv1alpha1.Cluster{
+						{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar", CreationTimestamp: old}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--orphaned", CreationTimestamp: old}},
+					}
+				case *extensionsv1alpha1.InfrastructureList:
+					l.Items = []extensionsv1alpha1.Infrastructure{
+						{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "infra", CreationTimestamp: old}},
+						{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--orphaned", Name: "infra", CreationTimestamp: old}},
+					}
+				case *extensionsv1alpha1.NetworkList:
+					return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: extensionsv1alpha1.SchemeGroupVersion.Group, Kind: "Network"}}
+				}
+				return nil
+			}).Times(7)
+
+			garbage, err := NewOrphanedExtensionResourcesPolicy(runtimeClient, gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Projects().Lister(), minimumAge).Garbage(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(garbage).To(HaveLen(2))
+			Expect(garbage[0].Object.(*extensionsv1alpha1.Cluster).Name).To(Equal("shoot--foo--orphaned"))
+			Expect(garbage[1].Object.(*extensionsv1alpha1.Infrastructure).Namespace).To(Equal("shoot--foo--orphaned"))
+		})
+	})
+
+	Describe("#NewStaleManagedResourcesPolicy", func() {
+		It("should consider managed resources garbage whose secrets do not exist anymore", func() {
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResourceList{})).DoAndReturn(func(_ context.Context, list *resourcesv1alpha1.ManagedResourceList, _ ...client.ListOptionFunc) error {
+				list.Items = []resourcesv1alpha1.ManagedResource{
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "stale", CreationTimestamp: old}, Spec: resourcesv1alpha1.ManagedResourceSpec{SecretRefs: []corev1.LocalObjectReference{{Name: "missing"}}}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "ok", CreationTimestamp: old}, Spec: resourcesv1alpha1.ManagedResourceSpec{SecretRefs: []corev1.LocalObjectReference{{Name: "missing"}, {Name: "existing"}}}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "young", CreationTimestamp: young}, Spec: resourcesv1alpha1.ManagedResourceSpec{SecretRefs: []corev1.LocalObjectReference{{Name: "missing"}}}},
+				}
+				return nil
+			})
+			runtimeClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: "foo", Name: "missing"}, gomock.AssignableToTypeOf(&corev1.Secret{})).Return(apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "missing")).Times(2)
+			runtimeClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: "foo", Name: "existing"}, gomock.AssignableToTypeOf(&corev1.Secret{}))
+
+			garbage, err := NewStaleManagedResourcesPolicy(runtimeClient, minimumAge).Garbage(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(garbage).To(HaveLen(1))
+			Expect(garbage[0].Object.(*resourcesv1alpha1.ManagedResource).Name).To(Equal("stale"))
+		})
+	})
+
+	Describe("#NewTerraformerResourcesPolicy", func() {
+		It("should consider finished jobs and unused configurations with empty state garbage", func() {
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&batchv1.JobList{})).DoAndReturn(func(_ context.Context, list *batchv1.JobList, _ ...client.ListOptionFunc) error {
+				list.Items = []batchv1.Job{
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "finished.infra.tf-job", CreationTimestamp: old}, Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "running.infra.tf-job", CreationTimestamp: old}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "other", CreationTimestamp: old}, Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}}},
+				}
+				return nil
+			})
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.ConfigMapList{})).DoAndReturn(func(_ context.Context, list *corev1.ConfigMapList, _ ...client.ListOptionFunc) error {
+				list.Items = []corev1.ConfigMap{
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "running.infra.tf-config", CreationTimestamp: old}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "applied.infra.tf-config", CreationTimestamp: old}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "applied.infra.tf-state", CreationTimestamp: old}, Data: map[string]string{"terraform.tfstate": "{}"}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "destroyed.infra.tf-config", CreationTimestamp: old}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "destroyed.infra.tf-state", CreationTimestamp: old}},
+				}
+				return nil
+			})
+
+			garbage, err := NewTerraformerResourcesPolicy(runtimeClient, minimumAge).Garbage(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, g := range garbage {
+				accessor, err := meta.Accessor(g.Object)
+				Expect(err).NotTo(HaveOccurred())
+				names = append(names, accessor.GetName())
+			}
+			Expect(names).To(ConsistOf("finished.infra.tf-job", "destroyed.infra.tf-config", "destroyed.infra.tf-state", "destroyed.infra.tf-vars"))
+		})
+	})
+
+	Describe("#NewOrphanedBackupEntriesPolicy", func() {
+		It("should consider backup entries of the seed garbage whose shoot does not exist anymore", func() {
+			var (
+				seedName  = "seed"
+				otherSeed = "other"
+			)
+
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1alpha1.BackupEntryList{})).DoAndReturn(func(_ context.Context, list *gardencorev1alpha1.BackupEntryList, _ ...client.ListOptionFunc) error {
+				list.Items = []gardencorev1alpha1.BackupEntry{
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-foo", Name: "shoot--foo--bar--1234", CreationTimestamp: old}, Spec: gardencorev1alpha1.BackupEntrySpec{Seed: &seedName}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-foo", Name: "shoot--foo--bar--5678", CreationTimestamp: old}, Spec: gardencorev1alpha1.BackupEntrySpec{Seed: &seedName}},
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-foo", Name: "shoot--foo--baz--9012", CreationTimestamp: old}, Spec: gardencorev1alpha1.BackupEntrySpec{Seed: &otherSeed}},
+				}
+				return nil
+			})
+
+			garbage, err := NewOrphanedBackupEntriesPolicy(runtimeClient, gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), seedName, minimumAge).Garbage(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(garbage).To(HaveLen(1))
+			Expect(garbage[0].Object.(*gardencorev1alpha1.BackupEntry).Name).To(Equal("shoot--foo--bar--5678"))
+		})
+	})
+})
@@ -0,0 +1,403 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package garbagecollection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/operation/terraformer"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	resourcesv1alpha1 "github.com/gardener/gardener-resource-manager/pkg/apis/resources/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// PolicyOrphanedShootNamespaces is the name of the policy for orphaned Shoot namespaces.
+	PolicyOrphanedShootNamespaces = "OrphanedShootNamespaces"
+	// PolicyOrphanedExtensionResources is the name of the policy for orphaned extension resources.
+	PolicyOrphanedExtensionResources = "OrphanedExtensionResources"
+	// PolicyStaleManagedResources is the name of the policy for stale ManagedResources.
+	PolicyStaleManagedResources = "StaleManagedResources"
+	// PolicyTerraformerResources is the name of the policy for leftover Terraformer resources.
+	PolicyTerraformerResources = "TerraformerResources"
+	// PolicyOrphanedBackupEntries is the name of the policy for orphaned BackupEntries.
+	PolicyOrphanedBackupEntries = "OrphanedBackupEntries"
+)
+
+// shootTechnicalIDs returns the technical ids of all Shoots known by the given lister. The technical id of Shoots
+// which have not yet been reconciled is computed based on their project and name. If the project of such a Shoot
+// cannot be determined then an error is returned, as its resources in the Seed cluster could not be told apart from
+// orphaned ones.
+func shootTechnicalIDs(shootLister gardencorelisters.ShootLister, projectLister gardencorelisters.ProjectLister) (sets.String, error) {
+	shoots, err := shootLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	technicalIDs := sets.NewString()
+	for _, shoot := range shoots {
+		if len(shoot.Status.TechnicalID) > 0 {
+			technicalIDs.Insert(shoot.Status.TechnicalID)
+			continue
+		}
+
+		project, err := common.ProjectForNamespace(projectLister, shoot.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("could not determine the technical id of Shoot %s/%s: %v", shoot.Namespace, shoot.Name, err)
+		}
+		technicalIDs.Insert(shootpkg.ComputeTechnicalID(project.Name, shoot))
+	}
+	return technicalIDs, nil
+}
+
+type orphanedShootNamespaces struct {
+	seedClient    client.Client
+	shootLister   gardencorelisters.ShootLister
+	projectLister gardencorelisters.ProjectLister
+	minimumAge    time.Duration
+}
+
+// NewOrphanedShootNamespacesPolicy returns a policy which considers the Shoot namespaces in the Seed cluster garbage
+// whose Shoot does not exist anymore.
+func NewOrphanedShootNamespacesPolicy(seedClient client.Client, shootLister gardencorelisters.ShootLister, projectLister gardencorelisters.ProjectLister, minimumAge time.Duration) Policy {
+	return &orphanedShootNamespaces{seedClient, shootLister, projectLister, minimumAge}
+}
+
+func (p *orphanedShootNamespaces) Name() string {
+	return PolicyOrphanedShootNamespaces
+}
+
+func (p *orphanedShootNamespaces) Garbage(ctx context.Context) ([]Garbage, error) {
+	technicalIDs, err := shootTechnicalIDs(p.shootLister, p.projectLister)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceList := &corev1.NamespaceList{}
+	if err := p.seedClient.List(ctx, namespaceList, client.MatchingLabels(map[string]string{v1alpha1constants.GardenRole: v1alpha1constants.GardenRoleShoot})); err != nil {
+		return nil, err
+	}
+
+	var garbage []Garbage
+	for i := range namespaceList.Items {
+		namespace := &namespaceList.Items[i]
+		if !isCollectable(namespace, p.minimumAge) || technicalIDs.Has(namespace.Name) {
+			continue
+		}
+
+		garbage = append(garbage, Garbage{
+			Client: p.seedClient,
+			Object: namespace,
+			Reason: "the Shoot of the namespace does not exist anymore",
+		})
+	}
+	return garbage, nil
+}
+
+type orphanedExtensionResources struct {
+	seedClient    client.Client
+	shootLister   gardencorelisters.ShootLister
+	projectLister gardencorelisters.ProjectLister
+	minimumAge    time.Duration
+}
+
+// NewOrphanedExtensionResourcesPolicy returns a policy which considers the extension resources in the Seed cluster
+// garbage whose Shoot does not exist anymore. For namespaced resources the Shoot is determined by the namespace, for
+// Cluster resources by their name.
+func NewOrphanedExtensionResourcesPolicy(seedClient client.Client, shootLister gardencorelisters.ShootLister, projectLister gardencorelisters.ProjectLister, minimumAge time.Duration) Policy {
+	return &orphanedExtensionResources{seedClient, shootLister, projectLister, minimumAge}
+}
+
+func (p *orphanedExtensionResources) Name() string {
+	return PolicyOrphanedExtensionResources
+}
+
+func (p *orphanedExtensionResources) Garbage(ctx context.Context) ([]Garbage, error) {
+	technicalIDs, err := shootTechnicalIDs(p.shootLister, p.projectLister)
+	if err != nil {
+		return nil, err
+	}
+
+	var garbage []Garbage
+	for _, list := range []runtime.Object{
+		&extensionsv1alpha1.ClusterList{},
+		&extensionsv1alpha1.ControlPlaneList{},
+		&extensionsv1alpha1.ExtensionList{},
+		&extensionsv1alpha1.InfrastructureList{},
+		&extensionsv1alpha1.NetworkList{},
+		&extensionsv1alpha1.OperatingSystemConfigList{},
+		&extensionsv1alpha1.WorkerList{},
+	} {
+		if err := p.seedClient.List(ctx, list); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			accessor, err := meta.Accessor(item)
+			if err != nil {
+				return nil, err
+			}
+
+			technicalID := accessor.GetNamespace()
+			if len(technicalID) == 0 {
+				technicalID = accessor.GetName()
+			}
+
+			if !isCollectable(accessor, p.minimumAge) || technicalIDs.Has(technicalID) {
+				continue
+			}
+
+			garbage = append(garbage, Garbage{
+				Client: p.seedClient,
+				Object: item,
+				Reason: fmt.Sprintf("the Shoot %q does not exist anymore", technicalID),
+			})
+		}
+	}
+	return garbage, nil
+}
+
+type staleManagedResources struct {
+	seedClient client.Client
+	minimumAge time.Duration
+}
+
+// NewStaleManagedResourcesPolicy returns a policy which considers the ManagedResources in the Seed cluster garbage
+// whose referenced secrets do not exist anymore.
+func NewStaleManagedResourcesPolicy(seedClient client.Client, minimumAge time.Duration) Policy {
+	return &staleManagedResources{seedClient, minimumAge}
+}
+
+func (p *staleManagedResources) Name() string {
+	return PolicyStaleManagedResources
+}
+
+func (p *staleManagedResources) Garbage(ctx context.Context) ([]Garbage, error) {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := p.seedClient.List(ctx, managedResourceList); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var garbage []Garbage
+	for i := range managedResourceList.Items {
+		managedResource := &managedResourceList.Items[i]
+		if !isCollectable(managedResource, p.minimumAge) || len(managedResource.Spec.SecretRefs) == 0 {
+			continue
+		}
+
+		stale := true
+		for _, ref := range managedResource.Spec.SecretRefs {
+			if err := p.seedClient.Get(ctx, kutil.Key(managedResource.Namespace, ref.Name), &corev1.Secret{}); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			stale = false
+			break
+		}
+
+		if stale {
+			garbage = append(garbage, Garbage{
+				Client: p.seedClient,
+				Object: managedResource,
+				Reason: "none of the referenced secrets exists anymore",
+			})
+		}
+	}
+	return garbage, nil
+}
+
+type terraformerResources struct {
+	seedClient client.Client
+	minimumAge time.Duration
+}
+
+// NewTerraformerResourcesPolicy returns a policy which considers finished Terraformer jobs in the Seed cluster
+// garbage. It also considers Terraformer configurations garbage whose state is empty and which are not used by a job.
+func NewTerraformerResourcesPolicy(seedClient client.Client, minimumAge time.Duration) Policy {
+	return &terraformerResources{seedClient, minimumAge}
+}
+
+func (p *terraformerResources) Name() string {
+	return PolicyTerraformerResources
+}
+
+func (p *terraformerResources) Garbage(ctx context.Context) ([]Garbage, error) {
+	jobList := &batchv1.JobList{}
+	if err := p.seedClient.List(ctx, jobList); err != nil {
+		return nil, err
+	}
+
+	var (
+		garbage []Garbage
+		jobs    = sets.NewString()
+	)
+
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		if !strings.HasSuffix(job.Name, common.TerraformerJobSuffix) {
+			continue
+		}
+		jobs.Insert(job.Namespace + "/" + job.Name)
+
+		if !isCollectable(job, p.minimumAge) || !isJobFinished(job) {
+			continue
+		}
+
+		garbage = append(garbage, Garbage{
+			Client:        p.seedClient,
+			Object:        job,
+			Reason:        "the Terraformer job is finished",
+			DeleteOptions: []client.DeleteOptionFunc{client.PropagationPolicy(metav1.DeletePropagationBackground)},
+		})
+	}
+
+	configMapList := &corev1.ConfigMapList{}
+	if err := p.seedClient.List(ctx, configMapList); err != nil {
+		return nil, err
+	}
+
+	configMaps := make(map[string]*corev1.ConfigMap, len(configMapList.Items))
+	for i := range configMapList.Items {
+		configMap := &configMapList.Items[i]
+		configMaps[configMap.Namespace+"/"+configMap.Name] = configMap
+	}
+
+	for i := range configMapList.Items {
+		config := &configMapList.Items[i]
+		if !strings.HasSuffix(config.Name, common.TerraformerConfigSuffix) || !isCollectable(config, p.minimumAge) {
+			continue
+		}
+
+		prefix := config.Namespace + "/" + strings.TrimSuffix(config.Name, common.TerraformerConfigSuffix)
+		if jobs.Has(prefix + common.TerraformerJobSuffix) {
+			continue
+		}
+
+		state, ok := configMaps[prefix+common.TerraformerStateSuffix]
+		if ok && len(state.Data[terraformer.StateKey]) > 0 {
+			continue
+		}
+
+		reason := "the Terraform state is empty"
+		garbage = append(garbage, Garbage{Client: p.seedClient, Object: config, Reason: reason})
+		if ok {
+			garbage = append(garbage, Garbage{Client: p.seedClient, Object: state, Reason: reason})
+		}
+		garbage = append(garbage, Garbage{
+			Client: p.seedClient,
+			Object: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: config.Namespace, Name: strings.TrimSuffix(config.Name, common.TerraformerConfigSuffix) + common.TerraformerVariablesSuffix}},
+			Reason: reason,
+		})
+	}
+
+	return garbage, nil
+}
+
+// isJobFinished returns true if the given job has completed or failed.
+func isJobFinished(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+type orphanedBackupEntries struct {
+	gardenClient client.Client
+	shootLister  gardencorelisters.ShootLister
+	seedName     string
+	minimumAge   time.Duration
+}
+
+// NewOrphanedBackupEntriesPolicy returns a policy which considers the BackupEntries in the Garden cluster garbage which
+// are assigned to the given Seed and whose Shoot does not exist anymore. Deleting a BackupEntry does not immediately
+// delete the backup, it respects the deletion grace period of the BackupEntry controller.
+func NewOrphanedBackupEntriesPolicy(gardenClient client.Client, shootLister gardencorelisters.ShootLister, seedName string, minimumAge time.Duration) Policy {
+	return &orphanedBackupEntries{gardenClient, shootLister, seedName, minimumAge}
+}
+
+func (p *orphanedBackupEntries) Name() string {
+	return PolicyOrphanedBackupEntries
+}
+
+func (p *orphanedBackupEntries) Garbage(ctx context.Context) ([]Garbage, error) {
+	backupEntryList := &gardencorev1alpha1.BackupEntryList{}
+	if err := p.gardenClient.List(ctx, backupEntryList); err != nil {
+		return nil, err
+	}
+
+	var garbage []Garbage
+	for i := range backupEntryList.Items {
+		backupEntry := &backupEntryList.Items[i]
+		if backupEntry.Spec.Seed == nil || *backupEntry.Spec.Seed != p.seedName || !isCollectable(backupEntry, p.minimumAge) {
+			continue
+		}
+
+		shoots, err := p.shootLister.Shoots(backupEntry.Namespace).List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+
+		_, shootUID := common.ExtractShootDetailsFromBackupEntryName(backupEntry.Name)
+		exists := false
+		for _, shoot := range shoots {
+			if string(shoot.UID) == shootUID {
+				exists = true
+				break
+			}
+		}
+
+		if !exists {
+			garbage = append(garbage, Garbage{
+				Client: p.gardenClient,
+				Object: backupEntry,
+				Reason: "the Shoot of the BackupEntry does not exist anymore",
+			})
+		}
+	}
+	return garbage, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// defaultExcessCapacityShootPercentage is the default percentage of the hosted shoots for which capacity is reserved.
+	defaultExcessCapacityShootPercentage = 3
+	// defaultExcessCapacityMinShoots is the default minimum number of shoot control planes for which capacity is reserved.
+	defaultExcessCapacityMinShoots = 2
+)
+
+var (
+	// excessCapacityPodResources are the resources (requests and limits) of a single replica of the
+	// reserve-excess-capacity deployment.
+	excessCapacityPodResources = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("500m"),
+		corev1.ResourceMemory: resource.MustParse("1200Mi"),
+	}
+
+	// defaultShootControlPlaneResources are the resources assumed for a single shoot control plane if the seed does
+	// not host any shoot control plane yet.
+	defaultShootControlPlaneResources = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("4800Mi"),
+	}
+)
+
+// ObserveShootControlPlaneResources computes the average resources (CPU and memory) requested by the active pods of
+// the shoot control planes hosted by the seed. If the seed does not host any shoot control plane then default
+// resources are returned.
+func ObserveShootControlPlaneResources(ctx context.Context, c client.Client) (corev1.ResourceList, error) {
+	namespaceList := &corev1.NamespaceList{}
+	if err := c.List(ctx, namespaceList, client.MatchingLabels(map[string]string{v1alpha1constants.GardenRole: v1alpha1constants.GardenRoleShoot})); err != nil {
+		return nil, err
+	}
+	if len(namespaceList.Items) == 0 {
+		return defaultShootControlPlaneResources.DeepCopy(), nil
+	}
+
+	shootNamespaces := sets.NewString()
+	for _, namespace := range namespaceList.Items {
+		shootNamespaces.Insert(namespace.Name)
+	}
+
+	podList := &corev1.PodList{}
+	if err := c.List(ctx, podList); err != nil {
+		return nil, err
+	}
+
+	var (
+		cpu    = resource.NewQuantity(0, resource.DecimalSI)
+		memory = resource.NewQuantity(0, resource.BinarySI)
+	)
+
+	for _, pod := range podList.Items {
+		if !shootNamespaces.Has(pod.Namespace) || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		requests := podRequests(&pod)
+		cpu.Add(*requests.Cpu())
+		memory.Add(*requests.Memory())
+	}
+
+	count := int64(shootNamespaces.Len())
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewMilliQuantity(cpu.MilliValue()/count, resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(memory.Value()/count, resource.BinarySI),
+	}, nil
+}
+
+// DesiredExcessCapacity computes the resources (CPU and memory) which are required to deploy new shoot control planes
+// (on the seed) in terms of reserve-excess-capacity deployment replicas. The number of shoot control planes for which
+// capacity is reserved is the given percentage of the number of associated shoots, but at least the minimum and at
+// most the maximum number of shoots of the reservation policy. Each of them is assumed to request the given shoot
+// control plane resources. The absolute resources of the policy are reserved in addition. If no policy is given then
+// capacity for 3% of the associated shoots (but at least for 2 shoots) is reserved.
+// It returns the number of replicas and the resources which are reserved by them.
+func DesiredExcessCapacity(reservation *gardencorev1alpha1.SeedExcessCapacityReservation, numberOfAssociatedShoots int, shootControlPlaneResources corev1.ResourceList) (int, corev1.ResourceList) {
+	var (
+		percentage = int64(defaultExcessCapacityShootPercentage)
+		minShoots  = int64(defaultExcessCapacityMinShoots)
+		maxShoots  *int64
+		additional corev1.ResourceList
+	)
+
+	if reservation != nil {
+		if reservation.ShootPercentage != nil {
+			percentage = int64(*reservation.ShootPercentage)
+		}
+		if reservation.MinShoots != nil {
+			minShoots = int64(*reservation.MinShoots)
+		}
+		if reservation.MaxShoots != nil {
+			max := int64(*reservation.MaxShoots)
+			maxShoots = &max
+		}
+		additional = reservation.Resources
+	}
+
+	shoots := int64(numberOfAssociatedShoots) * percentage / 100
+	if shoots < minShoots {
+		shoots = minShoots
+	}
+	if maxShoots != nil && shoots > *maxShoots {
+		shoots = *maxShoots
+	}
+
+	replicas := int64(0)
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		var (
+			perShoot = shootControlPlaneResources[name]
+			extra    = additional[name]
+			podSize  = excessCapacityPodResources[name]
+			required = perShoot.MilliValue()*shoots + extra.MilliValue()
+		)
+
+		if r := (required + podSize.MilliValue() - 1) / podSize.MilliValue(); r > replicas {
+			replicas = r
+		}
+	}
+
+	reserved := corev1.ResourceList{}
+	for name, podSize := range excessCapacityPodResources {
+		reserved[name] = *resource.NewMilliQuantity(podSize.MilliValue()*replicas, podSize.Format)
+	}
+
+	return int(replicas), reserved
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed_test
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	. "github.com/gardener/gardener/pkg/operation/seed"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("excess capacity", func() {
+	var (
+		ctrl          *gomock.Controller
+		runtimeClient *mockclient.MockClient
+		ctx           = context.TODO()
+
+		defaultShootControlPlaneResources = corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse("4800Mi"),
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		runtimeClient = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#ObserveShootControlPlaneResources", func() {
+		It("should return the default resources if no shoot control plane is hosted", func() {
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.NamespaceList{}), gomock.Any())
+
+			resources, err := ObserveShootControlPlaneResources(ctx, runtimeClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources.Cpu().Cmp(resource.MustParse("2"))).To(Equal(0))
+			Expect(resources.Memory().Cmp(resource.MustParse("4800Mi"))).To(Equal(0))
+		})
+
+		It("should return the average resources requested by the shoot control planes", func() {
+			newPod := func(namespace string, phase corev1.PodPhase, cpu, memory string) corev1.Pod {
+				return corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu), corev1.ResourceMemory: resource.MustParse(memory)}}},
+						},
+					},
+					Status: corev1.PodStatus{Phase: phase},
+				}
+			}
+
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.NamespaceList{}), gomock.Any()).DoAndReturn(func(_ context.Context, list runtime.Object, _ ...client.ListOptionFunc) error {
+				list.(*corev1.NamespaceList).Items = []corev1.Namespace{
+					{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--baz"}},
+				}
+				return nil
+			})
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.PodList{})).DoAndReturn(func(_ context.Context, list runtime.Object, _ ...client.ListOptionFunc) error {
+				list.(*corev1.PodList).Items = []corev1.Pod{
+					newPod("shoot--foo--bar", corev1.PodRunning, "1", "2Gi"),
+					newPod("shoot--foo--bar", corev1.PodRunning, "500m", "1Gi"),
+					newPod("shoot--foo--baz", corev1.PodRunning, "1500m", "3Gi"),
+					newPod("shoot--foo--baz", corev1.PodSucceeded, "10", "10Gi"),
+					newPod("garden", corev1.PodRunning, "10", "10Gi"),
+				}
+				return nil
+			})
+
+			resources, err := ObserveShootControlPlaneResources(ctx, runtimeClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources.Cpu().Cmp(resource.MustParse("1500m"))).To(Equal(0))
+			Expect(resources.Memory().Cmp(resource.MustParse("3Gi"))).To(Equal(0))
+		})
+	})
+
+	Describe("#DesiredExcessCapacity", func() {
+		var (
+			int32Ptr = func(i int32) *int32 { return &i }
+		)
+
+		DescribeTable("should compute the desired excess capacity",
+			func(reservation *gardencorev1alpha1.SeedExcessCapacityReservation, numberOfAssociatedShoots int, shootControlPlaneResources corev1.ResourceList, expectedReplicas int, expectedCPU, expectedMemory string) {
+				replicas, reserved := DesiredExcessCapacity(reservation, numberOfAssociatedShoots, shootControlPlaneResources)
+				Expect(replicas).To(Equal(expectedReplicas))
+				Expect(reserved.Cpu().Cmp(resource.MustParse(expectedCPU))).To(Equal(0))
+				Expect(reserved.Memory().Cmp(resource.MustParse(expectedMemory))).To(Equal(0))
+			},
+			Entry("default policy, few shoots", nil, 10, defaultShootControlPlaneResources, 8, "4", "9600Mi"),
+			Entry("default policy, many shoots", nil, 100, defaultShootControlPlaneResources, 12, "6", "14400Mi"),
+			Entry("custom percentage", &gardencorev1alpha1.SeedExcessCapacityReservation{ShootPercentage: int32Ptr(10)}, 100, defaultShootControlPlaneResources, 40, "20", "48000Mi"),
+			Entry("maximum number of shoots", &gardencorev1alpha1.SeedExcessCapacityReservation{ShootPercentage: int32Ptr(10), MaxShoots: int32Ptr(1)}, 100, defaultShootControlPlaneResources, 4, "2", "4800Mi"),
+			Entry("no shoots but absolute resources", &gardencorev1alpha1.SeedExcessCapacityReservation{MinShoots: int32Ptr(0), Resources: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2400Mi")}}, 10, defaultShootControlPlaneResources, 2, "1", "2400Mi"),
+			Entry("observed large control planes", nil, 10, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("6000Mi")}, 10, "5", "12000Mi"),
+		)
+	})
+})
@@ -0,0 +1,293 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// internalDNSProviderName is the name of the DNS provider for the internal domain which is deployed into each Shoot
+// namespace of the Seed cluster.
+const internalDNSProviderName = "internal"
+
+// requiredBootstrapComponents returns the names of the deployments, stateful sets and daemon sets which are deployed
+// into the garden namespace of the Seed cluster while bootstrapping it.
+func (s *Seed) requiredBootstrapComponents() (deployments, statefulSets, daemonSets sets.String) {
+	deployments = sets.NewString(
+		v1alpha1constants.DeploymentNameGardenerResourceManager,
+		"grafana",
+		"vpa-admission-controller",
+		"vpa-exporter",
+		"vpa-recommender",
+		"vpa-updater",
+	)
+	statefulSets = sets.NewString(
+		v1alpha1constants.StatefulSetNameAlertManager,
+		v1alpha1constants.StatefulSetNamePrometheus,
+		"aggregate-prometheus",
+	)
+	daemonSets = sets.NewString()
+
+	if s.reserveExcessCapacity {
+		deployments.Insert("reserve-excess-capacity")
+	}
+	if controllermanagerfeatures.FeatureGate.Enabled(features.HVPA) {
+		deployments.Insert("hvpa-controller")
+	}
+	if controllermanagerfeatures.FeatureGate.Enabled(features.Logging) {
+		deployments.Insert(v1alpha1constants.DeploymentNameKibana)
+		statefulSets.Insert(v1alpha1constants.StatefulSetNameElasticSearch, "fluentd-es")
+		daemonSets.Insert("fluent-bit")
+	}
+
+	return
+}
+
+// CheckBootstrapComponents checks whether the components deployed into the garden namespace of the Seed cluster while
+// bootstrapping it exist and are healthy.
+func (s *Seed) CheckBootstrapComponents(ctx context.Context, c client.Client, condition gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
+	requiredDeployments, requiredStatefulSets, requiredDaemonSets := s.requiredBootstrapComponents()
+
+	deploymentList := &appsv1.DeploymentList{}
+	if err := c.List(ctx, deploymentList, client.InNamespace(v1alpha1constants.GardenNamespace)); err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+	}
+	statefulSetList := &appsv1.StatefulSetList{}
+	if err := c.List(ctx, statefulSetList, client.InNamespace(v1alpha1constants.GardenNamespace)); err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+	}
+	daemonSetList := &appsv1.DaemonSetList{}
+	if err := c.List(ctx, daemonSetList, client.InNamespace(v1alpha1constants.GardenNamespace)); err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+	}
+
+	var (
+		deployments  []*appsv1.Deployment
+		statefulSets []*appsv1.StatefulSet
+		daemonSets   []*appsv1.DaemonSet
+	)
+
+	actualNames := sets.NewString()
+	for i, deployment := range deploymentList.Items {
+		if requiredDeployments.Has(deployment.Name) {
+			actualNames.Insert(deployment.Name)
+			deployments = append(deployments, &deploymentList.Items[i])
+		}
+	}
+	if missingNames := requiredDeployments.Difference(actualNames); missingNames.Len() != 0 {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "DeploymentMissing", fmt.Sprintf("Missing required deployments: %v", missingNames.List()))
+	}
+
+	actualNames = sets.NewString()
+	for i, statefulSet := range statefulSetList.Items {
+		if requiredStatefulSets.Has(statefulSet.Name) {
+			actualNames.Insert(statefulSet.Name)
+			statefulSets = append(statefulSets, &statefulSetList.Items[i])
+		}
+	}
+	if missingNames := requiredStatefulSets.Difference(actualNames); missingNames.Len() != 0 {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "StatefulSetMissing", fmt.Sprintf("Missing required stateful sets: %v", missingNames.List()))
+	}
+
+	actualNames = sets.NewString()
+	for i, daemonSet := range daemonSetList.Items {
+		if requiredDaemonSets.Has(daemonSet.Name) {
+			actualNames.Insert(daemonSet.Name)
+			daemonSets = append(daemonSets, &daemonSetList.Items[i])
+		}
+	}
+	if missingNames := requiredDaemonSets.Difference(actualNames); missingNames.Len() != 0 {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "DaemonSetMissing", fmt.Sprintf("Missing required daemon sets: %v", missingNames.List()))
+	}
+
+	for _, deployment := range deployments {
+		if err := health.CheckDeployment(deployment); err != nil {
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "DeploymentUnhealthy", fmt.Sprintf("Deployment %s is unhealthy: %v", deployment.Name, err))
+		}
+	}
+	for _, statefulSet := range statefulSets {
+		if err := health.CheckStatefulSet(statefulSet); err != nil {
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "StatefulSetUnhealthy", fmt.Sprintf("Stateful set %s is unhealthy: %v", statefulSet.Name, err))
+		}
+	}
+	for _, daemonSet := range daemonSets {
+		if err := health.CheckDaemonSet(daemonSet); err != nil {
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "DaemonSetUnhealthy", fmt.Sprintf("Daemon set %s is unhealthy: %v", daemonSet.Name, err))
+		}
+	}
+
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "BootstrapComponentsRunning", "All bootstrap components are healthy.")
+}
+
+// CheckAPIServerAvailability checks if the API server of the Seed cluster is reachable and measures the response time.
+func CheckAPIServerAvailability(condition gardencorev1alpha1.Condition, restClient rest.Interface) gardencorev1alpha1.Condition {
+	return health.CheckAPIServerAvailability(condition, restClient, func(conditionType, message string) gardencorev1alpha1.Condition {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, conditionType, message)
+	})
+}
+
+// CheckDNSProviders checks whether the DNS providers for the internal domains of the Shoots hosted by the Seed cluster
+// are ready. DNS providers for external domains are not considered as they use end-user credentials.
+func CheckDNSProviders(ctx context.Context, c client.Client, condition gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
+	providerList := &dnsv1alpha1.DNSProviderList{}
+	if err := c.List(ctx, providerList); err != nil {
+		if meta.IsNoMatchError(err) {
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "NoDNSProviders", "No DNS providers are deployed to the Seed cluster.")
+		}
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+	}
+
+	for _, provider := range providerList.Items {
+		if provider.Name != internalDNSProviderName || provider.DeletionTimestamp != nil {
+			continue
+		}
+
+		if provider.Status.State != dnsv1alpha1.STATE_READY {
+			message := fmt.Sprintf("DNS provider %s/%s is not ready (state=%s)", provider.Namespace, provider.Name, provider.Status.State)
+			if provider.Status.Message != nil {
+				message = fmt.Sprintf("%s: %s", message, *provider.Status.Message)
+			}
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "DNSProviderNotReady", message)
+		}
+	}
+
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "DNSProvidersReady", "All DNS providers are ready.")
+}
+
+// CheckExtensions checks whether all given ControllerInstallations for the Seed are valid and installed. If a
+// ControllerInstallation reports the Healthy condition then it must be healthy as well.
+func CheckExtensions(condition gardencorev1alpha1.Condition, controllerInstallations []*gardencorev1alpha1.ControllerInstallation) gardencorev1alpha1.Condition {
+	for _, controllerInstallation := range controllerInstallations {
+		for _, conditionType := range []gardencorev1alpha1.ConditionType{gardencorev1alpha1.ControllerInstallationValid, gardencorev1alpha1.ControllerInstallationInstalled} {
+			c := gardencorev1alpha1helper.GetCondition(controllerInstallation.Status.Conditions, conditionType)
+			if c == nil {
+				return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "ExtensionNotReady", fmt.Sprintf("ControllerInstallation %s has not yet reported condition %s", controllerInstallation.Name, conditionType))
+			}
+			if c.Status != gardencorev1alpha1.ConditionTrue {
+				return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "ExtensionNotReady", fmt.Sprintf("ControllerInstallation %s has condition %s=%s: %s", controllerInstallation.Name, conditionType, c.Status, c.Message))
+			}
+		}
+
+		if c := gardencorev1alpha1helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1alpha1.ControllerInstallationHealthy); c != nil && c.Status != gardencorev1alpha1.ConditionTrue {
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "ExtensionUnhealthy", fmt.Sprintf("ControllerInstallation %s has condition %s=%s: %s", controllerInstallation.Name, gardencorev1alpha1.ControllerInstallationHealthy, c.Status, c.Message))
+		}
+	}
+
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "ExtensionsReady", fmt.Sprintf("All %d extensions are installed and ready.", len(controllerInstallations)))
+}
+
+// ComputeCapacity computes the allocatable and the used resources of the Seed cluster. The allocatable resources
+// consider the ready and schedulable nodes only. The used resources are the requests of all active and scheduled pods
+// as well as the given number of Shoots hosted by the Seed.
+func ComputeCapacity(ctx context.Context, c client.Client, numberOfAssociatedShoots int) (allocatable corev1.ResourceList, used corev1.ResourceList, err error) {
+	nodeList := &corev1.NodeList{}
+	if err := c.List(ctx, nodeList); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		nodes             int64
+		allocatableCPU    = resource.NewQuantity(0, resource.DecimalSI)
+		allocatableMemory = resource.NewQuantity(0, resource.BinarySI)
+	)
+
+	for _, node := range nodeList.Items {
+		if node.Spec.Unschedulable || health.CheckNode(&node) != nil {
+			continue
+		}
+
+		nodes++
+		allocatableCPU.Add(*node.Status.Allocatable.Cpu())
+		allocatableMemory.Add(*node.Status.Allocatable.Memory())
+	}
+
+	podList := &corev1.PodList{}
+	if err := c.List(ctx, podList); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		usedCPU    = resource.NewQuantity(0, resource.DecimalSI)
+		usedMemory = resource.NewQuantity(0, resource.BinarySI)
+	)
+
+	for _, pod := range podList.Items {
+		if len(pod.Spec.NodeName) == 0 || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		requests := podRequests(&pod)
+		usedCPU.Add(*requests.Cpu())
+		usedMemory.Add(*requests.Memory())
+	}
+
+	allocatable = corev1.ResourceList{
+		gardencorev1alpha1.ResourceNodes: *resource.NewQuantity(nodes, resource.DecimalSI),
+		corev1.ResourceCPU:               *allocatableCPU,
+		corev1.ResourceMemory:            *allocatableMemory,
+	}
+	used = corev1.ResourceList{
+		gardencorev1alpha1.ResourceShoots: *resource.NewQuantity(int64(numberOfAssociatedShoots), resource.DecimalSI),
+		corev1.ResourceCPU:                *usedCPU,
+		corev1.ResourceMemory:             *usedMemory,
+	}
+	return allocatable, used, nil
+}
+
+// podRequests computes the effective CPU and memory requests of the given pod, i.e. the maximum of the sum of the
+// requests of all containers and the requests of each init container.
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewQuantity(0, resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(0, resource.BinarySI),
+	}
+
+	for _, container := range pod.Spec.Containers {
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			if quantity, ok := container.Resources.Requests[name]; ok {
+				sum := requests[name]
+				sum.Add(quantity)
+				requests[name] = sum
+			}
+		}
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			if quantity, ok := container.Resources.Requests[name]; ok && quantity.Cmp(requests[name]) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+
+	return requests
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed_test
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	. "github.com/gardener/gardener/pkg/operation/seed"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("health", func() {
+	var (
+		ctrl          *gomock.Controller
+		runtimeClient *mockclient.MockClient
+		ctx           = context.TODO()
+		condition     = gardencorev1alpha1.Condition{Type: "Foo", Status: gardencorev1alpha1.ConditionUnknown}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		runtimeClient = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#CheckBootstrapComponents", func() {
+		It("should report missing bootstrap components", func() {
+			seed := &Seed{Info: &gardencorev1alpha1.Seed{}}
+
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&appsv1.DeploymentList{}), gomock.Any()).DoAndReturn(func(_ context.Context, list *appsv1.DeploymentList, _ ...client.ListOptionFunc) error {
+				list.Items = []appsv1.Deployment{{ObjectMeta: metav1.ObjectMeta{Name: "grafana", Generation: 1}, Status: appsv1.DeploymentStatus{ObservedGeneration: 1}}}
+				return nil
+			})
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&appsv1.StatefulSetList{}), gomock.Any())
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&appsv1.DaemonSetList{}), gomock.Any())
+
+			result := seed.CheckBootstrapComponents(ctx, runtimeClient, condition)
+			Expect(result.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(result.Reason).To(Equal("DeploymentMissing"))
+			Expect(result.Message).To(ContainSubstring("gardener-resource-manager"))
+			Expect(result.Message).NotTo(ContainSubstring("grafana"))
+		})
+	})
+
+	Describe("#CheckDNSProviders", func() {
+		var message = "error"
+
+		DescribeTable("should compute the condition",
+			func(listErr error, providers []dnsv1alpha1.DNSProvider, expectedStatus gardencorev1alpha1.ConditionStatus, expectedReason string) {
+				runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&dnsv1alpha1.DNSProviderList{})).DoAndReturn(func(_ context.Context, list *dnsv1alpha1.DNSProviderList, _ ...client.ListOptionFunc) error {
+					list.Items = providers
+					return listErr
+				})
+
+				result := CheckDNSProviders(ctx, runtimeClient, condition)
+				Expect(result.Status).To(Equal(expectedStatus))
+				Expect(result.Reason).To(Equal(expectedReason))
+			},
+			Entry("no DNS provider resource", &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "dns.gardener.cloud", Kind: "DNSProvider"}}, nil, gardencorev1alpha1.ConditionTrue, "NoDNSProviders"),
+			Entry("all internal providers ready", nil, []dnsv1alpha1.DNSProvider{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "internal"}, Status: dnsv1alpha1.DNSProviderStatus{State: dnsv1alpha1.STATE_READY}},
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "external"}, Status: dnsv1alpha1.DNSProviderStatus{State: dnsv1alpha1.STATE_ERROR}},
+			}, gardencorev1alpha1.ConditionTrue, "DNSProvidersReady"),
+			Entry("internal provider not ready", nil, []dnsv1alpha1.DNSProvider{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "internal"}, Status: dnsv1alpha1.DNSProviderStatus{State: dnsv1alpha1.STATE_ERROR, Message: &message}},
+			}, gardencorev1alpha1.ConditionFalse, "DNSProviderNotReady"),
+		)
+	})
+
+	Describe("#CheckExtensions", func() {
+		newControllerInstallation := func(valid, installed gardencorev1alpha1.ConditionStatus) *gardencorev1alpha1.ControllerInstallation {
+			return &gardencorev1alpha1.ControllerInstallation{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Status: gardencorev1alpha1.ControllerInstallationStatus{
+					Conditions: []gardencorev1alpha1.Condition{
+						{Type: gardencorev1alpha1.ControllerInstallationValid, Status: valid},
+						{Type: gardencorev1alpha1.ControllerInstallationInstalled, Status: installed},
+					},
+				},
+			}
+		}
+		withHealthy := func(controllerInstallation *gardencorev1alpha1.ControllerInstallation, healthy gardencorev1alpha1.ConditionStatus) *gardencorev1alpha1.ControllerInstallation {
+			controllerInstallation.Status.Conditions = append(controllerInstallation.Status.Conditions, gardencorev1alpha1.Condition{Type: gardencorev1alpha1.ControllerInstallationHealthy, Status: healthy})
+			return controllerInstallation
+		}
+
+		DescribeTable("should compute the condition",
+			func(controllerInstallations []*gardencorev1alpha1.ControllerInstallation, expectedStatus gardencorev1alpha1.ConditionStatus) {
+				Expect(CheckExtensions(condition, controllerInstallations).Status).To(Equal(expectedStatus))
+			},
+			Entry("no extensions", nil, gardencorev1alpha1.ConditionTrue),
+			Entry("all extensions ready", []*gardencorev1alpha1.ControllerInstallation{newControllerInstallation(gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue)}, gardencorev1alpha1.ConditionTrue),
+			Entry("extension not installed", []*gardencorev1alpha1.ControllerInstallation{newControllerInstallation(gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionFalse)}, gardencorev1alpha1.ConditionFalse),
+			Entry("extension without conditions", []*gardencorev1alpha1.ControllerInstallation{{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}}, gardencorev1alpha1.ConditionFalse),
+			Entry("extension healthy", []*gardencorev1alpha1.ControllerInstallation{withHealthy(newControllerInstallation(gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue), gardencorev1alpha1.ConditionTrue)}, gardencorev1alpha1.ConditionTrue),
+			Entry("extension unhealthy", []*gardencorev1alpha1.ControllerInstallation{withHealthy(newControllerInstallation(gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue), gardencorev1alpha1.ConditionFalse)}, gardencorev1alpha1.ConditionFalse),
+		)
+	})
+
+	Describe("#ComputeCapacity", func() {
+		newNode := func(name string, ready bool, unschedulable bool) corev1.Node {
+			status := corev1.ConditionFalse
+			if ready {
+				status = corev1.ConditionTrue
+			}
+			return corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       corev1.NodeSpec{Unschedulable: unschedulable},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("8Gi"),
+					},
+				},
+			}
+		}
+
+		newPod := func(nodeName string, phase corev1.PodPhase, cpu string, initCPU string) corev1.Pod {
+			pod := corev1.Pod{
+				Spec: corev1.PodSpec{
+					NodeName: nodeName,
+					Containers: []corev1.Container{
+						{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu), corev1.ResourceMemory: resource.MustParse("1Gi")}}},
+						{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)}}},
+					},
+				},
+				Status: corev1.PodStatus{Phase: phase},
+			}
+			if len(initCPU) > 0 {
+				pod.Spec.InitContainers = []corev1.Container{{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(initCPU)}}}}
+			}
+			return pod
+		}
+
+		It("should compute the allocatable and used resources", func() {
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.NodeList{})).DoAndReturn(func(_ context.Context, list runtime.Object, _ ...client.ListOptionFunc) error {
+				list.(*corev1.NodeList).Items = []corev1.Node{
+					newNode("ready", true, false),
+					newNode("ready-2", true, false),
+					newNode("not-ready", false, false),
+					newNode("cordoned", true, true),
+				}
+				return nil
+			})
+			runtimeClient.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.PodList{})).DoAndReturn(func(_ context.Context, list runtime.Object, _ ...client.ListOptionFunc) error {
+				list.(*corev1.PodList).Items = []corev1.Pod{
+					newPod("ready", corev1.PodRunning, "100m", ""),
+					newPod("ready", corev1.PodRunning, "100m", "500m"),
+					newPod("ready", corev1.PodSucceeded, "1", ""),
+					newPod("", corev1.PodPending, "1", ""),
+				}
+				return nil
+			})
+
+			allocatable, used, err := ComputeCapacity(ctx, runtimeClient, 3)
+			Expect(err).NotTo(HaveOccurred())
+
+			nodes := allocatable[gardencorev1alpha1.ResourceNodes]
+			Expect(nodes.Value()).To(Equal(int64(2)))
+			Expect(allocatable.Cpu().Cmp(resource.MustParse("4"))).To(Equal(0))
+			Expect(allocatable.Memory().Cmp(resource.MustParse("16Gi"))).To(Equal(0))
+
+			shoots := used[gardencorev1alpha1.ResourceShoots]
+			Expect(shoots.Value()).To(Equal(int64(3)))
+			Expect(used.Cpu().Cmp(resource.MustParse("700m"))).To(Equal(0))
+			Expect(used.Memory().Cmp(resource.MustParse("2Gi"))).To(Equal(0))
+		})
+	})
+})
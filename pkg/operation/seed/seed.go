@@ -420,6 +420,16 @@ func BootstrapCluster(seed *Seed, config *config.ControllerManagerConfiguration,
 	}
 	nodeCount := len(nodes.Items)
 
+	excessCapacityReplicas := 0
+	seed.reservedExcessCapacity = nil
+	if seed.reserveExcessCapacity {
+		shootControlPlaneResources, err := ObserveShootControlPlaneResources(context.TODO(), k8sSeedClient.Client())
+		if err != nil {
+			return err
+		}
+		excessCapacityReplicas, seed.reservedExcessCapacity = DesiredExcessCapacity(seed.Info.Spec.ExcessCapacityReservation, numberOfAssociatedShoots, shootControlPlaneResources)
+	}
+
 	chartRenderer, err := chartrenderer.NewForConfig(k8sSeedClient.RESTConfig())
 	if err != nil {
 		return err
@@ -467,8 +477,12 @@ func BootstrapCluster(seed *Seed, config *config.ControllerManagerConfiguration,
 			"images": chart.ImageMapToValues(images),
 		},
 		"reserveExcessCapacity": seed.reserveExcessCapacity,
+		"reserveExcessCapacityResources": map[string]interface{}{
+			"cpu":    excessCapacityPodResources.Cpu().String(),
+			"memory": excessCapacityPodResources.Memory().String(),
+		},
 		"replicas": map[string]interface{}{
-			"reserve-excess-capacity": DesiredExcessCapacity(numberOfAssociatedShoots),
+			"reserve-excess-capacity": excessCapacityReplicas,
 		},
 		"prometheus": map[string]interface{}{
 			"storage": seed.GetValidVolumeSize("10Gi"),
@@ -543,27 +557,6 @@ func BootstrapCluster(seed *Seed, config *config.ControllerManagerConfiguration,
 	}, applierOptions)
 }
 
-// DesiredExcessCapacity computes the required resources (CPU and memory) required to deploy new shoot control planes
-// (on the seed) in terms of reserve-excess-capacity deployment replicas. Each deployment replica currently
-// corresponds to resources of (request/limits) 500m of CPU and 1200Mi of Memory.
-// ReplicasRequiredToSupportSingleShoot is 4 which is 2000m of CPU and 4800Mi of RAM.
-// The logic for computation of desired excess capacity corresponds to either deploying 2 new shoot control planes
-// or 3% of existing shoot control planes of current number of shoots deployed in seed (3 if current shoots are 100),
-// whichever of the two is larger
-func DesiredExcessCapacity(numberOfAssociatedShoots int) int {
-	var (
-		replicasToSupportSingleShoot          = 4
-		effectiveExcessCapacity               = 2
-		excessCapacityBasedOnAssociatedShoots = int(float64(numberOfAssociatedShoots) * 0.03)
-	)
-
-	if excessCapacityBasedOnAssociatedShoots > effectiveExcessCapacity {
-		effectiveExcessCapacity = excessCapacityBasedOnAssociatedShoots
-	}
-
-	return effectiveExcessCapacity * replicasToSupportSingleShoot
-}
-
 // GetFluentdReplicaCount returns fluentd stateful set replica count if it exists, otherwise - the default (1).
 // As fluentd HPA manages the number of replicas, we have to make sure to do not override HPA scaling.
 func GetFluentdReplicaCount(k8sSeedClient kubernetes.Interface) (int32, error) {
@@ -626,6 +619,12 @@ func (s *Seed) MustReserveExcessCapacity(must bool) {
 	s.reserveExcessCapacity = must
 }
 
+// ReservedExcessCapacity returns the resources which have been reserved for new shoot control planes in the Seed
+// cluster during the last bootstrapping.
+func (s *Seed) ReservedExcessCapacity() corev1.ResourceList {
+	return s.reservedExcessCapacity
+}
+
 // GetValidVolumeSize is to get a valid volume size.
 // If the given size is smaller than the minimum volume size permitted by cloud provider on which seed cluster is running, it will return the minimum size.
 func (s *Seed) GetValidVolumeSize(size string) string {
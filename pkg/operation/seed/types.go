@@ -24,5 +24,6 @@ type Seed struct {
 	Info   *gardencorev1alpha1.Seed
 	Secret *corev1.Secret
 
-	reserveExcessCapacity bool
+	reserveExcessCapacity  bool
+	reservedExcessCapacity corev1.ResourceList
 }
@@ -58,6 +58,13 @@ func (t *Terraformer) SetActiveDeadlineSeconds(adl int64) *Terraformer {
 	return t
 }
 
+// SetStateHistoryLimit sets the number of previous Terraform state versions which are kept in ConfigMaps. A limit of
+// zero disables the state history, which is the default.
+func (t *Terraformer) SetStateHistoryLimit(limit int) *Terraformer {
+	t.stateHistoryLimit = limit
+	return t
+}
+
 // SetDeadlineCleaning configures the deadline while waiting for a clean environment.
 func (t *Terraformer) SetDeadlineCleaning(d time.Duration) *Terraformer {
 	t.deadlineCleaning = d
@@ -253,7 +260,7 @@ func (t *Terraformer) CleanupConfiguration(ctx context.Context) error {
 		return err
 	}
 
-	return nil
+	return t.cleanupStateHistory(ctx)
 }
 
 // ensureCleanedUp deletes the job, pods, and waits until everything has been cleaned up.
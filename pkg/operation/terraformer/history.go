@@ -0,0 +1,183 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LabelStateHistory is the label which is set on the ConfigMaps containing previous versions of a Terraform state.
+const LabelStateHistory = "terraformer.gardener.cloud/state-history"
+
+// StateRevision is a previous version of the Terraform state.
+type StateRevision struct {
+	// Revision is the number of the revision. Higher numbers denote newer revisions.
+	Revision int
+	// CreationTimestamp is the time at which the revision has been backed up.
+	CreationTimestamp metav1.Time
+	// State is the Terraform state.
+	State []byte
+}
+
+func (t *Terraformer) stateHistoryName(revision int) string {
+	return fmt.Sprintf("%s.%d", t.stateName, revision)
+}
+
+type stateHistoryEntry struct {
+	configMap corev1.ConfigMap
+	revision  int
+}
+
+// listStateHistory returns the ConfigMaps containing the previous versions of the Terraform state, the newest first.
+func (t *Terraformer) listStateHistory(ctx context.Context) ([]stateHistoryEntry, error) {
+	configMapList := &corev1.ConfigMapList{}
+	if err := t.client.List(ctx, configMapList, client.InNamespace(t.namespace), client.MatchingLabels(map[string]string{LabelStateHistory: "true"})); err != nil {
+		return nil, err
+	}
+
+	var (
+		prefix  = t.stateName + "."
+		entries []stateHistoryEntry
+	)
+	for _, configMap := range configMapList.Items {
+		if !strings.HasPrefix(configMap.Name, prefix) {
+			continue
+		}
+		revision, err := strconv.Atoi(strings.TrimPrefix(configMap.Name, prefix))
+		if err != nil {
+			continue
+		}
+		entries = append(entries, stateHistoryEntry{configMap, revision})
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].revision > entries[j].revision })
+	return entries, nil
+}
+
+// GetStateHistory returns the previous versions of the Terraform state, the newest first.
+func (t *Terraformer) GetStateHistory() ([]StateRevision, error) {
+	entries, err := t.listStateHistory(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]StateRevision, 0, len(entries))
+	for _, entry := range entries {
+		history = append(history, StateRevision{
+			Revision:          entry.revision,
+			CreationTimestamp: entry.configMap.CreationTimestamp,
+			State:             []byte(entry.configMap.Data[StateKey]),
+		})
+	}
+	return history, nil
+}
+
+// backupState stores the current Terraform state as a new revision of the state history unless it is empty or equal
+// to the newest revision. Revisions exceeding the history limit are deleted.
+func (t *Terraformer) backupState(ctx context.Context) error {
+	if t.stateHistoryLimit <= 0 {
+		return nil
+	}
+
+	state, err := t.GetState()
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if len(state) == 0 {
+		return nil
+	}
+
+	entries, err := t.listStateHistory(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 || entries[0].configMap.Data[StateKey] != string(state) {
+		revision := 1
+		if len(entries) > 0 {
+			revision = entries[0].revision + 1
+		}
+
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: t.namespace,
+				Name:      t.stateHistoryName(revision),
+				Labels:    map[string]string{LabelStateHistory: "true"},
+			},
+			Data: map[string]string{StateKey: string(state)},
+		}
+		if err := t.client.Create(ctx, configMap); err != nil {
+			return err
+		}
+		t.logger.Debugf("Backed up Terraform state to ConfigMap '%s'", configMap.Name)
+
+		entries = append([]stateHistoryEntry{{*configMap, revision}}, entries...)
+	}
+
+	for i := t.stateHistoryLimit; i < len(entries); i++ {
+		if err := t.client.Delete(ctx, &entries[i].configMap); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreState replaces the current Terraform state with the given revision of the state history. If the state history
+// is enabled, the current state is backed up before, so that it can be restored as well.
+func (t *Terraformer) RestoreState(revision int) error {
+	ctx := context.TODO()
+
+	history := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, kutil.Key(t.namespace, t.stateHistoryName(revision)), history); err != nil {
+		return fmt.Errorf("could not get revision %d of the Terraform state: %v", revision, err)
+	}
+
+	if err := t.backupState(ctx); err != nil {
+		return err
+	}
+
+	t.logger.Infof("Restoring Terraform state '%s' from revision %d", t.stateName, revision)
+	_, err := createOrUpdateConfigMap(ctx, t.client, t.namespace, t.stateName, map[string]string{StateKey: history.Data[StateKey]})
+	return err
+}
+
+func (t *Terraformer) cleanupStateHistory(ctx context.Context) error {
+	entries, err := t.listStateHistory(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range entries {
+		t.logger.Debugf("Deleting Terraform state history ConfigMap '%s'", entries[i].configMap.Name)
+		if err := t.client.Delete(ctx, &entries[i].configMap); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"io/ioutil"
+
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("State history", func() {
+	const (
+		namespace = "namespace"
+		stateName = "shoot.infra.tf-state"
+	)
+
+	var (
+		ctx = context.TODO()
+		c   client.Client
+		tf  *Terraformer
+	)
+
+	setState := func(state string) {
+		_, err := createOrUpdateConfigMap(ctx, c, namespace, stateName, map[string]string{StateKey: state})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	getState := func() string {
+		state, err := tf.GetState()
+		Expect(err).NotTo(HaveOccurred())
+		return string(state)
+	}
+
+	historyStates := func() []string {
+		history, err := tf.GetStateHistory()
+		Expect(err).NotTo(HaveOccurred())
+
+		var states []string
+		for _, revision := range history {
+			states = append(states, string(revision.State))
+		}
+		return states
+	}
+
+	BeforeEach(func() {
+		log := logrus.New()
+		log.Out = ioutil.Discard
+
+		c = fake.NewFakeClient()
+		tf = New(log, c, nil, "infra", namespace, "shoot", "image").SetStateHistoryLimit(3)
+	})
+
+	Describe("#backupState", func() {
+		It("should not back up an empty state", func() {
+			Expect(tf.backupState(ctx)).To(Succeed())
+			setState("")
+			Expect(tf.backupState(ctx)).To(Succeed())
+
+			Expect(historyStates()).To(BeEmpty())
+		})
+
+		It("should back up changed states only", func() {
+			setState("state-1")
+			Expect(tf.backupState(ctx)).To(Succeed())
+			Expect(tf.backupState(ctx)).To(Succeed())
+			setState("state-2")
+			Expect(tf.backupState(ctx)).To(Succeed())
+
+			Expect(historyStates()).To(Equal([]string{"state-2", "state-1"}))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, kutil.Key(namespace, stateName+".2"), configMap)).To(Succeed())
+			Expect(configMap.Labels).To(HaveKeyWithValue(LabelStateHistory, "true"))
+		})
+
+		It("should delete the revisions exceeding the history limit", func() {
+			tf.SetStateHistoryLimit(2)
+			for _, state := range []string{"state-1", "state-2", "state-3"} {
+				setState(state)
+				Expect(tf.backupState(ctx)).To(Succeed())
+			}
+
+			history, err := tf.GetStateHistory()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(2))
+			Expect(history[0].Revision).To(Equal(3))
+			Expect(history[1].Revision).To(Equal(2))
+		})
+
+		It("should not back up the state if the history is disabled", func() {
+			tf.SetStateHistoryLimit(0)
+			setState("state-1")
+			Expect(tf.backupState(ctx)).To(Succeed())
+
+			Expect(historyStates()).To(BeEmpty())
+		})
+
+		It("should not back up the state by default", func() {
+			tf = New(logrus.New(), c, nil, "infra", namespace, "shoot", "image")
+			setState("state-1")
+			Expect(tf.backupState(ctx)).To(Succeed())
+
+			Expect(historyStates()).To(BeEmpty())
+		})
+
+		It("should ignore the history of other states", func() {
+			Expect(c.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "shoot.dns.tf-state.1", Labels: map[string]string{LabelStateHistory: "true"}},
+				Data:       map[string]string{StateKey: "other"},
+			})).To(Succeed())
+
+			setState("state-1")
+			Expect(tf.backupState(ctx)).To(Succeed())
+
+			history, err := tf.GetStateHistory()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].Revision).To(Equal(1))
+		})
+	})
+
+	Describe("#RestoreState", func() {
+		It("should restore the given revision and back up the current state", func() {
+			setState("state-1")
+			Expect(tf.backupState(ctx)).To(Succeed())
+			setState("corrupted")
+
+			Expect(tf.RestoreState(1)).To(Succeed())
+
+			Expect(getState()).To(Equal("state-1"))
+			Expect(historyStates()).To(Equal([]string{"corrupted", "state-1"}))
+		})
+
+		It("should fail if the revision does not exist", func() {
+			setState("state-1")
+
+			Expect(tf.RestoreState(1)).NotTo(Succeed())
+			Expect(getState()).To(Equal("state-1"))
+		})
+	})
+
+	Describe("#CleanupConfiguration", func() {
+		It("should delete the state history", func() {
+			setState("state-1")
+			Expect(tf.backupState(ctx)).To(Succeed())
+
+			Expect(tf.CleanupConfiguration(ctx)).To(Succeed())
+
+			Expect(historyStates()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,74 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PlanSummary is the summary of the changes which Terraform planned to perform.
+type PlanSummary struct {
+	// ToAdd is the number of resources which will be created.
+	ToAdd int
+	// ToChange is the number of resources which will be updated in-place.
+	ToChange int
+	// ToDestroy is the number of resources which will be deleted.
+	ToDestroy int
+}
+
+// HasChanges returns true if the plan contains any changes.
+func (p *PlanSummary) HasChanges() bool {
+	return p.ToAdd > 0 || p.ToChange > 0 || p.ToDestroy > 0
+}
+
+func (p *PlanSummary) String() string {
+	return fmt.Sprintf("%d to add, %d to change, %d to destroy", p.ToAdd, p.ToChange, p.ToDestroy)
+}
+
+// Result is the result of the last execution of the Terraformer.
+type Result struct {
+	// Plan is the summary of the changes planned by Terraform. It is nil if the plan could not be determined, e.g.
+	// because the validation was skipped or failed.
+	Plan *PlanSummary
+	// PlanOnly indicates whether only the plan was computed without executing the Terraform Job.
+	PlanOnly bool
+	// Succeeded indicates whether the execution was successful.
+	Succeeded bool
+	// Errors contains the errors which have been found in the logs of the Terraform Pods.
+	Errors []string
+}
+
+var (
+	regexPlanSummary = regexp.MustCompile(`Plan: (\d+) to add, (\d+) to change, (\d+) to destroy\.`)
+	regexNoChanges   = regexp.MustCompile(`No changes\. Infrastructure is up-to-date\.`)
+)
+
+// parsePlanSummary returns the summary of the plan which is printed in the given Terraform output, or nil if the
+// output does not contain a plan.
+func parsePlanSummary(output string) *PlanSummary {
+	if match := regexPlanSummary.FindStringSubmatch(output); len(match) == 4 {
+		toAdd, _ := strconv.Atoi(match[1])
+		toChange, _ := strconv.Atoi(match[2])
+		toDestroy, _ := strconv.Atoi(match[3])
+		return &PlanSummary{ToAdd: toAdd, ToChange: toChange, ToDestroy: toDestroy}
+	}
+	if regexNoChanges.MatchString(output) || strings.Contains(output, "No changes. Your infrastructure matches the configuration.") {
+		return &PlanSummary{}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Result", func() {
+	Describe("#parsePlanSummary", func() {
+		It("should parse the summary of a plan with changes", func() {
+			output := `aws_vpc.vpc: Refreshing state... [id=vpc-1234]
+
+An execution plan has been generated and is shown below.
+
+Plan: 3 to add, 1 to change, 2 to destroy.
+
+------------------------------------------------------------------------`
+
+			summary := parsePlanSummary(output)
+			Expect(summary).To(Equal(&PlanSummary{ToAdd: 3, ToChange: 1, ToDestroy: 2}))
+			Expect(summary.HasChanges()).To(BeTrue())
+			Expect(summary.String()).To(Equal("3 to add, 1 to change, 2 to destroy"))
+		})
+
+		It("should parse the summary of a plan without changes", func() {
+			output := `aws_vpc.vpc: Refreshing state... [id=vpc-1234]
+
+No changes. Infrastructure is up-to-date.`
+
+			summary := parsePlanSummary(output)
+			Expect(summary).To(Equal(&PlanSummary{}))
+			Expect(summary.HasChanges()).To(BeFalse())
+		})
+
+		It("should return nil if the output does not contain a plan", func() {
+			Expect(parsePlanSummary("Error: Invalid reference")).To(BeNil())
+		})
+	})
+})
@@ -116,6 +116,21 @@ func (t *Terraformer) Apply() error {
 	return t.execute(context.TODO(), "apply")
 }
 
+// Plan only runs the Terraform validation Pod which computes the plan of the 'terraform apply' command without
+// executing it. It returns the summary of the planned changes.
+func (t *Terraformer) Plan() (*PlanSummary, error) {
+	if !t.configurationDefined {
+		return nil, errors.New("Terraformer configuration has not been defined, cannot compute the Terraform plan")
+	}
+	if err := t.execute(context.TODO(), "plan"); err != nil {
+		return nil, err
+	}
+	if t.lastResult == nil || t.lastResult.Plan == nil {
+		return nil, fmt.Errorf("could not determine the plan of Terraform job '%s'", t.jobName)
+	}
+	return t.lastResult.Plan, nil
+}
+
 // Destroy executes the Terraform Job by running the 'terraform destroy' command.
 func (t *Terraformer) Destroy() error {
 	if err := t.execute(context.TODO(), "destroy"); err != nil {
@@ -124,8 +139,14 @@ func (t *Terraformer) Destroy() error {
 	return t.CleanupConfiguration(context.TODO())
 }
 
+// LastResult returns the result of the last execution of the Terraformer, or nil if it has not been executed yet.
+func (t *Terraformer) LastResult() *Result {
+	return t.lastResult
+}
+
 // execute creates a Terraform Job which runs the provided scriptName (apply or destroy), waits for the Job to be completed
-// (either successful or not), prints its logs, deletes it and returns whether it was successful or not.
+// (either successful or not), prints its logs, deletes it and returns whether it was successful or not. If scriptName
+// is 'plan', only the validation Pod is executed. The state is backed up before the Job is created.
 func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 	var (
 		exitCode  int32 = 1     // Exit code of the Terraform validation pod
@@ -133,8 +154,11 @@ func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 		execute         = false // Should we skip the rest of the function depending on whether all ConfigMaps/Secrets exist/do not exist?
 		skipPod         = false // Should we skip the execution of the Terraform Pod (validation of the Terraform config)?
 		skipJob         = false // Should we skip the execution of the Terraform Job (actual execution of the Terraform config)?
+		planOnly        = scriptName == "plan"
 	)
 
+	t.lastResult = nil
+
 	// We should retry the preparation check in order to allow the kube-apiserver to actually create the ConfigMaps.
 	if err := retry.UntilTimeout(ctx, 5*time.Second, 30*time.Second, func(ctx context.Context) (done bool, err error) {
 		numberOfExistingResources, err := t.prepare(ctx)
@@ -175,7 +199,7 @@ func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 
 		// Wait for the Terraform validation Pod to be completed
 		exitCode = t.waitForPod(ctx)
-		skipJob = exitCode == 0 || exitCode == 1
+		skipJob = exitCode == 0 || exitCode == 1 || planOnly
 
 		switch exitCode {
 		case 0:
@@ -189,6 +213,11 @@ func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 	}
 
 	if !skipJob {
+		// Back up the Terraform state in order to be able to recover from a state which got corrupted by the Job
+		if err := t.backupState(ctx); err != nil {
+			return fmt.Errorf("Failed to back up the Terraform state: %s", err.Error())
+		}
+
 		// Create Terraform Job which executes the provided scriptName
 		if err := t.deployTerraformerJob(ctx, scriptName); err != nil {
 			return fmt.Errorf("Failed to deploy the Terraformer: %s", err.Error())
@@ -224,10 +253,25 @@ func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 
 	// Evaluate whether the execution was successful or not
 	t.logger.Infof("Terraformer execution for job '%s' has been completed.", t.jobName)
+	t.lastResult = &Result{
+		PlanOnly:  planOnly,
+		Succeeded: succeeded,
+		Errors:    retrieveTerraformErrors(logList),
+	}
+	if !skipPod {
+		t.lastResult.Plan = parsePlanSummary(logList[t.podName])
+		if t.lastResult.Plan == nil && exitCode == 0 {
+			t.lastResult.Plan = &PlanSummary{}
+		}
+		if t.lastResult.Plan != nil {
+			t.logger.Infof("Terraform plan of job '%s': %s.", t.jobName, t.lastResult.Plan)
+		}
+	}
+
 	if !succeeded {
 		errorMessage := fmt.Sprintf("Terraform execution job '%s' could not be completed.", t.jobName)
-		if terraformErrors := retrieveTerraformErrors(logList); terraformErrors != nil {
-			errorMessage += fmt.Sprintf(" The following issues have been found in the logs:\n\n%s", strings.Join(terraformErrors, "\n\n"))
+		if t.lastResult.Errors != nil {
+			errorMessage += fmt.Sprintf(" The following issues have been found in the logs:\n\n%s", strings.Join(t.lastResult.Errors, "\n\n"))
 		}
 		return gardencorev1alpha1helper.DetermineError(errorMessage)
 	}
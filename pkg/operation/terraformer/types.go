@@ -36,6 +36,9 @@ import (
 //   with TF_VAR_).
 // * configurationDefined indicates whether the required configuration ConfigMaps/Secrets have been
 //   successfully defined.
+// * stateHistoryLimit is the number of previous Terraform state versions which are kept in ConfigMaps (zero disables
+//   the state history).
+// * lastResult is the result of the last execution of the Terraformer.
 type Terraformer struct {
 	logger       logrus.FieldLogger
 	client       client.Client
@@ -52,6 +55,8 @@ type Terraformer struct {
 	jobName              string
 	variablesEnvironment map[string]string
 	configurationDefined bool
+	stateHistoryLimit    int
+	lastResult           *Result
 
 	jobBackoffLimit       int32
 	activeDeadlineSeconds int64
@@ -52,6 +52,7 @@ func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
 	store := &genericregistry.Store{
 		NewFunc:                  func() runtime.Object { return &core.BackupBucket{} },
 		NewListFunc:              func() runtime.Object { return &core.BackupBucketList{} },
+		PredicateFunc:            backupbucket.MatchBackupBucket,
 		DefaultQualifiedResource: core.Resource("backupbuckets"),
 		EnableGarbageCollection:  true,
 
@@ -61,7 +62,7 @@ func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
 
 		TableConvertor: newTableConvertor(),
 	}
-	options := &generic.StoreOptions{RESTOptions: optsGetter}
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: backupbucket.GetAttrs, TriggerFunc: backupbucket.TriggerFunc}
 	if err := store.CompleteWithOptions(options); err != nil {
 		panic(err)
 	}
@@ -73,7 +73,11 @@ func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tabl
 
 		cells = append(cells, backupBucket.Name)
 		cells = append(cells, backupBucket.Spec.Provider.Type)
-		cells = append(cells, backupBucket.Spec.Seed)
+		if seed := backupBucket.Spec.Seed; seed != nil {
+			cells = append(cells, *seed)
+		} else {
+			cells = append(cells, "<none>")
+		}
 		if lastOp := backupBucket.Status.LastOperation; lastOp != nil {
 			cells = append(cells, lastOp.State)
 			cells = append(cells, lastOp.Progress)
@@ -52,6 +52,7 @@ func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
 	store := &genericregistry.Store{
 		NewFunc:                  func() runtime.Object { return &core.BackupEntry{} },
 		NewListFunc:              func() runtime.Object { return &core.BackupEntryList{} },
+		PredicateFunc:            backupentry.MatchBackupEntry,
 		DefaultQualifiedResource: core.Resource("backupentries"),
 		EnableGarbageCollection:  true,
 
@@ -61,7 +62,7 @@ func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
 
 		TableConvertor: newTableConvertor(),
 	}
-	options := &generic.StoreOptions{RESTOptions: optsGetter}
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: backupentry.GetAttrs, TriggerFunc: backupentry.TriggerFunc}
 	if err := store.CompleteWithOptions(options); err != nil {
 		panic(err)
 	}
@@ -73,7 +73,11 @@ func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tabl
 
 		cells = append(cells, backupEntry.Name)
 		cells = append(cells, backupEntry.Spec.BucketName)
-		cells = append(cells, backupEntry.Spec.Seed)
+		if seed := backupEntry.Spec.Seed; seed != nil {
+			cells = append(cells, *seed)
+		} else {
+			cells = append(cells, "<none>")
+		}
 		if lastOp := backupEntry.Status.LastOperation; lastOp != nil {
 			cells = append(cells, lastOp.State)
 			cells = append(cells, lastOp.Progress)
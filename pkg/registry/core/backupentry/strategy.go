@@ -16,6 +16,7 @@ package backupentry
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/gardener/gardener/pkg/api"
@@ -25,8 +26,12 @@ import (
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/apiserver/pkg/storage/names"
 )
 
@@ -120,3 +125,47 @@ func (backupEntryStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old
 func (backupEntryStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
 	return validation.ValidateBackupEntryStatusUpdate(obj.(*core.BackupEntry), old.(*core.BackupEntry))
 }
+
+// ToSelectableFields returns a field set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func ToSelectableFields(backupEntry *core.BackupEntry) fields.Set {
+	// The purpose of allocation with a given number of elements is to reduce
+	// amount of allocations needed to create the fields.Set. If you add any
+	// field here or the number of object-meta related fields changes, this should
+	// be adjusted.
+	backupEntrySpecificFieldsSet := make(fields.Set, 3)
+	backupEntrySpecificFieldsSet[core.BackupEntrySeedName] = getSeedName(backupEntry)
+	return generic.AddObjectMetaFieldsSet(backupEntrySpecificFieldsSet, &backupEntry.ObjectMeta, true)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	backupEntry, ok := obj.(*core.BackupEntry)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a backupentry")
+	}
+	return labels.Set(backupEntry.ObjectMeta.Labels), ToSelectableFields(backupEntry), nil
+}
+
+// TriggerFunc matches correct seed when watching.
+func TriggerFunc(obj runtime.Object) []storage.MatchValue {
+	backupEntry := obj.(*core.BackupEntry)
+	return []storage.MatchValue{{IndexName: core.BackupEntrySeedName, Value: getSeedName(backupEntry)}}
+}
+
+// MatchBackupEntry returns a generic matcher for a given label and field selector.
+func MatchBackupEntry(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{core.BackupEntrySeedName},
+	}
+}
+
+func getSeedName(backupEntry *core.BackupEntry) string {
+	if backupEntry.Spec.Seed == nil {
+		return ""
+	}
+	return *backupEntry.Spec.Seed
+}
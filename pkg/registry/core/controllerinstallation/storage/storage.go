@@ -53,6 +53,7 @@ func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
 	store := &genericregistry.Store{
 		NewFunc:                  func() runtime.Object { return &core.ControllerInstallation{} },
 		NewListFunc:              func() runtime.Object { return &core.ControllerInstallationList{} },
+		PredicateFunc:            controllerinstallation.MatchControllerInstallation,
 		DefaultQualifiedResource: core.Resource("controllerinstallations"),
 		EnableGarbageCollection:  true,
 
@@ -62,7 +63,7 @@ func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
 
 		TableConvertor: newTableConvertor(),
 	}
-	options := &generic.StoreOptions{RESTOptions: optsGetter}
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: controllerinstallation.GetAttrs, TriggerFunc: controllerinstallation.TriggerFunc}
 	if err := store.CompleteWithOptions(options); err != nil {
 		panic(err)
 	}
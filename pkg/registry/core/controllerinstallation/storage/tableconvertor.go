@@ -42,6 +42,7 @@ func newTableConvertor() rest.TableConvertor {
 			{Name: "Seed", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["seed"]},
 			{Name: "Valid", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["Valid"]},
 			{Name: "Installed", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["installed"]},
+			{Name: "Healthy", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["healthy"]},
 			{Name: "Age", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
 		},
 	}
@@ -86,6 +87,11 @@ func (c *convertor) ConvertToTable(ctx context.Context, o runtime.Object, tableO
 		} else {
 			cells = append(cells, "<unknown>")
 		}
+		if cond := helper.GetCondition(obj.Status.Conditions, core.ControllerInstallationHealthy); cond != nil {
+			cells = append(cells, cond.Status)
+		} else {
+			cells = append(cells, "<unknown>")
+		}
 		cells = append(cells, metatable.ConvertToHumanReadableDateType(obj.CreationTimestamp))
 
 		return cells, nil
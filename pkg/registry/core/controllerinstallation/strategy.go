@@ -16,14 +16,19 @@ package controllerinstallation
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/core/validation"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/apiserver/pkg/storage/names"
 )
 
@@ -108,3 +113,40 @@ func (controllerInstallationStatusStrategy) PrepareForUpdate(ctx context.Context
 func (controllerInstallationStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
 	return validation.ValidateControllerInstallationStatusUpdate(obj.(*core.ControllerInstallation).Status, old.(*core.ControllerInstallation).Status)
 }
+
+// ToSelectableFields returns a field set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func ToSelectableFields(controllerInstallation *core.ControllerInstallation) fields.Set {
+	// The purpose of allocation with a given number of elements is to reduce
+	// amount of allocations needed to create the fields.Set. If you add any
+	// field here or the number of object-meta related fields changes, this should
+	// be adjusted.
+	controllerInstallationSpecificFieldsSet := make(fields.Set, 2)
+	controllerInstallationSpecificFieldsSet[core.ControllerInstallationSeedRefName] = controllerInstallation.Spec.SeedRef.Name
+	return generic.AddObjectMetaFieldsSet(controllerInstallationSpecificFieldsSet, &controllerInstallation.ObjectMeta, false)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	controllerInstallation, ok := obj.(*core.ControllerInstallation)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a controllerinstallation")
+	}
+	return labels.Set(controllerInstallation.ObjectMeta.Labels), ToSelectableFields(controllerInstallation), nil
+}
+
+// TriggerFunc matches correct seed when watching.
+func TriggerFunc(obj runtime.Object) []storage.MatchValue {
+	controllerInstallation := obj.(*core.ControllerInstallation)
+	return []storage.MatchValue{{IndexName: core.ControllerInstallationSeedRefName, Value: controllerInstallation.Spec.SeedRef.Name}}
+}
+
+// MatchControllerInstallation returns a generic matcher for a given label and field selector.
+func MatchControllerInstallation(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{core.ControllerInstallationSeedRefName},
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation_test
+
+import (
+	"testing"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	strategy "github.com/gardener/gardener/pkg/registry/core/controllerinstallation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+func TestControllerInstallation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ControllerInstallation Suite")
+}
+
+var _ = Describe("ToSelectableFields", func() {
+	It("should return correct fields", func() {
+		result := strategy.ToSelectableFields(newControllerInstallation("foo"))
+
+		Expect(result).To(HaveLen(2))
+		Expect(result.Get("metadata.name")).To(Equal("test"))
+		Expect(result.Get(core.ControllerInstallationSeedRefName)).To(Equal("foo"))
+	})
+})
+
+var _ = Describe("GetAttrs", func() {
+	It("should return error when object is not ControllerInstallation", func() {
+		_, _, err := strategy.GetAttrs(&core.BackupBucket{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return correct result", func() {
+		ls, fs, err := strategy.GetAttrs(newControllerInstallation("foo"))
+
+		Expect(ls).To(HaveLen(1))
+		Expect(ls.Get("foo")).To(Equal("bar"))
+		Expect(fs.Get(core.ControllerInstallationSeedRefName)).To(Equal("foo"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
+
+var _ = Describe("TriggerFunc", func() {
+	It("should return correct matching values", func() {
+		mv := strategy.TriggerFunc(newControllerInstallation("foo"))
+
+		Expect(mv).To(Equal([]storage.MatchValue{{IndexName: core.ControllerInstallationSeedRefName, Value: "foo"}}))
+	})
+})
+
+var _ = Describe("MatchControllerInstallation", func() {
+	It("should return correct predicate", func() {
+		ls, _ := labels.Parse("app=test")
+		fs := fields.OneTermEqualSelector(core.ControllerInstallationSeedRefName, "foo")
+
+		result := strategy.MatchControllerInstallation(ls, fs)
+
+		Expect(result.Label).To(Equal(ls))
+		Expect(result.Field).To(Equal(fs))
+		Expect(result.IndexFields).To(ConsistOf(core.ControllerInstallationSeedRefName))
+	})
+})
+
+func newControllerInstallation(seedName string) *core.ControllerInstallation {
+	return &core.ControllerInstallation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "test",
+			Labels: map[string]string{"foo": "bar"},
+		},
+		Spec: core.ControllerInstallationSpec{
+			RegistrationRef: corev1.ObjectReference{Name: "registration"},
+			SeedRef:         corev1.ObjectReference{Name: seedName},
+		},
+	}
+}
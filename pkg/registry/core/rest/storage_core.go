@@ -36,10 +36,14 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/kubernetes"
 )
 
-// StorageProvider is an empty struct.
-type StorageProvider struct{}
+// StorageProvider contains configurations related to the core resources.
+type StorageProvider struct {
+	// KubeClient is used by the storage of the adminkubeconfig subresource of Shoots.
+	KubeClient kubernetes.Interface
+}
 
 // NewRESTStorage creates a new API group info object and registers the v1alpha1 core storage.
 func (p StorageProvider) NewRESTStorage(restOptionsGetter generic.RESTOptionsGetter) genericapiserver.APIGroupInfo {
@@ -69,6 +73,7 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 
 	controllerRegistrationStorage := controllerregistrationstore.NewStorage(restOptionsGetter)
 	storage["controllerregistrations"] = controllerRegistrationStorage.ControllerRegistration
+	storage["controllerregistrations/status"] = controllerRegistrationStorage.Status
 
 	controllerInstallationStorage := controllerinstallationstore.NewStorage(restOptionsGetter)
 	storage["controllerinstallations"] = controllerInstallationStorage.ControllerInstallation
@@ -92,9 +97,10 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storage["seeds"] = seedStorage.Seed
 	storage["seeds/status"] = seedStorage.Status
 
-	shootStorage := shootstore.NewStorage(restOptionsGetter)
+	shootStorage := shootstore.NewStorage(restOptionsGetter, seedStorage.Seed, p.KubeClient)
 	storage["shoots"] = shootStorage.Shoot
 	storage["shoots/status"] = shootStorage.Status
+	storage["shoots/adminkubeconfig"] = shootStorage.AdminKubeconfig
 
 	return storage
 }
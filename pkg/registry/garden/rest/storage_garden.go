@@ -28,10 +28,14 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/kubernetes"
 )
 
-// StorageProvider is an empty struct.
-type StorageProvider struct{}
+// StorageProvider contains configurations related to the garden resources.
+type StorageProvider struct {
+	// KubeClient is used by the storage of the adminkubeconfig subresource of Shoots.
+	KubeClient kubernetes.Interface
+}
 
 // NewRESTStorage creates a new API group info object and registers the v1beta1 Garden storage.
 func (p StorageProvider) NewRESTStorage(restOptionsGetter generic.RESTOptionsGetter) genericapiserver.APIGroupInfo {
@@ -65,9 +69,10 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	storage["seeds"] = seedStorage.Seed
 	storage["seeds/status"] = seedStorage.Status
 
-	shootStorage := shootstore.NewStorage(restOptionsGetter)
+	shootStorage := shootstore.NewStorage(restOptionsGetter, seedStorage.Seed, p.KubeClient)
 	storage["shoots"] = shootStorage.Shoot
 	storage["shoots/status"] = shootStorage.Status
+	storage["shoots/adminkubeconfig"] = shootStorage.AdminKubeconfig
 
 	return storage
 }
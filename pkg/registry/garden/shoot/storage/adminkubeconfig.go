@@ -0,0 +1,198 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// DefaultAdminKubeconfigExpiration is the validity of an admin kubeconfig if no expiration has been requested.
+	DefaultAdminKubeconfigExpiration = time.Hour
+	// MinAdminKubeconfigExpiration is the minimum validity of an admin kubeconfig.
+	MinAdminKubeconfigExpiration = 10 * time.Minute
+	// MaxAdminKubeconfigExpiration is the maximum validity of an admin kubeconfig. Longer requested expirations are
+	// capped to this value.
+	MaxAdminKubeconfigExpiration = 24 * time.Hour
+)
+
+// NewSeedClient creates a Kubernetes client for a seed cluster from the given kubeconfig. Exposed for testing.
+var NewSeedClient = func(kubeconfig []byte) (kubernetes.Interface, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+// AdminKubeconfigREST implements the REST endpoint for requesting short-lived admin kubeconfigs for a Shoot.
+type AdminKubeconfigREST struct {
+	shootGetter rest.Getter
+	seedGetter  rest.Getter
+	kubeClient  kubernetes.Interface
+}
+
+var _ rest.NamedCreater = &AdminKubeconfigREST{}
+
+// NewAdminKubeconfigREST creates a new AdminKubeconfigREST which reads Shoots and Seeds with the given getters. The
+// given Kubernetes client is used to read the kubeconfigs of the Seeds and the API server URLs of the Shoots.
+func NewAdminKubeconfigREST(shootGetter, seedGetter rest.Getter, kubeClient kubernetes.Interface) *AdminKubeconfigREST {
+	return &AdminKubeconfigREST{
+		shootGetter: shootGetter,
+		seedGetter:  seedGetter,
+		kubeClient:  kubeClient,
+	}
+}
+
+// New creates a new (empty) internal AdminKubeconfigRequest object.
+func (r *AdminKubeconfigREST) New() runtime.Object {
+	return &garden.AdminKubeconfigRequest{}
+}
+
+// Create issues a kubeconfig for the Shoot with the given name. Its client certificate is signed by the cluster CA of
+// the Shoot and expires after the requested (but capped) duration. The cluster CA is read from the control plane
+// namespace of the Shoot in its Seed, i.e. its private key never leaves the Seed except for signing.
+func (r *AdminKubeconfigREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if r.kubeClient == nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("kubernetes client is not configured"))
+	}
+
+	kubeconfigRequest, ok := obj.(*garden.AdminKubeconfigRequest)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not an AdminKubeconfigRequest: %T", obj))
+	}
+
+	if createValidation != nil {
+		if err := createValidation(kubeconfigRequest.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	userInfo, ok := request.UserFrom(ctx)
+	if !ok || len(userInfo.GetName()) == 0 {
+		return nil, apierrors.NewBadRequest("no user information found in request")
+	}
+
+	shootObj, err := r.shootGetter.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	shoot := shootObj.(*garden.Shoot)
+
+	if len(shoot.Status.TechnicalID) == 0 || shoot.Spec.SeedName == nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("shoot %s/%s has not been reconciled yet", shoot.Namespace, shoot.Name))
+	}
+
+	kubeconfigSecret, err := r.kubeClient.CoreV1().Secrets(shoot.Namespace).Get(fmt.Sprintf("%s.kubeconfig", shoot.Name), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("shoot %s/%s has not been reconciled yet", shoot.Namespace, shoot.Name))
+		}
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	ca, err := r.loadClusterCA(ctx, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	validity := AdminKubeconfigValidity(kubeconfigRequest.Spec.ExpirationSeconds)
+	controlPlane, err := (&secrets.ControlPlaneSecretConfig{
+		CertificateSecretConfig: &secrets.CertificateSecretConfig{
+			Name:         "admin-kubeconfig",
+			CommonName:   userInfo.GetName(),
+			Organization: []string{"system:masters"},
+			CertType:     secrets.ClientCert,
+			SigningCA:    ca,
+			Validity:     &validity,
+		},
+		KubeConfigRequest: &secrets.KubeConfigRequest{
+			ClusterName:  shoot.Status.TechnicalID,
+			APIServerURL: strings.TrimPrefix(kubeconfigSecret.Annotations["url"], "https://"),
+		},
+	}).GenerateControlPlane()
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	kubeconfigRequest.Status.Kubeconfig = controlPlane.Kubeconfig
+	// Certificates encode their expiration with a precision of seconds.
+	kubeconfigRequest.Status.ExpirationTimestamp = metav1.NewTime(controlPlane.Certificate.Certificate.NotAfter.Truncate(time.Second))
+	return kubeconfigRequest, nil
+}
+
+// loadClusterCA reads the cluster CA of the given Shoot from its control plane namespace in the Seed.
+func (r *AdminKubeconfigREST) loadClusterCA(ctx context.Context, shoot *garden.Shoot) (*secrets.Certificate, error) {
+	seedObj, err := r.seedGetter.Get(request.WithNamespace(ctx, ""), *shoot.Spec.SeedName, &metav1.GetOptions{})
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not get seed of shoot %s/%s: %v", shoot.Namespace, shoot.Name, err))
+	}
+	seed := seedObj.(*garden.Seed)
+
+	seedSecret, err := r.kubeClient.CoreV1().Secrets(seed.Spec.SecretRef.Namespace).Get(seed.Spec.SecretRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not get secret of seed %s: %v", seed.Name, err))
+	}
+
+	seedClient, err := NewSeedClient(seedSecret.Data[secrets.DataKeyKubeconfig])
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not create client for seed %s: %v", seed.Name, err))
+	}
+
+	caSecret, err := seedClient.CoreV1().Secrets(shoot.Status.TechnicalID).Get(constants.SecretNameCACluster, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("cluster CA of shoot %s/%s is not available yet", shoot.Namespace, shoot.Name))
+		}
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	ca, err := secrets.LoadCertificate(constants.SecretNameCACluster, caSecret.Data[secrets.DataKeyPrivateKeyCA], caSecret.Data[secrets.DataKeyCertificateCA])
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not load cluster CA of shoot %s/%s: %v", shoot.Namespace, shoot.Name, err))
+	}
+	return ca, nil
+}
+
+// AdminKubeconfigValidity computes the validity of an admin kubeconfig based on the requested expiration seconds.
+func AdminKubeconfigValidity(expirationSeconds *int64) time.Duration {
+	if expirationSeconds == nil {
+		return DefaultAdminKubeconfigExpiration
+	}
+
+	validity := time.Duration(*expirationSeconds) * time.Second
+	switch {
+	case validity < MinAdminKubeconfigExpiration:
+		return MinAdminKubeconfigExpiration
+	case validity > MaxAdminKubeconfigExpiration:
+		return MaxAdminKubeconfigExpiration
+	}
+	return validity
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	. "github.com/gardener/gardener/pkg/registry/garden/shoot/storage"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/clientcmd"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func TestStorage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Shoot Storage Suite")
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func parseCertificate(data []byte) *x509.Certificate {
+	block, _ := pem.Decode(data)
+	ExpectWithOffset(1, block).NotTo(BeNil())
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return certificate
+}
+
+// getterFunc implements rest.Getter with a function.
+type getterFunc func(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error)
+
+func (f getterFunc) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return f(ctx, name, options)
+}
+
+var _ = Describe("AdminKubeconfigValidity", func() {
+	DescribeTable("#AdminKubeconfigValidity",
+		func(expirationSeconds *int64, expected time.Duration) {
+			Expect(AdminKubeconfigValidity(expirationSeconds)).To(Equal(expected))
+		},
+		Entry("no expiration requested", nil, DefaultAdminKubeconfigExpiration),
+		Entry("expiration within bounds", int64Ptr(7200), 2*time.Hour),
+		Entry("expiration below minimum", int64Ptr(60), MinAdminKubeconfigExpiration),
+		Entry("negative expiration", int64Ptr(-1), MinAdminKubeconfigExpiration),
+		Entry("expiration above maximum", int64Ptr(7*24*3600), MaxAdminKubeconfigExpiration),
+	)
+})
+
+var _ = Describe("AdminKubeconfigREST", func() {
+	var (
+		ctx = request.WithUser(request.WithNamespace(context.TODO(), "garden-dev"), &user.DefaultInfo{Name: "foo@example.com", Groups: []string{"bar"}})
+
+		shoot       *garden.Shoot
+		seed        *garden.Seed
+		shootGetter getterFunc
+		seedGetter  getterFunc
+		kubeClient  *fake.Clientset
+		seedClient  *fake.Clientset
+		ca          *secrets.Certificate
+
+		oldNewSeedClient func([]byte) (kubernetes.Interface, error)
+		seedKubeconfig   []byte
+	)
+
+	BeforeEach(func() {
+		shoot = &garden.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec:       garden.ShootSpec{SeedName: stringPtr("seed")},
+			Status:     garden.ShootStatus{TechnicalID: "shoot--dev--shoot"},
+		}
+		seed = &garden.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+			Spec: garden.SeedSpec{
+				SecretRef: corev1.SecretReference{Name: "seed-secret", Namespace: "garden"},
+			},
+		}
+
+		shootGetter = func(_ context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+			if name != shoot.Name {
+				return nil, apierrors.NewNotFound(garden.Resource("shoots"), name)
+			}
+			return shoot, nil
+		}
+		seedGetter = func(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+			if ns, _ := request.NamespaceFrom(ctx); len(ns) > 0 || name != seed.Name {
+				return nil, apierrors.NewNotFound(garden.Resource("seeds"), name)
+			}
+			return seed, nil
+		}
+
+		var err error
+		ca, err = (&secrets.CertificateSecretConfig{Name: "ca", CommonName: "kubernetes", CertType: secrets.CACert}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		seedKubeconfig = []byte("seed-kubeconfig")
+		kubeClient = fake.NewSimpleClientset(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed-secret", Namespace: "garden"},
+				Data:       map[string][]byte{"kubeconfig": seedKubeconfig},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot.kubeconfig", Namespace: "garden-dev", Annotations: map[string]string{"url": "https://api.shoot.example.com"}},
+			},
+		)
+		seedClient = fake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "shoot--dev--shoot"},
+			Data: map[string][]byte{
+				secrets.DataKeyCertificateCA: ca.CertificatePEM,
+				secrets.DataKeyPrivateKeyCA:  ca.PrivateKeyPEM,
+			},
+		})
+
+		oldNewSeedClient = NewSeedClient
+		NewSeedClient = func(kubeconfig []byte) (kubernetes.Interface, error) {
+			Expect(kubeconfig).To(Equal(seedKubeconfig))
+			return seedClient, nil
+		}
+	})
+
+	AfterEach(func() {
+		NewSeedClient = oldNewSeedClient
+	})
+
+	clientCertificate := func(kubeconfig []byte) *x509.Certificate {
+		config, err := clientcmd.Load(kubeconfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Clusters).To(HaveKey("shoot--dev--shoot"))
+		Expect(config.Clusters["shoot--dev--shoot"].Server).To(Equal("https://api.shoot.example.com"))
+		Expect(config.AuthInfos).To(HaveKey("shoot--dev--shoot"))
+
+		return parseCertificate(config.AuthInfos["shoot--dev--shoot"].ClientCertificateData)
+	}
+
+	It("should issue a client certificate for the requesting user signed by the cluster CA in the seed", func() {
+		rest := NewAdminKubeconfigREST(shootGetter, seedGetter, kubeClient)
+
+		obj, err := rest.Create(ctx, "shoot", &garden.AdminKubeconfigRequest{}, nil, &metav1.CreateOptions{})
+
+		Expect(err).NotTo(HaveOccurred())
+		kubeconfigRequest := obj.(*garden.AdminKubeconfigRequest)
+
+		certificate := clientCertificate(kubeconfigRequest.Status.Kubeconfig)
+		Expect(certificate.Subject.CommonName).To(Equal("foo@example.com"))
+		Expect(certificate.Subject.Organization).To(ConsistOf("system:masters"))
+		Expect(certificate.CheckSignatureFrom(parseCertificate(ca.CertificatePEM))).To(Succeed())
+		Expect(certificate.NotAfter).To(BeTemporally("~", time.Now().Add(DefaultAdminKubeconfigExpiration), time.Minute))
+		Expect(kubeconfigRequest.Status.ExpirationTimestamp.Time).To(BeTemporally("==", certificate.NotAfter))
+	})
+
+	DescribeTable("should cap the expiration of the client certificate",
+		func(expirationSeconds int64, expected time.Duration) {
+			rest := NewAdminKubeconfigREST(shootGetter, seedGetter, kubeClient)
+
+			obj, err := rest.Create(ctx, "shoot", &garden.AdminKubeconfigRequest{Spec: garden.AdminKubeconfigRequestSpec{ExpirationSeconds: &expirationSeconds}}, nil, &metav1.CreateOptions{})
+
+			Expect(err).NotTo(HaveOccurred())
+			kubeconfigRequest := obj.(*garden.AdminKubeconfigRequest)
+			Expect(clientCertificate(kubeconfigRequest.Status.Kubeconfig).NotAfter).To(BeTemporally("~", time.Now().Add(expected), time.Minute))
+		},
+		Entry("expiration within bounds", int64(7200), 2*time.Hour),
+		Entry("expiration below minimum", int64(1), MinAdminKubeconfigExpiration),
+		Entry("expiration above maximum", int64(7*24*3600), MaxAdminKubeconfigExpiration),
+	)
+
+	It("should refuse the request without user information", func() {
+		rest := NewAdminKubeconfigREST(shootGetter, seedGetter, kubeClient)
+
+		_, err := rest.Create(request.WithNamespace(context.TODO(), "garden-dev"), "shoot", &garden.AdminKubeconfigRequest{}, nil, &metav1.CreateOptions{})
+
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+	})
+
+	It("should refuse the request if the create validation fails", func() {
+		rest := NewAdminKubeconfigREST(shootGetter, seedGetter, kubeClient)
+		forbidden := apierrors.NewForbidden(garden.Resource("shoots"), "shoot", nil)
+
+		_, err := rest.Create(ctx, "shoot", &garden.AdminKubeconfigRequest{}, func(runtime.Object) error { return forbidden }, &metav1.CreateOptions{})
+
+		Expect(err).To(Equal(forbidden))
+	})
+
+	It("should return not found if the shoot does not exist", func() {
+		rest := NewAdminKubeconfigREST(shootGetter, seedGetter, kubeClient)
+
+		_, err := rest.Create(ctx, "other", &garden.AdminKubeconfigRequest{}, nil, &metav1.CreateOptions{})
+
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should refuse the request if the shoot has not been reconciled yet", func() {
+		shoot.Status.TechnicalID = ""
+		rest := NewAdminKubeconfigREST(shootGetter, seedGetter, kubeClient)
+
+		_, err := rest.Create(ctx, "shoot", &garden.AdminKubeconfigRequest{}, nil, &metav1.CreateOptions{})
+
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+	})
+
+	It("should refuse the request if the cluster CA does not exist in the seed", func() {
+		seedClient = fake.NewSimpleClientset()
+		rest := NewAdminKubeconfigREST(shootGetter, seedGetter, kubeClient)
+
+		_, err := rest.Create(ctx, "shoot", &garden.AdminKubeconfigRequest{}, nil, &metav1.CreateOptions{})
+
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("cluster CA of shoot garden-dev/shoot is not available yet"))
+	})
+
+	It("should fail if the kubernetes client is not configured", func() {
+		rest := NewAdminKubeconfigREST(shootGetter, seedGetter, nil)
+
+		_, err := rest.Create(ctx, "shoot", &garden.AdminKubeconfigRequest{}, nil, &metav1.CreateOptions{})
+
+		Expect(apierrors.IsInternalError(err)).To(BeTrue())
+	})
+})
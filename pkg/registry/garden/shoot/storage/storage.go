@@ -22,6 +22,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
 	"github.com/gardener/gardener/pkg/registry/garden/shoot"
@@ -34,17 +35,20 @@ type REST struct {
 
 // ShootStorage implements the storage for Shoots and all their subresources.
 type ShootStorage struct {
-	Shoot  *REST
-	Status *StatusREST
+	Shoot           *REST
+	Status          *StatusREST
+	AdminKubeconfig *AdminKubeconfigREST
 }
 
-// NewStorage creates a new ShootStorage object.
-func NewStorage(optsGetter generic.RESTOptionsGetter) ShootStorage {
+// NewStorage creates a new ShootStorage object. The given Seed getter and Kubernetes client are used to read the
+// cluster CAs of the Shoots from their Seeds when admin kubeconfigs are requested.
+func NewStorage(optsGetter generic.RESTOptionsGetter, seedGetter rest.Getter, kubeClient kubernetes.Interface) ShootStorage {
 	shootRest, shootStatusRest := NewREST(optsGetter)
 
 	return ShootStorage{
-		Shoot:  shootRest,
-		Status: shootStatusRest,
+		Shoot:           shootRest,
+		Status:          shootStatusRest,
+		AdminKubeconfig: NewAdminKubeconfigREST(shootRest.Store, seedGetter, kubeClient),
 	}
 }
 
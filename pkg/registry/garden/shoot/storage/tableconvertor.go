@@ -39,6 +39,8 @@ func newTableConvertor() rest.TableConvertor {
 		headers: []metav1beta1.TableColumnDefinition{
 			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
 			{Name: "CloudProfile", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["cloudprofile"]},
+			{Name: "Provider", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["provider"]},
+			{Name: "Region", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["region"]},
 			{Name: "Version", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["version"]},
 			{Name: "Seed", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["seed"]},
 			{Name: "Domain", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["domain"]},
@@ -81,6 +83,8 @@ func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tabl
 
 		cells = append(cells, shoot.Name)
 		cells = append(cells, shoot.Spec.CloudProfileName)
+		cells = append(cells, shoot.Spec.Provider.Type)
+		cells = append(cells, shoot.Spec.Region)
 		cells = append(cells, shoot.Spec.Kubernetes.Version)
 		if seed := shoot.Spec.SeedName; seed != nil {
 			cells = append(cells, *seed)
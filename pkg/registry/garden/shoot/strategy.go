@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/garden"
 	"github.com/gardener/gardener/pkg/apis/garden/validation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/fields"
@@ -55,6 +56,8 @@ func (shootStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 
 	shoot.Generation = 1
 	shoot.Status = garden.ShootStatus{}
+
+	setDefaults(shoot)
 }
 
 func (shootStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
@@ -62,11 +65,57 @@ func (shootStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Obje
 	oldShoot := old.(*garden.Shoot)
 	newShoot.Status = oldShoot.Status
 
+	setDefaults(newShoot)
+
 	if mustIncreaseGeneration(oldShoot, newShoot) {
 		newShoot.Generation = oldShoot.Generation + 1
 	}
 }
 
+// setDefaults defaults the maintenance settings and the kubernetes-dashboard addon of the given Shoot. Unlike the other
+// defaults these are not applied when decoding the object but only after the mutating admission plugins ran. Otherwise,
+// Shoot presets could never set them as they only fill values which have not been set yet.
+func setDefaults(shoot *garden.Shoot) {
+	trueVar := true
+
+	if shoot.Spec.Maintenance == nil {
+		shoot.Spec.Maintenance = &garden.Maintenance{}
+	}
+	if shoot.Spec.Maintenance.AutoUpdate == nil {
+		shoot.Spec.Maintenance.AutoUpdate = &garden.MaintenanceAutoUpdate{
+			KubernetesVersion: true,
+		}
+	}
+	if shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion == nil {
+		shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = &trueVar
+	}
+	if shoot.Spec.Maintenance.TimeWindow == nil {
+		mt := utils.RandomMaintenanceTimeWindow()
+
+		shoot.Spec.Maintenance.TimeWindow = &garden.MaintenanceTimeWindow{
+			Begin: mt.Begin().Formatted(),
+			End:   mt.End().Formatted(),
+		}
+	}
+
+	if shoot.Spec.Addons == nil {
+		shoot.Spec.Addons = &garden.Addons{}
+	}
+	if shoot.Spec.Addons.KubernetesDashboard == nil {
+		shoot.Spec.Addons.KubernetesDashboard = &garden.KubernetesDashboard{}
+	}
+	if shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode == nil {
+		// If the Kubernetes version cannot be parsed the token authentication mode is used.
+		k8sVersionLessThan116, _ := utils.CompareVersions(shoot.Spec.Kubernetes.Version, "<", "1.16")
+
+		defaultAuthMode := garden.KubernetesDashboardAuthModeToken
+		if k8sVersionLessThan116 {
+			defaultAuthMode = garden.KubernetesDashboardAuthModeBasic
+		}
+		shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode = &defaultAuthMode
+	}
+}
+
 func mustIncreaseGeneration(oldShoot, newShoot *garden.Shoot) bool {
 	var (
 		oldPurpose, newPurpose string
@@ -166,10 +215,14 @@ func ToSelectableFields(shoot *garden.Shoot) fields.Set {
 	// amount of allocations needed to create the fields.Set. If you add any
 	// field here or the number of object-meta related fields changes, this should
 	// be adjusted.
-	shootSpecificFieldsSet := make(fields.Set, 4)
+	shootSpecificFieldsSet := make(fields.Set, 9)
 	shootSpecificFieldsSet[garden.ShootSeedNameDeprecated] = getSeedName(shoot)
 	shootSpecificFieldsSet[garden.ShootSeedName] = getSeedName(shoot)
 	shootSpecificFieldsSet[garden.ShootCloudProfileName] = shoot.Spec.CloudProfileName
+	shootSpecificFieldsSet[garden.ShootRegion] = shoot.Spec.Region
+	shootSpecificFieldsSet[garden.ShootProviderType] = shoot.Spec.Provider.Type
+	shootSpecificFieldsSet[garden.ShootSecretBindingName] = shoot.Spec.SecretBindingName
+	shootSpecificFieldsSet[garden.ShootStatusLastOperationState] = getLastOperationState(shoot)
 	return generic.AddObjectMetaFieldsSet(shootSpecificFieldsSet, &shoot.ObjectMeta, true)
 }
 
@@ -208,3 +261,10 @@ func getSeedName(shoot *garden.Shoot) string {
 	}
 	return *shoot.Spec.SeedName
 }
+
+func getLastOperationState(shoot *garden.Shoot) string {
+	if shoot.Status.LastOperation == nil {
+		return ""
+	}
+	return string(shoot.Status.LastOperation.State)
+}
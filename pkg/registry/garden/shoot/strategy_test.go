@@ -15,10 +15,12 @@
 package shoot_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
 	strategy "github.com/gardener/gardener/pkg/registry/garden/shoot"
+	"github.com/gardener/gardener/pkg/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
@@ -26,6 +28,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 )
 
 func TestHealth(t *testing.T) {
@@ -37,13 +40,27 @@ var _ = Describe("ToSelectableFields", func() {
 	It("should return correct fields", func() {
 		result := strategy.ToSelectableFields(newShoot("foo"))
 
-		Expect(result).To(HaveLen(5))
+		Expect(result).To(HaveLen(9))
 		Expect(result.Has(garden.ShootSeedNameDeprecated)).To(BeTrue())
 		Expect(result.Get(garden.ShootSeedNameDeprecated)).To(Equal("foo"))
 		Expect(result.Has(garden.ShootSeedName)).To(BeTrue())
 		Expect(result.Get(garden.ShootSeedName)).To(Equal("foo"))
 		Expect(result.Has(garden.ShootCloudProfileName)).To(BeTrue())
 		Expect(result.Get(garden.ShootCloudProfileName)).To(Equal("baz"))
+		Expect(result.Get(garden.ShootRegion)).To(Equal("eu-west-1"))
+		Expect(result.Get(garden.ShootProviderType)).To(Equal("aws"))
+		Expect(result.Get(garden.ShootSecretBindingName)).To(Equal("secretbinding"))
+		Expect(result.Get(garden.ShootStatusLastOperationState)).To(Equal("Succeeded"))
+	})
+
+	It("should return an empty last operation state if there is no last operation", func() {
+		shoot := newShoot("foo")
+		shoot.Status.LastOperation = nil
+
+		result := strategy.ToSelectableFields(shoot)
+
+		Expect(result.Has(garden.ShootStatusLastOperationState)).To(BeTrue())
+		Expect(result.Get(garden.ShootStatusLastOperationState)).To(BeEmpty())
 	})
 })
 
@@ -92,6 +109,91 @@ var _ = Describe("MatchShoot", func() {
 	})
 })
 
+var _ = Describe("PrepareForCreate", func() {
+	var shoot *garden.Shoot
+
+	BeforeEach(func() {
+		shoot = newShoot("foo")
+	})
+
+	Context("maintenance", func() {
+		It("should default the maintenance if it is not provided", func() {
+			strategy.Strategy.PrepareForCreate(context.TODO(), shoot)
+
+			Expect(shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion).To(BeTrue())
+			Expect(shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion).To(PointTo(BeTrue()))
+			Expect(utils.ParseMaintenanceTime(shoot.Spec.Maintenance.TimeWindow.Begin)).ShouldNot(BeNil())
+			Expect(utils.ParseMaintenanceTime(shoot.Spec.Maintenance.TimeWindow.End)).ShouldNot(BeNil())
+		})
+
+		It("should default the missing values of a provided maintenance", func() {
+			shoot.Spec.Maintenance = &garden.Maintenance{
+				AutoUpdate: &garden.MaintenanceAutoUpdate{KubernetesVersion: false},
+			}
+
+			strategy.Strategy.PrepareForCreate(context.TODO(), shoot)
+
+			Expect(shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion).To(BeFalse())
+			Expect(shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion).To(PointTo(BeTrue()))
+			Expect(utils.ParseMaintenanceTime(shoot.Spec.Maintenance.TimeWindow.Begin)).ShouldNot(BeNil())
+			Expect(utils.ParseMaintenanceTime(shoot.Spec.Maintenance.TimeWindow.End)).ShouldNot(BeNil())
+		})
+
+		It("should not overwrite a provided time window", func() {
+			timeWindow := &garden.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}
+			shoot.Spec.Maintenance = &garden.Maintenance{TimeWindow: timeWindow.DeepCopy()}
+
+			strategy.Strategy.PrepareForCreate(context.TODO(), shoot)
+
+			Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(timeWindow))
+		})
+	})
+
+	Context("addons", func() {
+		It("should use the token authentication mode for the dashboard by default", func() {
+			shoot.Spec.Kubernetes.Version = "1.16.0"
+
+			strategy.Strategy.PrepareForCreate(context.TODO(), shoot)
+
+			Expect(shoot.Spec.Addons.KubernetesDashboard.Enabled).To(BeFalse())
+			Expect(shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode).To(PointTo(Equal(garden.KubernetesDashboardAuthModeToken)))
+		})
+
+		It("should use the basic authentication mode for the dashboard for Kubernetes versions < 1.16", func() {
+			shoot.Spec.Kubernetes.Version = "1.15.4"
+
+			strategy.Strategy.PrepareForCreate(context.TODO(), shoot)
+
+			Expect(shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode).To(PointTo(Equal(garden.KubernetesDashboardAuthModeBasic)))
+		})
+
+		It("should not overwrite a provided dashboard configuration", func() {
+			shoot.Spec.Addons = &garden.Addons{
+				KubernetesDashboard: &garden.KubernetesDashboard{Addon: garden.Addon{Enabled: true}},
+			}
+
+			strategy.Strategy.PrepareForCreate(context.TODO(), shoot)
+
+			Expect(shoot.Spec.Addons.KubernetesDashboard.Enabled).To(BeTrue())
+			Expect(shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode).NotTo(BeNil())
+		})
+	})
+})
+
+var _ = Describe("PrepareForUpdate", func() {
+	It("should default the maintenance and the dashboard if they are removed", func() {
+		oldShoot := newShoot("foo")
+		strategy.Strategy.PrepareForCreate(context.TODO(), oldShoot)
+		newShoot := newShoot("foo")
+
+		strategy.Strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+		Expect(newShoot.Spec.Maintenance.AutoUpdate).NotTo(BeNil())
+		Expect(newShoot.Spec.Maintenance.TimeWindow).NotTo(BeNil())
+		Expect(newShoot.Spec.Addons.KubernetesDashboard.AuthenticationMode).NotTo(BeNil())
+	})
+})
+
 func newShoot(seedName string) *garden.Shoot {
 	return &garden.Shoot{
 		ObjectMeta: metav1.ObjectMeta{
@@ -100,8 +202,14 @@ func newShoot(seedName string) *garden.Shoot {
 			Labels:    map[string]string{"foo": "bar"},
 		},
 		Spec: garden.ShootSpec{
-			CloudProfileName: "baz",
-			SeedName:         &seedName,
+			CloudProfileName:  "baz",
+			Provider:          garden.Provider{Type: "aws"},
+			Region:            "eu-west-1",
+			SecretBindingName: "secretbinding",
+			SeedName:          &seedName,
+		},
+		Status: garden.ShootStatus{
+			LastOperation: &garden.LastOperation{State: garden.LastOperationStateSucceeded},
 		},
 	}
 }
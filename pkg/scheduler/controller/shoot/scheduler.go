@@ -53,6 +53,8 @@ type SchedulerController struct {
 	shootSynced cache.InformerSynced
 	shootQueue  workqueue.RateLimitingInterface
 
+	controllerRegistrationSynced cache.InformerSynced
+
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
@@ -69,13 +71,17 @@ func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenCoreInform
 		seedLister           = seedInformer.Lister()
 		cloudProfileInformer = coreV1Alpha1Informer.CloudProfiles()
 		cloudProfileLister   = cloudProfileInformer.Lister()
-		shootQueue           = workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(config.Schedulers.Shoot.RetrySyncPeriod.Duration, 12*time.Hour), "gardener-shoot-scheduler")
+
+		controllerRegistrationInformer = coreV1Alpha1Informer.ControllerRegistrations()
+		controllerRegistrationLister   = controllerRegistrationInformer.Lister()
+
+		shootQueue = workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(config.Schedulers.Shoot.RetrySyncPeriod.Duration, 12*time.Hour), "gardener-shoot-scheduler")
 	)
 
 	schedulerController := &SchedulerController{
 		k8sGardenClient:        k8sGardenClient,
 		k8sGardenCoreInformers: gardenCoreInformerFactory,
-		control:                NewDefaultControl(k8sGardenClient, gardenCoreInformerFactory, recorder, config, shootLister, seedLister, cloudProfileLister),
+		control:                NewDefaultControl(k8sGardenClient, gardenCoreInformerFactory, recorder, config, shootLister, seedLister, cloudProfileLister, controllerRegistrationLister),
 		config:                 config,
 		recorder:               recorder,
 		cloudProfileLister:     cloudProfileLister,
@@ -92,6 +98,7 @@ func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenCoreInform
 	schedulerController.cloudProfileSynced = cloudProfileInformer.Informer().HasSynced
 	schedulerController.seedSynced = seedInformer.Informer().HasSynced
 	schedulerController.shootSynced = shootInformer.Informer().HasSynced
+	schedulerController.controllerRegistrationSynced = controllerRegistrationInformer.Informer().HasSynced
 
 	return schedulerController
 }
@@ -102,7 +109,7 @@ func (c *SchedulerController) Run(ctx context.Context, k8sGardenCoreInformers ga
 
 	k8sGardenCoreInformers.Start(ctx.Done())
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.cloudProfileSynced, c.seedSynced, c.shootSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.cloudProfileSynced, c.seedSynced, c.shootSynced, c.controllerRegistrationSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
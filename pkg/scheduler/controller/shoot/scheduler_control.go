@@ -21,6 +21,7 @@ import (
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -34,6 +35,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -102,8 +104,8 @@ type SchedulerInterface interface {
 
 // NewDefaultControl returns a new instance of the default implementation SchedulerInterface that
 // implements the documented semantics for Scheduling.
-func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.SchedulerConfiguration, shootLister gardencorelisters.ShootLister, seedLister gardencorelisters.SeedLister, cloudProfileLister gardencorelisters.CloudProfileLister) SchedulerInterface {
-	return &defaultControl{k8sGardenClient, k8sGardenCoreInformers, recorder, config, shootLister, seedLister, cloudProfileLister}
+func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.SchedulerConfiguration, shootLister gardencorelisters.ShootLister, seedLister gardencorelisters.SeedLister, cloudProfileLister gardencorelisters.CloudProfileLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister) SchedulerInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenCoreInformers, recorder, config, shootLister, seedLister, cloudProfileLister, controllerRegistrationLister}
 }
 
 type defaultControl struct {
@@ -114,6 +116,8 @@ type defaultControl struct {
 	shootLister            gardencorelisters.ShootLister
 	seedLister             gardencorelisters.SeedLister
 	cloudProfileLister     gardencorelisters.CloudProfileLister
+
+	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 }
 
 type executeSchedulingRequest = func(context.Context, *gardencorev1alpha1.Shoot) error
@@ -127,7 +131,7 @@ func (c *defaultControl) ScheduleShoot(ctx context.Context, obj *gardencorev1alp
 	schedulerLogger.Infof("[SCHEDULING SHOOT] using %s strategy", c.config.Schedulers.Shoot.Strategy)
 
 	// If no Seed is referenced, we try to determine an adequate one.
-	seed, err := determineSeed(shoot, c.seedLister, c.shootLister, c.cloudProfileLister, c.config.Schedulers.Shoot.Strategy)
+	seed, err := determineSeed(shoot, c.seedLister, c.shootLister, c.cloudProfileLister, c.controllerRegistrationLister, c.config.Schedulers.Shoot.Strategy)
 	if err != nil {
 		c.reportFailedScheduling(shoot, err)
 		return err
@@ -161,7 +165,7 @@ func (c *defaultControl) ScheduleShoot(ctx context.Context, obj *gardencorev1alp
 }
 
 // determineSeed returns an appropriate Seed cluster (or nil).
-func determineSeed(shoot *gardencorev1alpha1.Shoot, seedLister gardencorelisters.SeedLister, shootLister gardencorelisters.ShootLister, cloudProfileLister gardencorelisters.CloudProfileLister, strategy config.CandidateDeterminationStrategy) (*gardencorev1alpha1.Seed, error) {
+func determineSeed(shoot *gardencorev1alpha1.Shoot, seedLister gardencorelisters.SeedLister, shootLister gardencorelisters.ShootLister, cloudProfileLister gardencorelisters.CloudProfileLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, strategy config.CandidateDeterminationStrategy) (*gardencorev1alpha1.Seed, error) {
 	seedList, err := seedLister.List(labels.Everything())
 	if err != nil {
 		return nil, err
@@ -174,11 +178,15 @@ func determineSeed(shoot *gardencorev1alpha1.Shoot, seedLister gardencorelisters
 	if err != nil {
 		return nil, err
 	}
+	controllerRegistrationList, err := controllerRegistrationLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
 
-	return determineBestSeedCandidate(shoot, cloudProfile, shootList, seedList, strategy)
+	return determineBestSeedCandidate(shoot, cloudProfile, shootList, seedList, controllerRegistrationList, strategy)
 }
 
-func determineBestSeedCandidate(shoot *gardencorev1alpha1.Shoot, cloudProfile *gardencorev1alpha1.CloudProfile, shootList []*gardencorev1alpha1.Shoot, seedList []*gardencorev1alpha1.Seed, strategy config.CandidateDeterminationStrategy) (*gardencorev1alpha1.Seed, error) {
+func determineBestSeedCandidate(shoot *gardencorev1alpha1.Shoot, cloudProfile *gardencorev1alpha1.CloudProfile, shootList []*gardencorev1alpha1.Shoot, seedList []*gardencorev1alpha1.Seed, controllerRegistrationList []*gardencorev1alpha1.ControllerRegistration, strategy config.CandidateDeterminationStrategy) (*gardencorev1alpha1.Seed, error) {
 	var candidates []*gardencorev1alpha1.Seed
 	switch strategy {
 	case config.SameRegion:
@@ -203,7 +211,11 @@ func determineBestSeedCandidate(shoot *gardencorev1alpha1.Shoot, cloudProfile *g
 	}
 
 	// Filter out candidates
-	old := candidates
+	var (
+		old                = candidates
+		requiredExtensions = computeRequiredExtensions(shoot)
+		unsupported        []string
+	)
 	candidates = nil
 
 	for _, seed := range old {
@@ -213,10 +225,21 @@ func determineBestSeedCandidate(shoot *gardencorev1alpha1.Shoot, cloudProfile *g
 		if !seedSelector.Matches(labels.Set(seed.Labels)) {
 			continue
 		}
+		selected, err := seedSelectedByControllerRegistrations(seed, requiredExtensions, controllerRegistrationList)
+		if err != nil {
+			return nil, err
+		}
+		if !selected {
+			unsupported = append(unsupported, seed.Name)
+			continue
+		}
 		candidates = append(candidates, seed)
 	}
 
 	if candidates == nil {
+		if len(unsupported) > 0 {
+			return nil, fmt.Errorf("found %d possible seed cluster(s), however the seed cluster(s) %s are not selected by the controller registrations of the required extensions", len(old), strings.Join(unsupported, ", "))
+		}
 		return nil, fmt.Errorf("found %d possible seed cluster(s), however none have a disjoint network", len(old))
 	}
 
@@ -277,6 +300,69 @@ func determineCandidatesWithMinimalDistanceStrategy(seeds []*gardencorev1alpha1.
 	return candidates
 }
 
+// computeRequiredExtensions returns the extension kinds and types which are required by the given Shoot and which do
+// not depend on the Seed it is scheduled to.
+func computeRequiredExtensions(shoot *gardencorev1alpha1.Shoot) map[string]sets.String {
+	requiredExtensions := map[string]sets.String{
+		extensionsv1alpha1.InfrastructureResource: sets.NewString(shoot.Spec.Provider.Type),
+		extensionsv1alpha1.ControlPlaneResource:   sets.NewString(shoot.Spec.Provider.Type),
+		extensionsv1alpha1.WorkerResource:         sets.NewString(shoot.Spec.Provider.Type),
+		extensionsv1alpha1.NetworkResource:        sets.NewString(shoot.Spec.Networking.Type),
+	}
+
+	machineImages := sets.NewString()
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Machine.Image != nil {
+			machineImages.Insert(worker.Machine.Image.Name)
+		}
+	}
+	requiredExtensions[extensionsv1alpha1.OperatingSystemConfigResource] = machineImages
+
+	extensionTypes := sets.NewString()
+	for _, extension := range shoot.Spec.Extensions {
+		extensionTypes.Insert(extension.Type)
+	}
+	requiredExtensions[extensionsv1alpha1.ExtensionResource] = extensionTypes
+
+	return requiredExtensions
+}
+
+// seedSelectedByControllerRegistrations returns true if, for all of the given required extension kinds and types, at
+// least one of the ControllerRegistrations supporting them selects the given Seed. Extension kinds and types which are
+// not supported by any ControllerRegistration are ignored.
+func seedSelectedByControllerRegistrations(seed *gardencorev1alpha1.Seed, requiredExtensions map[string]sets.String, controllerRegistrationList []*gardencorev1alpha1.ControllerRegistration) (bool, error) {
+	for extensionKind, extensionTypes := range requiredExtensions {
+		for extensionType := range extensionTypes {
+			var supported, selected bool
+
+			for _, controllerRegistration := range controllerRegistrationList {
+				if !gardencorev1alpha1helper.IsResourceSupported(controllerRegistration.Spec.Resources, extensionKind, extensionType) {
+					continue
+				}
+				supported = true
+
+				if controllerRegistration.Spec.SeedSelector == nil {
+					selected = true
+					break
+				}
+				seedSelector, err := metav1.LabelSelectorAsSelector(controllerRegistration.Spec.SeedSelector)
+				if err != nil {
+					return false, fmt.Errorf("label selector conversion failed: %v for seedSelector of controller registration %s: %v", *controllerRegistration.Spec.SeedSelector, controllerRegistration.Name, err)
+				}
+				if seedSelector.Matches(labels.Set(seed.Labels)) {
+					selected = true
+					break
+				}
+			}
+
+			if supported && !selected {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
 func generateSeedUsageMap(shootList []*gardencorev1alpha1.Shoot) map[string]int {
 	m := map[string]int{}
 
@@ -293,7 +379,12 @@ func networksAreDisjunct(seed *gardencorev1alpha1.Seed, shoot *gardencorev1alpha
 	return len(schedulerutils.ValidateNetworkDisjointedness(seed.Spec.Networks, shoot.Spec.Networking.Nodes, shoot.Spec.Networking.Pods, shoot.Spec.Networking.Services, field.NewPath(""))) == 0
 }
 
+// verifySeedAvailability returns true if the Seed is available and its extensions are not reported to be unready or
+// unhealthy.
 func verifySeedAvailability(seed *gardencorev1alpha1.Seed) bool {
+	if cond := gardencorev1alpha1helper.GetCondition(seed.Status.Conditions, gardencorev1alpha1.SeedExtensionsReady); cond != nil && cond.Status == gardencorev1alpha1.ConditionFalse {
+		return false
+	}
 	if cond := gardencorev1alpha1helper.GetCondition(seed.Status.Conditions, gardencorev1alpha1.SeedAvailable); cond != nil {
 		return cond.Status == gardencorev1alpha1.ConditionTrue
 	}
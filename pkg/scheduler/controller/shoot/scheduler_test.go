@@ -132,7 +132,7 @@ var _ = Describe("Scheduler_Control", func() {
 			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seed.Name))
@@ -154,7 +154,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
@@ -169,7 +169,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -192,7 +192,7 @@ var _ = Describe("Scheduler_Control", func() {
 			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
@@ -206,7 +206,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
@@ -236,7 +236,7 @@ var _ = Describe("Scheduler_Control", func() {
 			anotherRegion := "europe-west3"
 			shoot.Spec.Region = anotherRegion
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
@@ -260,7 +260,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
@@ -283,7 +283,7 @@ var _ = Describe("Scheduler_Control", func() {
 			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
@@ -304,7 +304,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
@@ -322,7 +322,7 @@ var _ = Describe("Scheduler_Control", func() {
 				Nodes:    seed.Spec.Networks.Nodes,
 			}
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -334,7 +334,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			shoot.Spec.Region = "another-region"
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -350,19 +350,71 @@ var _ = Describe("Scheduler_Control", func() {
 			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
 
+		It("should fail because the controller registration of the shoot's provider type doesn't select any seed candidate", func() {
+			controllerRegistration := &gardencorev1alpha1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-foo"},
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Resources:    []gardencorev1alpha1.ControllerResource{{Kind: "Infrastructure", Type: providerType}},
+					SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				},
+			}
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)
+
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("not selected by the controller registrations"))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should find the seed cluster selected by the controller registration of the shoot's provider type", func() {
+			otherSeed := seed.DeepCopy()
+			otherSeed.Name = "seed-2"
+			seed.Labels = map[string]string{"foo": "bar"}
+
+			controllerRegistration := &gardencorev1alpha1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-foo"},
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Resources:    []gardencorev1alpha1.ControllerResource{{Kind: "Infrastructure", Type: providerType}},
+					SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				},
+			}
+			unrelatedControllerRegistration := &gardencorev1alpha1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-other"},
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Resources:    []gardencorev1alpha1.ControllerResource{{Kind: "Infrastructure", Type: "other"}},
+					SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"other": "seed"}},
+				},
+			}
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(otherSeed)
+			gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)
+			gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Informer().GetStore().Add(unrelatedControllerRegistration)
+
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
 		It("should fail because it cannot find a seed cluster due to invalid profile", func() {
 			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
 			shoot.Spec.CloudProfileName = "another-profile"
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -379,7 +431,22 @@ var _ = Describe("Scheduler_Control", func() {
 			}
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+
+			Expect(err).To(HaveOccurred())
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should fail because it cannot find a seed cluster due to unhealthy extensions", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			seed.Status.Conditions = append(seed.Status.Conditions, gardencorev1alpha1.Condition{
+				Type:   gardencorev1alpha1.SeedExtensionsReady,
+				Status: gardencorev1alpha1.ConditionFalse,
+			})
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -393,7 +460,7 @@ var _ = Describe("Scheduler_Control", func() {
 			}
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
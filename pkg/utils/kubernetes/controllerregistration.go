@@ -86,3 +86,23 @@ func TryUpdateControllerRegistration(g gardencore.Interface, backoff wait.Backof
 		return equality.Semantic.DeepEqual(cur, updated)
 	})
 }
+
+// TryUpdateControllerRegistrationStatusWithEqualFunc tries to update the status of the controllerRegistration matching the given <meta>.
+// It retries with the given <backoff> characteristics as long as it gets Conflict errors.
+// The transformation function is applied to the current state of the ControllerRegistration object. If the equal
+// func concludes a semantically equal ControllerRegistration, no update is done and the operation returns normally.
+func TryUpdateControllerRegistrationStatusWithEqualFunc(g gardencore.Interface, backoff wait.Backoff, meta metav1.ObjectMeta, transform func(*gardencorev1alpha1.ControllerRegistration) (*gardencorev1alpha1.ControllerRegistration, error), equal func(cur, updated *gardencorev1alpha1.ControllerRegistration) bool) (*gardencorev1alpha1.ControllerRegistration, error) {
+	return tryUpdateControllerRegistration(g, backoff, meta, transform, func(g gardencore.Interface, controllerRegistration *gardencorev1alpha1.ControllerRegistration) (*gardencorev1alpha1.ControllerRegistration, error) {
+		return g.CoreV1alpha1().ControllerRegistrations().UpdateStatus(controllerRegistration)
+	}, equal)
+}
+
+// TryUpdateControllerRegistrationStatus tries to update the status of the controllerRegistration matching the given <meta>.
+// It retries with the given <backoff> characteristics as long as it gets Conflict errors.
+// The transformation function is applied to the current state of the ControllerRegistration object. If the transformation
+// yields a semantically equal ControllerRegistration, no update is done and the operation returns normally.
+func TryUpdateControllerRegistrationStatus(g gardencore.Interface, backoff wait.Backoff, meta metav1.ObjectMeta, transform func(*gardencorev1alpha1.ControllerRegistration) (*gardencorev1alpha1.ControllerRegistration, error)) (*gardencorev1alpha1.ControllerRegistration, error) {
+	return TryUpdateControllerRegistrationStatusWithEqualFunc(g, backoff, meta, transform, func(cur, updated *gardencorev1alpha1.ControllerRegistration) bool {
+		return equality.Semantic.DeepEqual(cur.Status, updated.Status)
+	})
+}
@@ -0,0 +1,414 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kustomize
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// Kustomization is the subset of a kustomization (see https://github.com/kubernetes-sigs/kustomize) which is
+// supported by Gardener. In contrast to kustomize, the resources and patches are embedded instead of being
+// referenced by file paths. Bases and overlays, generators, name prefixes and suffixes, namespaces and vars are
+// not supported.
+type Kustomization struct {
+	// Resources are the objects which are customized.
+	Resources []runtime.RawExtension `json:"resources,omitempty"`
+	// CommonLabels are added to all objects, and to the selectors and pod templates of workloads and services.
+	CommonLabels map[string]string `json:"commonLabels,omitempty"`
+	// CommonAnnotations are added to all objects and to the pod templates of workloads.
+	CommonAnnotations map[string]string `json:"commonAnnotations,omitempty"`
+	// Images overwrite the names, tags or digests of container images.
+	Images []Image `json:"images,omitempty"`
+	// PatchesStrategicMerge are partial objects which are merged into the resources with the same kind, name and
+	// namespace (if given). Objects of kinds which are not known are merged with JSON merge patch semantics.
+	PatchesStrategicMerge []runtime.RawExtension `json:"patchesStrategicMerge,omitempty"`
+	// PatchesJSON6902 are JSON patches (RFC 6902) which are applied to the targeted resources.
+	PatchesJSON6902 []PatchJSON6902 `json:"patchesJson6902,omitempty"`
+}
+
+// Image overwrites the name, tag or digest of the container images with the given name.
+type Image struct {
+	// Name is the name of the image without tag or digest.
+	Name string `json:"name"`
+	// NewName is the name which replaces the name of the image.
+	NewName string `json:"newName,omitempty"`
+	// NewTag is the tag which replaces the tag of the image.
+	NewTag string `json:"newTag,omitempty"`
+	// Digest is the digest which replaces the tag of the image.
+	Digest string `json:"digest,omitempty"`
+}
+
+// PatchJSON6902 is a JSON patch (RFC 6902) for the targeted resource.
+type PatchJSON6902 struct {
+	// Target selects the resource which is patched.
+	Target Target `json:"target"`
+	// Patch is the list of patch operations in JSON or YAML format.
+	Patch string `json:"patch"`
+}
+
+// Target selects a resource by its group, version, kind, name and namespace. Empty fields except the kind and the
+// name match any value.
+type Target struct {
+	Group     string `json:"group,omitempty"`
+	Version   string `json:"version,omitempty"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// workloadKinds are the kinds whose selectors and pod templates are labelled with the common labels.
+var workloadKinds = map[string]bool{
+	"DaemonSet":   true,
+	"Deployment":  true,
+	"ReplicaSet":  true,
+	"StatefulSet": true,
+}
+
+// Decode decodes the given kustomization. Fields which are not supported yield an error instead of being silently
+// ignored.
+func Decode(data []byte) (*Kustomization, error) {
+	kustomization := &Kustomization{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(kustomization); err != nil {
+		return nil, err
+	}
+	return kustomization, nil
+}
+
+// Build returns the resources of the given kustomization after applying the patches, the common labels and
+// annotations, and the image overrides (in this order).
+func Build(kustomization *Kustomization) ([]*unstructured.Unstructured, error) {
+	if len(kustomization.Resources) == 0 {
+		return nil, fmt.Errorf("at least one resource must be specified")
+	}
+
+	var (
+		objects = make([]*unstructured.Unstructured, 0, len(kustomization.Resources))
+		ids     = make(map[string]bool, len(kustomization.Resources))
+	)
+
+	for i, raw := range kustomization.Resources {
+		obj, err := decodeObject(raw.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("resource %d is invalid: %v", i, err)
+		}
+
+		id := objectID(obj)
+		if ids[id] {
+			return nil, fmt.Errorf("resource %d (%s) is duplicated", i, id)
+		}
+		ids[id] = true
+
+		objects = append(objects, obj)
+	}
+
+	for i, raw := range kustomization.PatchesStrategicMerge {
+		patch, err := decodeObject(raw.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("strategic merge patch %d is invalid: %v", i, err)
+		}
+
+		target := Target{
+			Group:     patch.GroupVersionKind().Group,
+			Version:   patch.GroupVersionKind().Version,
+			Kind:      patch.GetKind(),
+			Name:      patch.GetName(),
+			Namespace: patch.GetNamespace(),
+		}
+		if objects, err = patchObjects(objects, target, func(obj *unstructured.Unstructured) (map[string]interface{}, error) {
+			return applyStrategicMergePatch(obj, patch.Object)
+		}); err != nil {
+			return nil, fmt.Errorf("strategic merge patch %d cannot be applied: %v", i, err)
+		}
+	}
+
+	for i, patchJSON6902 := range kustomization.PatchesJSON6902 {
+		if len(patchJSON6902.Target.Kind) == 0 || len(patchJSON6902.Target.Name) == 0 {
+			return nil, fmt.Errorf("the target of JSON patch %d must specify a kind and a name", i)
+		}
+
+		operations, err := yaml.YAMLToJSON([]byte(patchJSON6902.Patch))
+		if err != nil {
+			return nil, fmt.Errorf("JSON patch %d cannot be decoded: %v", i, err)
+		}
+		patch, err := jsonpatch.DecodePatch(operations)
+		if err != nil {
+			return nil, fmt.Errorf("JSON patch %d cannot be decoded: %v", i, err)
+		}
+
+		if objects, err = patchObjects(objects, patchJSON6902.Target, func(obj *unstructured.Unstructured) (map[string]interface{}, error) {
+			return applyJSONPatch(obj, patch)
+		}); err != nil {
+			return nil, fmt.Errorf("JSON patch %d cannot be applied: %v", i, err)
+		}
+	}
+
+	for _, obj := range objects {
+		if err := addLabels(obj, kustomization.CommonLabels); err != nil {
+			return nil, err
+		}
+		if err := addAnnotations(obj, kustomization.CommonAnnotations); err != nil {
+			return nil, err
+		}
+		setImages(obj.Object, kustomization.Images)
+	}
+
+	return objects, nil
+}
+
+// decodeObject decodes the given object and checks that it has an apiVersion, a kind and a name.
+func decodeObject(data []byte) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(data, &obj.Object); err != nil {
+		return nil, err
+	}
+	if len(obj.GetAPIVersion()) == 0 || len(obj.GetKind()) == 0 || len(obj.GetName()) == 0 {
+		return nil, fmt.Errorf("apiVersion, kind and name must be specified")
+	}
+	return obj, nil
+}
+
+func objectID(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s %s/%s", obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName())
+}
+
+func matches(obj *unstructured.Unstructured, target Target) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Kind == target.Kind &&
+		obj.GetName() == target.Name &&
+		(len(target.Group) == 0 || gvk.Group == target.Group) &&
+		(len(target.Version) == 0 || gvk.Version == target.Version) &&
+		(len(target.Namespace) == 0 || obj.GetNamespace() == target.Namespace)
+}
+
+// patchObjects applies the given patch function to the object matching the given target. If the patch function
+// returns an empty object then the object is removed. It is an error if no object matches the target.
+func patchObjects(objects []*unstructured.Unstructured, target Target, patch func(*unstructured.Unstructured) (map[string]interface{}, error)) ([]*unstructured.Unstructured, error) {
+	var (
+		result  = make([]*unstructured.Unstructured, 0, len(objects))
+		matched bool
+	)
+
+	for _, obj := range objects {
+		if !matches(obj, target) {
+			result = append(result, obj)
+			continue
+		}
+		matched = true
+
+		patched, err := patch(obj)
+		if err != nil {
+			return nil, err
+		}
+		if len(patched) > 0 {
+			result = append(result, &unstructured.Unstructured{Object: patched})
+		}
+	}
+
+	if !matched {
+		return nil, fmt.Errorf("no resource matches %s %s", target.Kind, target.Name)
+	}
+	return result, nil
+}
+
+// applyStrategicMergePatch applies the given strategic merge patch to the given object. The patch directive
+// `$patch: delete` removes the object.
+func applyStrategicMergePatch(obj *unstructured.Unstructured, patch map[string]interface{}) (map[string]interface{}, error) {
+	if directive, ok := patch["$patch"]; ok {
+		if directive == "delete" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unsupported patch directive %v", directive)
+	}
+
+	typed, err := scheme.Scheme.New(obj.GroupVersionKind())
+	if err == nil {
+		return strategicpatch.StrategicMergeMapPatch(obj.Object, patch, typed)
+	}
+	if !runtime.IsNotRegisteredError(err) {
+		return nil, err
+	}
+
+	original, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := jsonpatch.MergePatch(original, patchJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{}
+	if err := json.Unmarshal(merged, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func applyJSONPatch(obj *unstructured.Unstructured, patch jsonpatch.Patch) (map[string]interface{}, error) {
+	original, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := patch.Apply(original)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{}
+	if err := json.Unmarshal(patched, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// addLabels adds the given labels to the object. For workloads they are also added to the selector and to the pod
+// template, for jobs to the pod template, and for services to the selector (if the service has one).
+func addLabels(obj *unstructured.Unstructured, labels map[string]string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	var paths [][]string
+	switch kind := obj.GetKind(); {
+	case workloadKinds[kind]:
+		paths = [][]string{{"spec", "selector", "matchLabels"}, {"spec", "template", "metadata", "labels"}}
+	case kind == "Job":
+		paths = [][]string{{"spec", "template", "metadata", "labels"}}
+	case kind == "CronJob":
+		paths = [][]string{{"spec", "jobTemplate", "spec", "template", "metadata", "labels"}}
+	case kind == "Service":
+		if _, ok := obj.Object["spec"].(map[string]interface{})["selector"]; ok {
+			paths = [][]string{{"spec", "selector"}}
+		}
+	}
+
+	return addToMaps(obj.Object, labels, append(paths, []string{"metadata", "labels"})...)
+}
+
+// addAnnotations adds the given annotations to the object and, for workloads and jobs, to the pod template.
+func addAnnotations(obj *unstructured.Unstructured, annotations map[string]string) error {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	var paths [][]string
+	switch kind := obj.GetKind(); {
+	case workloadKinds[kind], kind == "Job":
+		paths = [][]string{{"spec", "template", "metadata", "annotations"}}
+	case kind == "CronJob":
+		paths = [][]string{{"spec", "jobTemplate", "spec", "template", "metadata", "annotations"}}
+	}
+
+	return addToMaps(obj.Object, annotations, append(paths, []string{"metadata", "annotations"})...)
+}
+
+func addToMaps(obj map[string]interface{}, values map[string]string, paths ...[]string) error {
+	for _, path := range paths {
+		existing, _, err := unstructured.NestedStringMap(obj, path...)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			existing = make(map[string]string, len(values))
+		}
+		for key, value := range values {
+			existing[key] = value
+		}
+		if err := unstructured.SetNestedStringMap(obj, existing, path...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setImages overwrites the images of all containers and init containers which are found in the given object.
+func setImages(obj interface{}, images []Image) {
+	if len(images) == 0 {
+		return
+	}
+
+	switch value := obj.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			if containers, ok := child.([]interface{}); ok && (key == "containers" || key == "initContainers") {
+				for _, c := range containers {
+					if container, ok := c.(map[string]interface{}); ok {
+						if image, ok := container["image"].(string); ok {
+							container["image"] = overwriteImage(image, images)
+						}
+					}
+				}
+				continue
+			}
+			setImages(child, images)
+		}
+	case []interface{}:
+		for _, child := range value {
+			setImages(child, images)
+		}
+	}
+}
+
+// overwriteImage returns the given image with the name, tag or digest of the first matching image override.
+func overwriteImage(image string, images []Image) string {
+	name, suffix := splitImage(image)
+
+	for _, override := range images {
+		if override.Name != name {
+			continue
+		}
+
+		if len(override.NewName) > 0 {
+			name = override.NewName
+		}
+		switch {
+		case len(override.Digest) > 0:
+			suffix = "@" + override.Digest
+		case len(override.NewTag) > 0:
+			suffix = ":" + override.NewTag
+		}
+		return name + suffix
+	}
+
+	return image
+}
+
+// splitImage splits the given image into its name and its tag (":<tag>") or digest ("@<digest>").
+func splitImage(image string) (string, string) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		return image[:i], image[i:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i:]
+	}
+	return image, ""
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kustomize_test
+
+import (
+	. "github.com/gardener/gardener/pkg/utils/kustomize"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("kustomize", func() {
+	build := func(kustomization string) ([]*unstructured.Unstructured, error) {
+		data, err := yaml.YAMLToJSON([]byte(kustomization))
+		Expect(err).NotTo(HaveOccurred())
+
+		k, err := Decode(data)
+		Expect(err).NotTo(HaveOccurred())
+
+		return Build(k)
+	}
+
+	toYAML := func(objects []*unstructured.Unstructured) []string {
+		var result []string
+		for _, obj := range objects {
+			data, err := yaml.Marshal(obj.Object)
+			Expect(err).NotTo(HaveOccurred())
+			result = append(result, string(data))
+		}
+		return result
+	}
+
+	nestedStringMap := func(obj map[string]interface{}, fields ...string) map[string]string {
+		value, _, err := unstructured.NestedStringMap(obj, fields...)
+		Expect(err).NotTo(HaveOccurred())
+		return value
+	}
+
+	const deployment = `
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: controller
+  spec:
+    selector:
+      matchLabels:
+        app: controller
+    template:
+      metadata:
+        labels:
+          app: controller
+      spec:
+        initContainers:
+        - name: init
+          image: eu.gcr.io/gardener/init:0.1.0
+        containers:
+        - name: controller
+          image: eu.gcr.io/gardener/controller:0.1.0
+          args:
+          - --foo
+        - name: sidecar
+          image: busybox
+`
+
+	Describe("#Decode", func() {
+		It("should reject unsupported fields", func() {
+			_, err := Decode([]byte(`{"namePrefix":"foo-"}`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Build", func() {
+		It("should add the common labels and annotations", func() {
+			objects, err := build(`
+resources:` + deployment + `
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: controller
+  spec:
+    selector:
+      app: controller
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: config
+commonLabels:
+  extension: foo
+commonAnnotations:
+  owner: bar
+`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(3))
+
+			Expect(objects[0].GetLabels()).To(Equal(map[string]string{"extension": "foo"}))
+			Expect(objects[0].GetAnnotations()).To(Equal(map[string]string{"owner": "bar"}))
+			Expect(nestedStringMap(objects[0].Object, "spec", "selector", "matchLabels")).To(Equal(map[string]string{"app": "controller", "extension": "foo"}))
+			Expect(nestedStringMap(objects[0].Object, "spec", "template", "metadata", "labels")).To(Equal(map[string]string{"app": "controller", "extension": "foo"}))
+			Expect(nestedStringMap(objects[0].Object, "spec", "template", "metadata", "annotations")).To(Equal(map[string]string{"owner": "bar"}))
+
+			Expect(nestedStringMap(objects[1].Object, "spec", "selector")).To(Equal(map[string]string{"app": "controller", "extension": "foo"}))
+
+			Expect(objects[2].GetLabels()).To(Equal(map[string]string{"extension": "foo"}))
+			Expect(objects[2].GetAnnotations()).To(Equal(map[string]string{"owner": "bar"}))
+		})
+
+		It("should overwrite the images", func() {
+			objects, err := build(`
+resources:` + deployment + `
+images:
+- name: eu.gcr.io/gardener/controller
+  newTag: 0.2.0
+- name: eu.gcr.io/gardener/init
+  newName: registry.example.com/init
+  digest: sha256:1234
+- name: busybox
+  newTag: "1.31"
+`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toYAML(objects)[0]).To(And(
+				ContainSubstring("image: eu.gcr.io/gardener/controller:0.2.0"),
+				ContainSubstring("image: registry.example.com/init@sha256:1234"),
+				ContainSubstring("image: busybox:1.31"),
+			))
+		})
+
+		It("should apply strategic merge patches", func() {
+			objects, err := build(`
+resources:` + deployment + `
+- apiVersion: example.com/v1
+  kind: Foo
+  metadata:
+    name: foo
+  spec:
+    replicas: 1
+    other: true
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: config
+patchesStrategicMerge:
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: controller
+  spec:
+    template:
+      spec:
+        containers:
+        - name: controller
+          resources:
+            limits:
+              memory: 1Gi
+- apiVersion: example.com/v1
+  kind: Foo
+  metadata:
+    name: foo
+  spec:
+    replicas: 2
+    other: null
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: config
+  $patch: delete
+`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(2))
+
+			containers, _, _ := unstructured.NestedSlice(objects[0].Object, "spec", "template", "spec", "containers")
+			Expect(containers).To(HaveLen(2))
+			Expect(containers[0]).To(HaveKeyWithValue("args", ConsistOf("--foo")))
+			Expect(containers[0]).To(HaveKeyWithValue("resources", HaveKeyWithValue("limits", HaveKeyWithValue("memory", "1Gi"))))
+
+			Expect(objects[1].Object["spec"]).To(Equal(map[string]interface{}{"replicas": float64(2)}))
+		})
+
+		It("should apply JSON patches", func() {
+			objects, err := build(`
+resources:` + deployment + `
+patchesJson6902:
+- target:
+    group: apps
+    version: v1
+    kind: Deployment
+    name: controller
+  patch: |
+    - op: add
+      path: /spec/template/spec/containers/0/args/-
+      value: --bar
+    - op: remove
+      path: /spec/template/spec/containers/1
+`)
+			Expect(err).NotTo(HaveOccurred())
+
+			containers, _, _ := unstructured.NestedSlice(objects[0].Object, "spec", "template", "spec", "containers")
+			Expect(containers).To(HaveLen(1))
+			Expect(containers[0]).To(HaveKeyWithValue("args", ConsistOf("--foo", "--bar")))
+		})
+
+		DescribeTable("should return an error for invalid kustomizations",
+			func(kustomization string) {
+				_, err := build(kustomization)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("no resources", `{}`),
+			Entry("resource without name", `{"resources":[{"apiVersion":"v1","kind":"ConfigMap"}]}`),
+			Entry("duplicate resources", `{"resources":[{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo"}},{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo"}}]}`),
+			Entry("patch without target", `{"resources":[{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo"}}],"patchesStrategicMerge":[{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"bar"}}]}`),
+			Entry("JSON patch without target", `{"resources":[{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo"}}],"patchesJson6902":[{"target":{"kind":"ConfigMap","name":"bar"},"patch":"[]"}]}`),
+			Entry("invalid JSON patch", `{"resources":[{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo"}}],"patchesJson6902":[{"target":{"kind":"ConfigMap","name":"foo"},"patch":"[{\"op\":\"remove\",\"path\":\"/data/foo\"}]"}]}`),
+		)
+	})
+})
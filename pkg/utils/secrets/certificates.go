@@ -76,6 +76,8 @@ type CertificateSecretConfig struct {
 	CertType  certType
 	SigningCA *Certificate
 	PKCS      int
+	// Validity is the duration for which the certificate is valid. It defaults to 10 years.
+	Validity *time.Duration
 }
 
 // Certificate contains the private key, and the certificate. It does also contain the CA certificate
@@ -214,29 +216,34 @@ func LoadCAFromSecret(k8sClient client.Client, namespace, name string) (*corev1.
 // generateCertificateTemplate creates a X509 Certificate object based on the provided information regarding
 // common name, organization, SANs (DNS names and IP addresses). It can create a server or a client certificate
 // or both, depending on the <certType> value. If <isCACert> is true, then a CA certificate is being created.
-// The certificates a valid for 10 years.
+// The certificates a valid for 10 years unless another validity is configured.
 func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificate {
 	var (
 		serialNumber, _ = rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
 		now             = time.Now()
+		notAfter        = now.AddDate(10, 0, 0) // + 10 years
 		isCA            = s.CertType == CACert
-
-		template = &x509.Certificate{
-			BasicConstraintsValid: true,
-			IsCA:                  isCA,
-			SerialNumber:          serialNumber,
-			NotBefore:             now,
-			NotAfter:              now.AddDate(10, 0, 0), // + 10 years
-			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
-			Subject: pkix.Name{
-				CommonName:   s.CommonName,
-				Organization: s.Organization,
-			},
-			DNSNames:    s.DNSNames,
-			IPAddresses: s.IPAddresses,
-		}
 	)
 
+	if s.Validity != nil {
+		notAfter = now.Add(*s.Validity)
+	}
+
+	template := &x509.Certificate{
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		SerialNumber:          serialNumber,
+		NotBefore:             now,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		Subject: pkix.Name{
+			CommonName:   s.CommonName,
+			Organization: s.Organization,
+		},
+		DNSNames:    s.DNSNames,
+		IPAddresses: s.IPAddresses,
+	}
+
 	switch s.CertType {
 	case CACert:
 		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
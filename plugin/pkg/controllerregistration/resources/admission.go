@@ -18,20 +18,32 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	"github.com/gardener/gardener/pkg/chartrenderer"
 	coreclientset "github.com/gardener/gardener/pkg/client/core/clientset/internalversion"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
 )
 
 const (
 	// PluginName is the name of this admission plugin.
 	PluginName = "ControllerRegistrationResources"
+
+	// AnnotationNotRendered is the key of the audit annotation which lists the ControllerRegistrations whose
+	// deployments could not be rendered at admission time and were therefore not checked for conflicting
+	// cluster-scoped resources.
+	AnnotationNotRendered = "controllerregistrationresources.admission.gardener.cloud/not-rendered"
 )
 
 // Register registers a plugin.
@@ -47,8 +59,25 @@ func NewFactory(config io.Reader) (admission.Interface, error) {
 // Resources contains an admission handler and listers.
 type Resources struct {
 	*admission.Handler
-	coreClient coreclientset.Interface
-	readyFunc  admission.ReadyFunc
+	coreClient    coreclientset.Interface
+	chartRenderer chartrenderer.Interface
+	readyFunc     admission.ReadyFunc
+
+	renderedDeploymentsLock sync.Mutex
+	renderedDeployments     map[string]*renderedDeployment
+}
+
+// renderedDeployment is the result of rendering the deployment of a ControllerRegistration with the given UID and
+// generation.
+type renderedDeployment struct {
+	uid        types.UID
+	generation int64
+
+	// resources are the identifiers of the cluster-scoped objects of the deployment.
+	resources sets.String
+	// notRenderedReason is the reason why the deployment was not rendered, if so.
+	notRenderedReason string
+	err               error
 }
 
 var (
@@ -60,7 +89,9 @@ var (
 // New creates a new Resources admission plugin.
 func New() (*Resources, error) {
 	return &Resources{
-		Handler: admission.NewHandler(admission.Create, admission.Update),
+		Handler:             admission.NewHandler(admission.Create, admission.Update),
+		chartRenderer:       newChartRenderer(),
+		renderedDeployments: map[string]*renderedDeployment{},
 	}, nil
 }
 
@@ -82,7 +113,9 @@ func (r *Resources) ValidateInitialization() error {
 
 // Validate makes admissions decisions based on the resources specified in a ControllerRegistration object.
 // It does reject the request if there is any other existing ControllerRegistration object in the system that
-// specifies the same resource kind/type combination like the incoming object.
+// specifies the same resource kind/type combination like the incoming object, or if the dependencies of the
+// incoming object would form a cycle. Furthermore, the deployment of the incoming object must be renderable without
+// deploying cluster-scoped resources which are also deployed by another ControllerRegistration.
 func (r *Resources) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
 	// Wait until the caches have been synced
 	if r.readyFunc == nil {
@@ -102,13 +135,16 @@ func (r *Resources) Validate(a admission.Attributes, o admission.ObjectInterface
 	// Ignore all kinds other than Shoot or Project.
 	// TODO: in future the Kinds should be configurable
 	// https://v1-9.docs.kubernetes.io/docs/admin/admission-controllers/#imagepolicywebhook
-	if a.GetKind().GroupKind() != core.Kind("ControllerRegistration") {
+	if a.GetKind().GroupKind() != core.Kind("ControllerRegistration") || len(a.GetSubresource()) > 0 {
 		return nil
 	}
 	controllerRegistration, ok := a.GetObject().(*core.ControllerRegistration)
 	if !ok {
 		return apierrors.NewBadRequest("could not convert resource into ControllerRegistration object")
 	}
+	if controllerRegistration.DeletionTimestamp != nil {
+		return nil
+	}
 
 	// Live lookup to prevent missing any data
 	controllerRegistrationList, err := r.coreClient.Core().ControllerRegistrations().List(metav1.ListOptions{})
@@ -133,5 +169,190 @@ func (r *Resources) Validate(a admission.Attributes, o admission.ObjectInterface
 		}
 	}
 
+	if cycle := findDependencyCycle(controllerRegistration, controllerRegistrationList.Items); len(cycle) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("dependencies must not be cyclic: %s", strings.Join(cycle, " -> ")))
+	}
+
+	// The deployment only needs to be validated if it changes, e.g. metadata-only updates like adding finalizers must
+	// not render it again.
+	if a.GetOperation() == admission.Update {
+		if old, ok := a.GetOldObject().(*core.ControllerRegistration); ok && apiequality.Semantic.DeepEqual(old.Spec.Deployment, controllerRegistration.Spec.Deployment) {
+			return nil
+		}
+	}
+
+	return r.validateDeployment(a, controllerRegistration, controllerRegistrationList.Items)
+}
+
+// validateDeployment renders the deployment of the given ControllerRegistration and checks that its cluster-scoped
+// resources are not deployed by any other of the given ControllerRegistrations. Deployments which are not rendered at
+// admission time (e.g., charts referenced via chartRef) or which cannot be rendered anymore are not checked for
+// conflicts but reported in an audit annotation. A broken ControllerRegistration must not block all others.
+func (r *Resources) validateDeployment(a admission.Attributes, controllerRegistration *core.ControllerRegistration, existing []core.ControllerRegistration) error {
+	rendered := r.renderDeployment(controllerRegistration)
+	if rendered.err != nil {
+		return admission.NewForbidden(a, fmt.Errorf("deployment is invalid: %v", rendered.err))
+	}
+
+	notRendered := map[string]string{}
+	defer func() {
+		reportNotRendered(a, notRendered)
+	}()
+
+	if len(rendered.notRenderedReason) > 0 {
+		notRendered[controllerRegistration.Name] = rendered.notRenderedReason
+		return nil
+	}
+	if rendered.resources.Len() == 0 {
+		return nil
+	}
+
+	for i := range existing {
+		other := &existing[i]
+		if other.Name == controllerRegistration.Name || other.DeletionTimestamp != nil {
+			continue
+		}
+
+		otherRendered := r.cachedRenderDeployment(other)
+		if otherRendered.err != nil {
+			notRendered[other.Name] = fmt.Sprintf("rendering failed: %v", otherRendered.err)
+			continue
+		}
+		if len(otherRendered.notRenderedReason) > 0 {
+			notRendered[other.Name] = otherRendered.notRenderedReason
+			continue
+		}
+
+		if conflicts := rendered.resources.Intersection(otherRendered.resources); conflicts.Len() > 0 {
+			return admission.NewForbidden(a, fmt.Errorf("cluster-scoped resources are also deployed by ControllerRegistration %s: %s", other.Name, strings.Join(conflicts.List(), ", ")))
+		}
+	}
+
+	r.pruneRenderedDeployments(existing)
 	return nil
 }
+
+// renderDeployment renders the deployment of the given ControllerRegistration.
+func (r *Resources) renderDeployment(controllerRegistration *core.ControllerRegistration) *renderedDeployment {
+	objects, notRenderedReason, err := renderDeployment(r.chartRenderer, controllerRegistration)
+	return &renderedDeployment{
+		uid:               controllerRegistration.UID,
+		generation:        controllerRegistration.Generation,
+		resources:         clusterScopedResources(objects),
+		notRenderedReason: notRenderedReason,
+		err:               err,
+	}
+}
+
+// cachedRenderDeployment renders the deployment of the given existing ControllerRegistration. As the deployment only
+// changes together with the generation, the result is cached per UID and generation of the ControllerRegistration.
+func (r *Resources) cachedRenderDeployment(controllerRegistration *core.ControllerRegistration) *renderedDeployment {
+	r.renderedDeploymentsLock.Lock()
+	cached, ok := r.renderedDeployments[controllerRegistration.Name]
+	r.renderedDeploymentsLock.Unlock()
+
+	if ok && cached.uid == controllerRegistration.UID && cached.generation == controllerRegistration.Generation {
+		return cached
+	}
+
+	rendered := r.renderDeployment(controllerRegistration)
+
+	r.renderedDeploymentsLock.Lock()
+	r.renderedDeployments[controllerRegistration.Name] = rendered
+	r.renderedDeploymentsLock.Unlock()
+
+	return rendered
+}
+
+// pruneRenderedDeployments removes the cached deployments of ControllerRegistrations which do not exist anymore.
+func (r *Resources) pruneRenderedDeployments(existing []core.ControllerRegistration) {
+	names := sets.NewString()
+	for _, controllerRegistration := range existing {
+		names.Insert(controllerRegistration.Name)
+	}
+
+	r.renderedDeploymentsLock.Lock()
+	defer r.renderedDeploymentsLock.Unlock()
+
+	for name := range r.renderedDeployments {
+		if !names.Has(name) {
+			delete(r.renderedDeployments, name)
+		}
+	}
+}
+
+// reportNotRendered adds an audit annotation to the request which lists the ControllerRegistrations whose deployments
+// were not rendered together with the reason.
+func reportNotRendered(a admission.Attributes, notRendered map[string]string) {
+	if len(notRendered) == 0 {
+		return
+	}
+
+	entries := make([]string, 0, len(notRendered))
+	for name, reason := range notRendered {
+		entries = append(entries, fmt.Sprintf("%s (%s)", name, reason))
+	}
+	sort.Strings(entries)
+
+	// Failing to add the annotation must not fail the request as the deployment itself is valid.
+	_ = a.AddAnnotation(AnnotationNotRendered, strings.Join(entries, ", "))
+}
+
+// findDependencyCycle returns the names of the ControllerRegistrations forming a dependency cycle which contains the
+// given ControllerRegistration. The given list of existing ControllerRegistrations may contain an older version of it.
+// It returns nil if there is no such cycle.
+func findDependencyCycle(controllerRegistration *core.ControllerRegistration, existing []core.ControllerRegistration) []string {
+	controllerRegistrations := []*core.ControllerRegistration{controllerRegistration}
+	for i := range existing {
+		if existing[i].Name != controllerRegistration.Name {
+			controllerRegistrations = append(controllerRegistrations, &existing[i])
+		}
+	}
+
+	var (
+		visited = map[string]bool{}
+		visit   func(current *core.ControllerRegistration, path []string) []string
+	)
+
+	visit = func(current *core.ControllerRegistration, path []string) []string {
+		path = append(path, current.Name)
+		for _, other := range controllerRegistrations {
+			if !dependsOn(current, other) {
+				continue
+			}
+			if other.Name == controllerRegistration.Name {
+				return append(path, other.Name)
+			}
+			if visited[other.Name] {
+				continue
+			}
+			visited[other.Name] = true
+			if cycle := visit(other, path); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+
+	return visit(controllerRegistration, nil)
+}
+
+func dependsOn(controllerRegistration, other *core.ControllerRegistration) bool {
+	if controllerRegistration.Name == other.Name {
+		return false
+	}
+	for _, dependency := range controllerRegistration.Spec.Dependencies {
+		if len(dependency.Name) > 0 {
+			if dependency.Name == other.Name {
+				return true
+			}
+			continue
+		}
+		for _, resource := range other.Spec.Resources {
+			if resource.Kind == dependency.Kind && resource.Type == dependency.Type {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -15,6 +15,12 @@
 package resources_test
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/client/core/clientset/internalversion/fake"
 	. "github.com/gardener/gardener/plugin/pkg/controllerregistration/resources"
@@ -112,6 +118,308 @@ var _ = Describe("resources", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(apierrors.IsForbidden(err)).To(BeTrue())
 		})
+
+		It("should allow the object because its dependencies are not cyclic", func() {
+			controllerRegistration.Spec.Dependencies = []core.ControllerDependency{{Name: "provider"}}
+			attrs = admission.NewAttributesRecord(&controllerRegistration, nil, core.Kind("ControllerRegistration").WithVersion("version"), "", controllerRegistration.Name, core.Resource("controllerregistrations").WithVersion("version"), "", admission.Create, false, nil)
+
+			provider := core.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider"},
+				Spec: core.ControllerRegistrationSpec{
+					Dependencies: []core.ControllerDependency{{Name: "other"}},
+				},
+			}
+			other := core.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "other"},
+				Spec: core.ControllerRegistrationSpec{
+					Dependencies: []core.ControllerDependency{{Name: "provider"}},
+				},
+			}
+
+			coreClient.AddReactor("list", "controllerregistrations", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, &core.ControllerRegistrationList{
+					Items: []core.ControllerRegistration{provider, other},
+				}, nil
+			})
+
+			err := admissionHandler.Validate(attrs, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should deny the object because its dependencies are cyclic", func() {
+			controllerRegistration.Spec.Dependencies = []core.ControllerDependency{{Name: "provider"}}
+			attrs = admission.NewAttributesRecord(&controllerRegistration, nil, core.Kind("ControllerRegistration").WithVersion("version"), "", controllerRegistration.Name, core.Resource("controllerregistrations").WithVersion("version"), "", admission.Create, false, nil)
+
+			provider := core.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider"},
+				Spec: core.ControllerRegistrationSpec{
+					Dependencies: []core.ControllerDependency{{Name: "networking"}},
+				},
+			}
+			networking := core.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "networking"},
+				Spec: core.ControllerRegistrationSpec{
+					Dependencies: []core.ControllerDependency{{Kind: resourceKind, Type: resourceType}},
+				},
+			}
+
+			coreClient.AddReactor("list", "controllerregistrations", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, &core.ControllerRegistrationList{
+					Items: []core.ControllerRegistration{provider, networking},
+				}, nil
+			})
+
+			err := admissionHandler.Validate(attrs, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("dummy -> provider -> networking -> dummy"))
+		})
+
+		Context("deployment", func() {
+			var (
+				clusterRole = `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: {{ .Values.clusterRoleName }}
+rules: []
+`
+				serviceAccount = `apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller
+  namespace: {{ .Release.Namespace }}
+`
+				rawDeployment = func(deploymentType, providerConfig string) *core.ControllerDeployment {
+					return &core.ControllerDeployment{
+						Type:           deploymentType,
+						ProviderConfig: &core.ProviderConfig{RawExtension: runtime.RawExtension{Raw: []byte(providerConfig)}},
+					}
+				}
+				helmDeployment = func(chart []byte, values map[string]interface{}) *core.ControllerDeployment {
+					raw, err := json.Marshal(map[string]interface{}{"chart": chart, "values": values})
+					Expect(err).NotTo(HaveOccurred())
+					return &core.ControllerDeployment{
+						Type:           "helm",
+						ProviderConfig: &core.ProviderConfig{RawExtension: runtime.RawExtension{Raw: raw}},
+					}
+				}
+			)
+
+			BeforeEach(func() {
+				attrs = admission.NewAttributesRecord(&controllerRegistration, nil, core.Kind("ControllerRegistration").WithVersion("version"), "", controllerRegistration.Name, core.Resource("controllerregistrations").WithVersion("version"), "", admission.Create, false, nil)
+			})
+
+			It("should allow the object because the chart can be rendered", func() {
+				controllerRegistration.Spec.Deployment = helmDeployment(chartArchive(clusterRole, serviceAccount), map[string]interface{}{"clusterRoleName": "foo"})
+
+				err := admissionHandler.Validate(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should deny the object because the chart cannot be rendered", func() {
+				controllerRegistration.Spec.Deployment = helmDeployment(chartArchive(`{{ required "foo is required" .Values.foo }}`), nil)
+
+				err := admissionHandler.Validate(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("foo is required"))
+			})
+
+			It("should deny the object because its cluster-scoped resources conflict with another registration", func() {
+				controllerRegistration.Spec.Deployment = helmDeployment(chartArchive(clusterRole, serviceAccount), map[string]interface{}{"clusterRoleName": "foo"})
+
+				other := core.ControllerRegistration{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Spec: core.ControllerRegistrationSpec{
+						Deployment: helmDeployment(chartArchive(clusterRole, serviceAccount), map[string]interface{}{"clusterRoleName": "foo"}),
+					},
+				}
+
+				coreClient.AddReactor("list", "controllerregistrations", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, &core.ControllerRegistrationList{
+						Items: []core.ControllerRegistration{other},
+					}, nil
+				})
+
+				err := admissionHandler.Validate(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("ClusterRole.rbac.authorization.k8s.io foo"))
+			})
+
+			It("should allow the object because only namespaced resources are deployed by both registrations", func() {
+				controllerRegistration.Spec.Deployment = helmDeployment(chartArchive(clusterRole, serviceAccount), map[string]interface{}{"clusterRoleName": "foo"})
+
+				other := core.ControllerRegistration{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Spec: core.ControllerRegistrationSpec{
+						Deployment: helmDeployment(chartArchive(clusterRole, serviceAccount), map[string]interface{}{"clusterRoleName": "bar"}),
+					},
+				}
+
+				coreClient.AddReactor("list", "controllerregistrations", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, &core.ControllerRegistrationList{
+						Items: []core.ControllerRegistration{other},
+					}, nil
+				})
+
+				err := admissionHandler.Validate(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should deny the object because its cluster-scoped resources conflict with a kustomization of another registration", func() {
+				controllerRegistration.Spec.Deployment = helmDeployment(chartArchive(clusterRole, serviceAccount), map[string]interface{}{"clusterRoleName": "foo"})
+
+				other := core.ControllerRegistration{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Spec: core.ControllerRegistrationSpec{
+						Deployment: rawDeployment("kustomize", `{"kustomization":{"resources":[{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"foo"}}]}}`),
+					},
+				}
+
+				coreClient.AddReactor("list", "controllerregistrations", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, &core.ControllerRegistrationList{
+						Items: []core.ControllerRegistration{other},
+					}, nil
+				})
+
+				err := admissionHandler.Validate(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("ClusterRole.rbac.authorization.k8s.io foo"))
+			})
+
+			It("should deny the object because the kustomization cannot be built", func() {
+				controllerRegistration.Spec.Deployment = rawDeployment("kustomize", `{"kustomization":{}}`)
+
+				err := admissionHandler.Validate(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("kustomization cannot be built"))
+			})
+
+			It("should allow the object but report that the deployment of another registration cannot be rendered", func() {
+				controllerRegistration.Spec.Deployment = helmDeployment(chartArchive(clusterRole, serviceAccount), map[string]interface{}{"clusterRoleName": "foo"})
+
+				other := core.ControllerRegistration{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Spec: core.ControllerRegistrationSpec{
+						Deployment: helmDeployment(chartArchive(`{{ required "foo is required" .Values.foo }}`), nil),
+					},
+				}
+
+				coreClient.AddReactor("list", "controllerregistrations", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, &core.ControllerRegistrationList{
+						Items: []core.ControllerRegistration{other},
+					}, nil
+				})
+
+				annotatedAttrs := &annotatedAttributes{Attributes: attrs}
+				err := admissionHandler.Validate(annotatedAttrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(annotatedAttrs.annotations).To(HaveKeyWithValue(AnnotationNotRendered, ContainSubstring("other (rendering failed: ")))
+				Expect(annotatedAttrs.annotations[AnnotationNotRendered]).To(ContainSubstring("foo is required"))
+			})
+
+			It("should not render the deployment again if it is not changed by an update", func() {
+				controllerRegistration.Spec.Deployment = helmDeployment(chartArchive(`{{ required "foo is required" .Values.foo }}`), nil)
+				newControllerRegistration := controllerRegistration.DeepCopy()
+				newControllerRegistration.Finalizers = []string{"core.gardener.cloud/controllerregistration"}
+				attrs = admission.NewAttributesRecord(newControllerRegistration, &controllerRegistration, core.Kind("ControllerRegistration").WithVersion("version"), "", controllerRegistration.Name, core.Resource("controllerregistrations").WithVersion("version"), "", admission.Update, false, nil)
+
+				Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+			})
+
+			It("should render the deployment again if it is changed by an update", func() {
+				newControllerRegistration := controllerRegistration.DeepCopy()
+				newControllerRegistration.Spec.Deployment = helmDeployment(chartArchive(`{{ required "foo is required" .Values.foo }}`), nil)
+				attrs = admission.NewAttributesRecord(newControllerRegistration, &controllerRegistration, core.Kind("ControllerRegistration").WithVersion("version"), "", controllerRegistration.Name, core.Resource("controllerregistrations").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Validate(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should allow the object but report the deployments which are not rendered", func() {
+				controllerRegistration.Spec.Deployment = helmDeployment(chartArchive(clusterRole, serviceAccount), map[string]interface{}{"clusterRoleName": "foo"})
+
+				others := []core.ControllerRegistration{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "chart-ref"},
+						Spec: core.ControllerRegistrationSpec{
+							Deployment: rawDeployment("helm", `{"chartRef":{"name":"foo"}}`),
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "unknown"},
+						Spec: core.ControllerRegistrationSpec{
+							Deployment: rawDeployment("foo", `{}`),
+						},
+					},
+				}
+
+				coreClient.AddReactor("list", "controllerregistrations", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, &core.ControllerRegistrationList{
+						Items: others,
+					}, nil
+				})
+
+				annotatedAttrs := &annotatedAttributes{Attributes: attrs}
+				err := admissionHandler.Validate(annotatedAttrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(annotatedAttrs.annotations).To(HaveKeyWithValue(AnnotationNotRendered, `chart-ref (chart is referenced via chartRef), unknown (deployment type "foo" is not rendered at admission time)`))
+			})
+
+			It("should allow the object but report that its own deployment is not rendered", func() {
+				controllerRegistration.Spec.Deployment = rawDeployment("helm", `{"chartRef":{"name":"foo"}}`)
+
+				annotatedAttrs := &annotatedAttributes{Attributes: attrs}
+				err := admissionHandler.Validate(annotatedAttrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(annotatedAttrs.annotations).To(HaveKeyWithValue(AnnotationNotRendered, "dummy (chart is referenced via chartRef)"))
+			})
+
+			It("should render the deployments of other registrations only once per generation", func() {
+				controllerRegistration.Spec.Deployment = helmDeployment(chartArchive(clusterRole, serviceAccount), map[string]interface{}{"clusterRoleName": "foo"})
+
+				other := core.ControllerRegistration{
+					ObjectMeta: metav1.ObjectMeta{Name: "other", UID: "1", Generation: 1},
+					Spec: core.ControllerRegistrationSpec{
+						Deployment: helmDeployment(chartArchive(clusterRole, serviceAccount), map[string]interface{}{"clusterRoleName": "bar"}),
+					},
+				}
+
+				coreClient.AddReactor("list", "controllerregistrations", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, &core.ControllerRegistrationList{
+						Items: []core.ControllerRegistration{other},
+					}, nil
+				})
+
+				Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+
+				// The changed deployment is not rendered again as long as the generation does not change.
+				other.Spec.Deployment = helmDeployment(chartArchive(clusterRole, serviceAccount), map[string]interface{}{"clusterRoleName": "foo"})
+				Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+
+				other.Generation = 2
+				err := admissionHandler.Validate(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
 	})
 
 	Describe("#Register", func() {
@@ -156,3 +464,41 @@ var _ = Describe("resources", func() {
 		})
 	})
 })
+
+// chartArchive returns a gzipped chart tarball with the given templates.
+func chartArchive(templates ...string) []byte {
+	files := map[string]string{
+		"test/Chart.yaml": "apiVersion: v1\nname: test\nversion: 0.1.0\n",
+	}
+	for i, template := range templates {
+		files[fmt.Sprintf("test/templates/template-%d.yaml", i)] = template
+	}
+
+	var (
+		buf        bytes.Buffer
+		gzipWriter = gzip.NewWriter(&buf)
+		tarWriter  = tar.NewWriter(gzipWriter)
+	)
+	for name, content := range files {
+		Expect(tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content))})).To(Succeed())
+		_, err := tarWriter.Write([]byte(content))
+		Expect(err).NotTo(HaveOccurred())
+	}
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzipWriter.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+// annotatedAttributes records the audit annotations which are added to the wrapped attributes.
+type annotatedAttributes struct {
+	admission.Attributes
+	annotations map[string]string
+}
+
+func (a *annotatedAttributes) AddAnnotation(key, value string) error {
+	if a.annotations == nil {
+		a.annotations = map[string]string{}
+	}
+	a.annotations[key] = value
+	return nil
+}
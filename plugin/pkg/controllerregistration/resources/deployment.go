@@ -0,0 +1,232 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/kustomize"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/helm/pkg/chartutil"
+	"k8s.io/helm/pkg/engine"
+)
+
+const (
+	deploymentTypeHelm      = "helm"
+	deploymentTypeManifest  = "manifest"
+	deploymentTypeKustomize = "kustomize"
+
+	// renderNamespace is the namespace into which charts are rendered at admission time. The actual namespace is only
+	// known once the controller is installed to a seed.
+	renderNamespace = "extension-admission"
+)
+
+// renderCapabilities is the capabilities set against which charts are rendered at admission time as the seeds to which
+// they are installed are not known.
+var renderCapabilities = &chartutil.Capabilities{
+	APIVersions: chartutil.NewVersionSet(
+		"v1",
+		"admissionregistration.k8s.io/v1beta1",
+		"apiextensions.k8s.io/v1beta1",
+		"apps/v1",
+		"autoscaling/v1",
+		"autoscaling/v2beta1",
+		"batch/v1",
+		"batch/v1beta1",
+		"networking.k8s.io/v1",
+		"policy/v1beta1",
+		"rbac.authorization.k8s.io/v1",
+		"scheduling.k8s.io/v1",
+		"storage.k8s.io/v1",
+	),
+	KubeVersion: &version.Info{
+		Major:      "1",
+		Minor:      "16",
+		GitVersion: "v1.16.0",
+	},
+}
+
+// renderValues are placeholders for the standard values which are mixed in by the ControllerInstallation controller.
+var renderValues = map[string]interface{}{
+	"gardener": map[string]interface{}{
+		"garden": map[string]interface{}{
+			"identity": "",
+		},
+		"seed": map[string]interface{}{
+			"identity":        "",
+			"provider":        "",
+			"volumeProvider":  "",
+			"volumeProviders": []interface{}{},
+			"region":          "",
+			"ingressDomain":   "",
+			"blockCIDRs":      []interface{}{},
+			"protected":       false,
+			"visible":         true,
+			"networks":        map[string]interface{}{},
+		},
+	},
+}
+
+// clusterScopedKinds are the well-known kinds of cluster-scoped resources which are checked for conflicts between
+// ControllerRegistrations.
+var clusterScopedKinds = sets.NewString(
+	"APIService",
+	"ClusterRole",
+	"ClusterRoleBinding",
+	"CustomResourceDefinition",
+	"MutatingWebhookConfiguration",
+	"Namespace",
+	"PersistentVolume",
+	"PodSecurityPolicy",
+	"PriorityClass",
+	"StorageClass",
+	"ValidatingWebhookConfiguration",
+)
+
+type helmDeployment struct {
+	Chart    []byte                 `json:"chart,omitempty"`
+	ChartRef *json.RawMessage       `json:"chartRef,omitempty"`
+	Values   map[string]interface{} `json:"values,omitempty"`
+}
+
+type manifestDeployment struct {
+	Manifests []runtime.RawExtension `json:"manifests,omitempty"`
+}
+
+type kustomizeDeployment struct {
+	Kustomization json.RawMessage `json:"kustomization,omitempty"`
+}
+
+// newChartRenderer returns a chart renderer which uses the fake capabilities for admission.
+func newChartRenderer() chartrenderer.Interface {
+	return chartrenderer.New(engine.New(), renderCapabilities)
+}
+
+// renderDeployment renders the objects deployed by the given ControllerRegistration. Deployments which cannot be
+// rendered at admission time, e.g. because the chart is stored in a remote repository, yield no objects but the reason
+// why they were not rendered.
+func renderDeployment(chartRenderer chartrenderer.Interface, controllerRegistration *core.ControllerRegistration) ([]*unstructured.Unstructured, string, error) {
+	deployment := controllerRegistration.Spec.Deployment
+	if deployment == nil || deployment.ProviderConfig == nil {
+		return nil, "", nil
+	}
+
+	switch deployment.Type {
+	case deploymentTypeHelm:
+		var config helmDeployment
+		if err := json.Unmarshal(deployment.ProviderConfig.Raw, &config); err != nil {
+			return nil, "", fmt.Errorf("chart information cannot be unmarshalled: %v", err)
+		}
+		if config.ChartRef != nil {
+			return nil, "chart is referenced via chartRef", nil
+		}
+		if len(config.Chart) == 0 {
+			return nil, "", nil
+		}
+
+		release, err := chartRenderer.RenderArchive(config.Chart, controllerRegistration.Name, renderNamespace, utils.MergeMaps(config.Values, renderValues))
+		if err != nil {
+			return nil, "", fmt.Errorf("chart cannot be rendered: %v", err)
+		}
+		objects, err := decodeObjects(release.Manifest())
+		return objects, "", err
+
+	case deploymentTypeManifest:
+		var config manifestDeployment
+		if err := json.Unmarshal(deployment.ProviderConfig.Raw, &config); err != nil {
+			return nil, "", fmt.Errorf("manifest information cannot be unmarshalled: %v", err)
+		}
+
+		objects := make([]*unstructured.Unstructured, 0, len(config.Manifests))
+		for i, raw := range config.Manifests {
+			obj := &unstructured.Unstructured{}
+			if err := json.Unmarshal(raw.Raw, &obj.Object); err != nil {
+				return nil, "", fmt.Errorf("manifest %d cannot be unmarshalled: %v", i, err)
+			}
+			objects = append(objects, obj)
+		}
+		return objects, "", nil
+
+	case deploymentTypeKustomize:
+		var config kustomizeDeployment
+		if err := json.Unmarshal(deployment.ProviderConfig.Raw, &config); err != nil {
+			return nil, "", fmt.Errorf("kustomization cannot be unmarshalled: %v", err)
+		}
+		if len(config.Kustomization) == 0 {
+			return nil, "", fmt.Errorf("kustomization must be specified")
+		}
+
+		kustomization, err := kustomize.Decode(config.Kustomization)
+		if err != nil {
+			return nil, "", fmt.Errorf("kustomization cannot be decoded: %v", err)
+		}
+		objects, err := kustomize.Build(kustomization)
+		if err != nil {
+			return nil, "", fmt.Errorf("kustomization cannot be built: %v", err)
+		}
+		return objects, "", nil
+	}
+
+	return nil, fmt.Sprintf("deployment type %q is not rendered at admission time", deployment.Type), nil
+}
+
+func decodeObjects(manifest []byte) ([]*unstructured.Unstructured, error) {
+	var (
+		objects []*unstructured.Unstructured
+		decoder = yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 1024)
+	)
+
+	for {
+		var decodedObj map[string]interface{}
+		if err := decoder.Decode(&decodedObj); err != nil {
+			if err == io.EOF {
+				return objects, nil
+			}
+			return nil, fmt.Errorf("rendered manifest cannot be decoded: %v", err)
+		}
+		if decodedObj == nil {
+			continue
+		}
+		objects = append(objects, &unstructured.Unstructured{Object: decodedObj})
+	}
+}
+
+// clusterScopedResources returns the identifiers of the cluster-scoped objects among the given objects.
+func clusterScopedResources(objects []*unstructured.Unstructured) sets.String {
+	result := sets.NewString()
+	for _, obj := range objects {
+		gvk := obj.GroupVersionKind()
+		if clusterScopedKinds.Has(gvk.Kind) {
+			result.Insert(clusterScopedResourceKey(gvk.GroupKind(), obj.GetName()))
+		}
+	}
+	return result
+}
+
+func clusterScopedResourceKey(groupKind schema.GroupKind, name string) string {
+	return fmt.Sprintf("%s %s", groupKind.String(), name)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectresourcequota
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	kubeinformers "k8s.io/client-go/informers"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ProjectResourceQuota"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, NewFactory)
+}
+
+// NewFactory creates a new PluginFactory.
+func NewFactory(config io.Reader) (admission.Interface, error) {
+	return New()
+}
+
+// ProjectResourceQuota contains an admission handler and listers.
+type ProjectResourceQuota struct {
+	*admission.Handler
+	resourceQuotaLister kubecorev1listers.ResourceQuotaLister
+	shootLister         gardenlisters.ShootLister
+	secretBindingLister gardenlisters.SecretBindingLister
+	readyFunc           admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsInternalGardenInformerFactory(&ProjectResourceQuota{})
+	_ = admissioninitializer.WantsKubeInformerFactory(&ProjectResourceQuota{})
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new ProjectResourceQuota admission plugin.
+func New() (*ProjectResourceQuota, error) {
+	return &ProjectResourceQuota{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (p *ProjectResourceQuota) AssignReadyFunc(f admission.ReadyFunc) {
+	p.readyFunc = f
+	p.SetReadyFunc(f)
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (p *ProjectResourceQuota) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
+	shootInformer := f.Garden().InternalVersion().Shoots()
+	p.shootLister = shootInformer.Lister()
+
+	secretBindingInformer := f.Garden().InternalVersion().SecretBindings()
+	p.secretBindingLister = secretBindingInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced)
+}
+
+// SetKubeInformerFactory gets Lister from SharedInformerFactory.
+func (p *ProjectResourceQuota) SetKubeInformerFactory(f kubeinformers.SharedInformerFactory) {
+	resourceQuotaInformer := f.Core().V1().ResourceQuotas()
+	p.resourceQuotaLister = resourceQuotaInformer.Lister()
+
+	readyFuncs = append(readyFuncs, resourceQuotaInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (p *ProjectResourceQuota) ValidateInitialization() error {
+	if p.resourceQuotaLister == nil {
+		return errors.New("missing resource quota lister")
+	}
+	if p.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
+	if p.secretBindingLister == nil {
+		return errors.New("missing secret binding lister")
+	}
+	return nil
+}
+
+// Validate rejects the creation of Shoots and SecretBindings if the object count quota defined by a ResourceQuota in
+// the namespace (e.g., `count/shoots.core.gardener.cloud`) would be exceeded. Such quotas are not enforced by the
+// Kubernetes API server because these resources are served by the Gardener API server.
+func (p *ProjectResourceQuota) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	var countFunc func() (int, error)
+
+	switch a.GetKind().GroupKind() {
+	case garden.Kind("Shoot"), core.Kind("Shoot"):
+		countFunc = func() (int, error) {
+			list, err := p.shootLister.Shoots(a.GetNamespace()).List(labels.Everything())
+			return len(list), err
+		}
+	case garden.Kind("SecretBinding"), core.Kind("SecretBinding"):
+		countFunc = func() (int, error) {
+			list, err := p.secretBindingLister.SecretBindings(a.GetNamespace()).List(labels.Everything())
+			return len(list), err
+		}
+	default:
+		return nil
+	}
+
+	// Ignore subresources.
+	if len(a.GetSubresource()) != 0 {
+		return nil
+	}
+
+	// Wait until the caches have been synced
+	if p.readyFunc == nil {
+		p.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !p.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	resourceQuotas, err := p.resourceQuotaLister.ResourceQuotas(a.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+
+	var (
+		resourceNames = objectCountResourceNames(a.GetResource().Resource)
+		used          *int
+	)
+
+	for _, resourceQuota := range resourceQuotas {
+		for _, resourceName := range resourceNames {
+			hard, ok := resourceQuota.Spec.Hard[resourceName]
+			if !ok {
+				continue
+			}
+
+			if used == nil {
+				count, err := countFunc()
+				if err != nil {
+					return admission.NewForbidden(a, err)
+				}
+				used = &count
+			}
+
+			if requested := resource.NewQuantity(int64(*used+1), resource.DecimalSI); requested.Cmp(hard) > 0 {
+				return admission.NewForbidden(a, fmt.Errorf("exceeded quota: %s, requested: %s=1, used: %s=%d, limited: %s=%s", resourceQuota.Name, resourceName, resourceName, *used, resourceName, hard.String()))
+			}
+		}
+	}
+
+	return nil
+}
+
+// objectCountResourceNames returns the object count resource names of the given resource for both API groups it is
+// served in. Objects in both groups are the same, hence, quotas defined for either group apply.
+func objectCountResourceNames(resource string) []corev1.ResourceName {
+	var out []corev1.ResourceName
+	for _, gr := range []schema.GroupResource{core.Resource(resource), garden.Resource(resource)} {
+		out = append(out, corev1.ResourceName("count/"+gr.String()))
+	}
+	return out
+}
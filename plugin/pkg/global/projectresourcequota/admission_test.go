@@ -0,0 +1,147 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectresourcequota_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	. "github.com/gardener/gardener/plugin/pkg/global/projectresourcequota"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ = Describe("projectresourcequota", func() {
+	Describe("#Validate", func() {
+		const namespace = "garden-dev"
+
+		var (
+			admissionHandler *ProjectResourceQuota
+
+			gardenInformerFactory gardeninformers.SharedInformerFactory
+			kubeInformerFactory   kubeinformers.SharedInformerFactory
+
+			shootStore         cache.Store
+			secretBindingStore cache.Store
+			resourceQuotaStore cache.Store
+
+			shoot         garden.Shoot
+			secretBinding garden.SecretBinding
+			resourceQuota corev1.ResourceQuota
+		)
+
+		BeforeEach(func() {
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+
+			kubeInformerFactory = kubeinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetKubeInformerFactory(kubeInformerFactory)
+
+			shootStore = gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore()
+			secretBindingStore = gardenInformerFactory.Garden().InternalVersion().SecretBindings().Informer().GetStore()
+			resourceQuotaStore = kubeInformerFactory.Core().V1().ResourceQuotas().Informer().GetStore()
+
+			shoot = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: namespace,
+				},
+			}
+			secretBinding = garden.SecretBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secretbinding",
+					Namespace: namespace,
+				},
+			}
+			resourceQuota = corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gardener",
+					Namespace: namespace,
+				},
+				Spec: corev1.ResourceQuotaSpec{
+					Hard: corev1.ResourceList{
+						"count/shoots.core.gardener.cloud":        resource.MustParse("1"),
+						"count/secretbindings.garden.sapcloud.io": resource.MustParse("2"),
+					},
+				},
+			}
+		})
+
+		It("should do nothing because the resource is neither a Shoot nor a SecretBinding", func() {
+			Expect(resourceQuotaStore.Add(&resourceQuota)).To(Succeed())
+			attrs := admission.NewAttributesRecord(&garden.Project{}, nil, garden.Kind("Project").WithVersion("version"), "", "dev", garden.Resource("projects").WithVersion("version"), "", admission.Create, false, nil)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+		})
+
+		It("should allow creating a shoot if there is no resource quota", func() {
+			Expect(shootStore.Add(&shoot)).To(Succeed())
+			attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), namespace, "other", core.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+		})
+
+		It("should allow creating a shoot if the quota is not exceeded", func() {
+			Expect(resourceQuotaStore.Add(&resourceQuota)).To(Succeed())
+			attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+		})
+
+		It("should forbid creating a shoot via the garden API group if the quota of the core API group is exceeded", func() {
+			Expect(resourceQuotaStore.Add(&resourceQuota)).To(Succeed())
+			Expect(shootStore.Add(&shoot)).To(Succeed())
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), namespace, "other", garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+			err := admissionHandler.Validate(attrs, nil)
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("exceeded quota: gardener, requested: count/shoots.core.gardener.cloud=1, used: count/shoots.core.gardener.cloud=1, limited: count/shoots.core.gardener.cloud=1"))
+		})
+
+		It("should allow creating a secret binding if the quota is not exceeded", func() {
+			Expect(resourceQuotaStore.Add(&resourceQuota)).To(Succeed())
+			Expect(secretBindingStore.Add(&secretBinding)).To(Succeed())
+			attrs := admission.NewAttributesRecord(&secretBinding, nil, core.Kind("SecretBinding").WithVersion("version"), namespace, "other", core.Resource("secretbindings").WithVersion("version"), "", admission.Create, false, nil)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+		})
+
+		It("should forbid creating a secret binding if the quota is exceeded", func() {
+			Expect(resourceQuotaStore.Add(&resourceQuota)).To(Succeed())
+			for _, name := range []string{"one", "two"} {
+				obj := secretBinding.DeepCopy()
+				obj.Name = name
+				Expect(secretBindingStore.Add(obj)).To(Succeed())
+			}
+			attrs := admission.NewAttributesRecord(&secretBinding, nil, core.Kind("SecretBinding").WithVersion("version"), namespace, "three", core.Resource("secretbindings").WithVersion("version"), "", admission.Create, false, nil)
+
+			err := admissionHandler.Validate(attrs, nil)
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+})
@@ -30,6 +30,7 @@ import (
 	"github.com/gardener/gardener/plugin/pkg/utils"
 
 	rbacv1 "k8s.io/api/rbac/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
@@ -244,6 +245,22 @@ func (r *ReferenceManager) Admit(a admission.Attributes, o admission.ObjectInter
 			}
 		}
 		if a.GetOperation() == admission.Update {
+			oldProject, ok := a.GetOldObject().(*garden.Project)
+			if !ok {
+				return apierrors.NewBadRequest("could not convert old resource into Project object")
+			}
+			if !apiequality.Semantic.DeepEqual(project.Spec.ProjectMembers, oldProject.Spec.ProjectMembers) ||
+				!apiequality.Semantic.DeepEqual(project.Spec.ServiceAccounts, oldProject.Spec.ServiceAccounts) {
+				if err := r.ensureProjectMembersManageable(a, project); err != nil {
+					return admission.NewForbidden(a, err)
+				}
+			}
+			if projectSettingsChanged(project, oldProject) {
+				if err := r.ensureProjectManageable(a, project); err != nil {
+					return admission.NewForbidden(a, err)
+				}
+			}
+
 			if createdBy, ok := project.Annotations[common.GardenCreatedBy]; ok {
 				project.Spec.CreatedBy = &rbacv1.Subject{
 					APIGroup: "rbac.authorization.k8s.io",
@@ -276,6 +293,54 @@ func (r *ReferenceManager) Admit(a admission.Attributes, o admission.ObjectInter
 	return nil
 }
 
+// ensureProjectMembersManageable checks whether the user is allowed to manage the members of the given project. Service
+// accounts of the project are considered as members as well.
+func (r *ReferenceManager) ensureProjectMembersManageable(attributes admission.Attributes, project *garden.Project) error {
+	manageAttributes := authorizer.AttributesRecord{
+		User:            attributes.GetUserInfo(),
+		Verb:            "manage-members",
+		APIGroup:        attributes.GetResource().Group,
+		APIVersion:      attributes.GetResource().Version,
+		Resource:        "projects",
+		Name:            project.Name,
+		ResourceRequest: true,
+	}
+	if decision, _, _ := r.authorizer.Authorize(manageAttributes); decision != authorizer.DecisionAllow {
+		return errors.New("members and service accounts of the project can only be changed by users allowed to manage them")
+	}
+	return nil
+}
+
+// ensureProjectManageable checks whether the user is allowed to change the given project apart from its members and
+// service accounts. User access managers may update projects, but only to change their members.
+func (r *ReferenceManager) ensureProjectManageable(attributes admission.Attributes, project *garden.Project) error {
+	manageAttributes := authorizer.AttributesRecord{
+		User:            attributes.GetUserInfo(),
+		Verb:            "manage-project",
+		APIGroup:        attributes.GetResource().Group,
+		APIVersion:      attributes.GetResource().Version,
+		Resource:        "projects",
+		Name:            project.Name,
+		ResourceRequest: true,
+	}
+	if decision, _, _ := r.authorizer.Authorize(manageAttributes); decision != authorizer.DecisionAllow {
+		return errors.New("the project apart from its members and service accounts can only be changed by users allowed to manage it")
+	}
+	return nil
+}
+
+// projectSettingsChanged returns whether anything of the given projects apart from their members and service accounts
+// differs.
+func projectSettingsChanged(project, oldProject *garden.Project) bool {
+	spec, oldSpec := project.Spec.DeepCopy(), oldProject.Spec.DeepCopy()
+	spec.ProjectMembers, oldSpec.ProjectMembers = nil, nil
+	spec.ServiceAccounts, oldSpec.ServiceAccounts = nil, nil
+
+	return !apiequality.Semantic.DeepEqual(spec, oldSpec) ||
+		!apiequality.Semantic.DeepEqual(project.Labels, oldProject.Labels) ||
+		!apiequality.Semantic.DeepEqual(project.Annotations, oldProject.Annotations)
+}
+
 func (r *ReferenceManager) ensureSecretBindingReferences(attributes admission.Attributes, binding *garden.SecretBinding) error {
 	readAttributes := authorizer.AttributesRecord{
 		User:            attributes.GetUserInfo(),
@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/admission"
@@ -44,6 +45,9 @@ func (fakeAuthorizerType) Authorize(a authorizer.Attributes) (authorizer.Decisio
 	if username == "allowed-user" {
 		return authorizer.DecisionAllow, "", nil
 	}
+	if username == "member-manager" && a.GetVerb() == "manage-members" {
+		return authorizer.DecisionAllow, "", nil
+	}
 
 	return authorizer.DecisionDeny, "", nil
 }
@@ -523,6 +527,103 @@ var _ = Describe("resourcereferencemanager", func() {
 					Role: garden.ProjectMemberAdmin,
 				})))
 			})
+
+			It("should allow changing the members if the user is allowed to manage them", func() {
+				oldProject := project.DeepCopy()
+				project.Spec.ProjectMembers = []garden.ProjectMember{
+					{
+						Subject: rbacv1.Subject{APIGroup: "rbac.authorization.k8s.io", Kind: rbacv1.UserKind, Name: "alice.doe@example.com"},
+						Role:    garden.ProjectMemberViewer,
+					},
+				}
+
+				attrs := admission.NewAttributesRecord(&project, oldProject, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, &user.DefaultInfo{Name: allowedUser})
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should forbid changing the members if the user is not allowed to manage them", func() {
+				oldProject := project.DeepCopy()
+				project.Spec.ProjectMembers = []garden.ProjectMember{
+					{
+						Subject: rbacv1.Subject{APIGroup: "rbac.authorization.k8s.io", Kind: rbacv1.UserKind, Name: "alice.doe@example.com"},
+						Role:    garden.ProjectMemberViewer,
+					},
+				}
+
+				attrs := admission.NewAttributesRecord(&project, oldProject, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should forbid changing the service accounts if the user is not allowed to manage them", func() {
+				oldProject := project.DeepCopy()
+				project.Spec.ServiceAccounts = []garden.ProjectServiceAccount{{Name: "ci", Role: garden.ProjectMemberAdmin}}
+
+				attrs := admission.NewAttributesRecord(&project, oldProject, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should allow changing the members if the user is only allowed to manage members", func() {
+				oldProject := project.DeepCopy()
+				project.Spec.ProjectMembers = []garden.ProjectMember{
+					{
+						Subject: rbacv1.Subject{APIGroup: "rbac.authorization.k8s.io", Kind: rbacv1.UserKind, Name: "alice.doe@example.com"},
+						Role:    garden.ProjectMemberViewer,
+					},
+				}
+
+				attrs := admission.NewAttributesRecord(&project, oldProject, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, &user.DefaultInfo{Name: "member-manager"})
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow updates not changing the members if the user is allowed to manage the project", func() {
+				oldProject := project.DeepCopy()
+				description := "new description"
+				project.Spec.Description = &description
+
+				attrs := admission.NewAttributesRecord(&project, oldProject, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, &user.DefaultInfo{Name: allowedUser})
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should forbid changing the project apart from its members if the user is only allowed to manage members", func() {
+				oldProject := project.DeepCopy()
+				project.Spec.Owner = &rbacv1.Subject{APIGroup: "rbac.authorization.k8s.io", Kind: rbacv1.UserKind, Name: "member-manager"}
+
+				attrs := admission.NewAttributesRecord(&project, oldProject, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, &user.DefaultInfo{Name: "member-manager"})
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should forbid changing the labels of the project if the user is only allowed to manage members", func() {
+				oldProject := project.DeepCopy()
+				project.Labels = map[string]string{"foo": "bar"}
+
+				attrs := admission.NewAttributesRecord(&project, oldProject, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, &user.DefaultInfo{Name: "member-manager"})
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
 		})
 	})
 })
@@ -0,0 +1,134 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lock
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootLock"
+
+	// AdministratorsGroup is the name of the group whose members are allowed to change the specification of locked Shoots.
+	AdministratorsGroup = "garden.sapcloud.io:shoot-lock-administrators"
+)
+
+// gardenerServiceAccounts are the service accounts of the Gardener components which are allowed to change the
+// specification of locked Shoots, e.g. the scheduler assigns the Seed and the controller-manager performs the
+// maintenance operations and applies the hibernation schedules.
+var gardenerServiceAccounts = sets.NewString(
+	serviceaccount.MakeUsername(v1alpha1constants.GardenNamespace, "gardener-controller-manager"),
+	serviceaccount.MakeUsername(v1alpha1constants.GardenNamespace, "gardener-scheduler"),
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, NewFactory)
+}
+
+// NewFactory creates a new PluginFactory.
+func NewFactory(config io.Reader) (admission.Interface, error) {
+	return New()
+}
+
+// ShootLock contains an admission handler.
+type ShootLock struct {
+	*admission.Handler
+}
+
+var _ admission.ValidationInterface = &ShootLock{}
+
+// New creates a new ShootLock admission plugin.
+func New() (*ShootLock, error) {
+	return &ShootLock{
+		Handler: admission.NewHandler(admission.Update),
+	}, nil
+}
+
+// Validate rejects changes to the specification of Shoots which are locked by the lock annotation. Only members of the
+// administrators group (or of the system:masters group) and the Gardener components are allowed to perform such
+// changes. The initial assignment of the Seed is always allowed. Changing the specification and removing the
+// annotation in the same request is rejected as well, i.e. a Shoot must be unlocked explicitly.
+func (l *ShootLock) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Ignore all kinds other than Shoot
+	// Ignore all subresource calls
+	if len(a.GetSubresource()) != 0 || (a.GetKind().GroupKind() != garden.Kind("Shoot") && a.GetKind().GroupKind() != core.Kind("Shoot")) {
+		return nil
+	}
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+	oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+	}
+
+	if !shootLocked(oldShoot) || apiequality.Semantic.DeepEqual(shoot.Spec, oldShoot.Spec) || seedAssigned(shoot, oldShoot) {
+		return nil
+	}
+	if userInfo := a.GetUserInfo(); userInfo != nil && (isAdministrator(userInfo) || gardenerServiceAccounts.Has(userInfo.GetName())) {
+		return nil
+	}
+
+	return admission.NewForbidden(a, fmt.Errorf("shoot is locked by the %q annotation, changes to its specification (including hibernation) are only allowed for members of the %q group, remove the annotation in a separate request to unlock the shoot", common.ShootLocked, AdministratorsGroup))
+}
+
+// seedAssigned returns true if the only change to the specification of the given Shoot is the initial assignment of
+// its Seed.
+func seedAssigned(shoot, oldShoot *garden.Shoot) bool {
+	if oldShoot.Spec.SeedName != nil || oldShoot.Spec.Cloud.Seed != nil {
+		return false
+	}
+
+	spec := shoot.Spec.DeepCopy()
+	spec.SeedName = nil
+	spec.Cloud.Seed = nil
+	return apiequality.Semantic.DeepEqual(*spec, oldShoot.Spec)
+}
+
+func shootLocked(obj metav1.Object) bool {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		return false
+	}
+	locked, _ := strconv.ParseBool(annotations[common.ShootLocked])
+	return locked
+}
+
+func isAdministrator(userInfo user.Info) bool {
+	for _, group := range userInfo.GetGroups() {
+		if group == AdministratorsGroup || group == user.SystemPrivilegedGroup {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lock_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/lock"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ShootLock", func() {
+	Describe("#Validate", func() {
+		var (
+			admissionHandler *ShootLock
+			shoot            *garden.Shoot
+			oldShoot         *garden.Shoot
+			userInfo         user.Info
+		)
+
+		BeforeEach(func() {
+			admissionHandler, _ = New()
+
+			oldShoot = &garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "shoot",
+					Namespace:   "garden-dev",
+					Annotations: map[string]string{common.ShootLocked: "true"},
+				},
+				Spec: garden.ShootSpec{
+					Kubernetes: garden.Kubernetes{Version: "1.15.2"},
+				},
+			}
+			shoot = oldShoot.DeepCopy()
+			userInfo = &user.DefaultInfo{Name: "john.doe", Groups: []string{"system:authenticated"}}
+		})
+
+		validate := func(subresource string) error {
+			attrs := admission.NewAttributesRecord(shoot, oldShoot, garden.Kind("Shoot").WithVersion("v1beta1"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("v1beta1"), subresource, admission.Update, false, userInfo)
+			return admissionHandler.Validate(attrs, nil)
+		}
+
+		It("should reject spec changes of a locked shoot", func() {
+			shoot.Spec.Kubernetes.Version = "1.15.3"
+
+			err := validate("")
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(common.ShootLocked))
+		})
+
+		It("should reject hibernating a locked shoot", func() {
+			enabled := true
+			shoot.Spec.Hibernation = &garden.Hibernation{Enabled: &enabled}
+
+			Expect(apierrors.IsForbidden(validate(""))).To(BeTrue())
+		})
+
+		It("should reject spec changes which remove the lock annotation at the same time", func() {
+			delete(shoot.Annotations, common.ShootLocked)
+			shoot.Spec.Kubernetes.Version = "1.15.3"
+
+			Expect(apierrors.IsForbidden(validate(""))).To(BeTrue())
+		})
+
+		It("should allow unlocking the shoot", func() {
+			delete(shoot.Annotations, common.ShootLocked)
+
+			Expect(validate("")).To(Succeed())
+		})
+
+		It("should allow metadata changes of a locked shoot", func() {
+			shoot.Labels = map[string]string{"foo": "bar"}
+
+			Expect(validate("")).To(Succeed())
+		})
+
+		It("should allow status updates of a locked shoot", func() {
+			shoot.Spec.Kubernetes.Version = "1.15.3"
+
+			Expect(validate("status")).To(Succeed())
+		})
+
+		It("should allow spec changes of a shoot which is not locked", func() {
+			oldShoot.Annotations[common.ShootLocked] = "false"
+			shoot.Annotations[common.ShootLocked] = "false"
+			shoot.Spec.Kubernetes.Version = "1.15.3"
+
+			Expect(validate("")).To(Succeed())
+		})
+
+		It("should allow spec changes of a locked shoot by members of the administrators group", func() {
+			userInfo = &user.DefaultInfo{Name: "jane.doe", Groups: []string{"system:authenticated", AdministratorsGroup}}
+			shoot.Spec.Kubernetes.Version = "1.15.3"
+
+			Expect(validate("")).To(Succeed())
+		})
+
+		It("should allow spec changes of a locked shoot by members of the system:masters group", func() {
+			userInfo = &user.DefaultInfo{Name: "admin", Groups: []string{user.SystemPrivilegedGroup}}
+			shoot.Spec.Kubernetes.Version = "1.15.3"
+
+			Expect(validate("")).To(Succeed())
+		})
+
+		It("should allow the initial assignment of the seed to a locked shoot", func() {
+			seedName := "seed"
+			shoot.Spec.SeedName = &seedName
+			shoot.Spec.Cloud.Seed = &seedName
+
+			Expect(validate("")).To(Succeed())
+		})
+
+		It("should reject changing the seed of a locked shoot", func() {
+			oldSeedName, seedName := "old-seed", "seed"
+			oldShoot.Spec.SeedName = &oldSeedName
+			shoot.Spec.SeedName = &seedName
+
+			Expect(apierrors.IsForbidden(validate(""))).To(BeTrue())
+		})
+
+		It("should reject other spec changes together with the initial assignment of the seed", func() {
+			seedName := "seed"
+			shoot.Spec.SeedName = &seedName
+			shoot.Spec.Kubernetes.Version = "1.15.3"
+
+			Expect(apierrors.IsForbidden(validate(""))).To(BeTrue())
+		})
+
+		It("should allow the scheduler to assign the seed of a locked shoot", func() {
+			userInfo = &user.DefaultInfo{Name: "system:serviceaccount:garden:gardener-scheduler", Groups: []string{"system:serviceaccounts", "system:serviceaccounts:garden"}}
+			seedName := "seed"
+			shoot.Spec.SeedName = &seedName
+
+			Expect(validate("")).To(Succeed())
+		})
+
+		It("should allow the controller-manager to perform the maintenance of a locked shoot", func() {
+			userInfo = &user.DefaultInfo{Name: "system:serviceaccount:garden:gardener-controller-manager", Groups: []string{"system:serviceaccounts", "system:serviceaccounts:garden"}}
+			shoot.Spec.Kubernetes.Version = "1.15.3"
+
+			Expect(validate("")).To(Succeed())
+		})
+
+		It("should reject spec changes of a locked shoot by other service accounts", func() {
+			userInfo = &user.DefaultInfo{Name: "system:serviceaccount:garden-dev:gardener-controller-manager", Groups: []string{"system:serviceaccounts", "system:serviceaccounts:garden-dev"}}
+			shoot.Spec.Kubernetes.Version = "1.15.3"
+
+			Expect(apierrors.IsForbidden(validate(""))).To(BeTrue())
+		})
+
+		It("should do nothing because the resource is not a Shoot", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, garden.Kind("Foo").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("foos").WithVersion("version"), "", admission.Update, false, userInfo)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,319 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	settingsinformer "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	settingslister "github.com/gardener/gardener/pkg/client/settings/listers/settings/v1alpha1"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+
+	"github.com/Masterminds/semver"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootPolicy"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ShootPolicy contains listers and and admission handler.
+type ShootPolicy struct {
+	*admission.Handler
+
+	projectLister gardenlisters.ProjectLister
+	policyLister  settingslister.ClusterShootPolicyLister
+	readyFunc     admission.ReadyFunc
+}
+
+var (
+	_                               = admissioninitializer.WantsInternalGardenInformerFactory(&ShootPolicy{})
+	_                               = admissioninitializer.WantsSettingsInformerFactory(&ShootPolicy{})
+	_ admission.ValidationInterface = &ShootPolicy{}
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new ShootPolicy admission plugin.
+func New() (*ShootPolicy, error) {
+	return &ShootPolicy{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (s *ShootPolicy) AssignReadyFunc(f admission.ReadyFunc) {
+	s.readyFunc = f
+	s.SetReadyFunc(f)
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootPolicy) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
+	projectInformer := f.Garden().InternalVersion().Projects()
+	s.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// SetSettingsInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootPolicy) SetSettingsInformerFactory(f settingsinformer.SharedInformerFactory) {
+	policyInformer := f.Settings().V1alpha1().ClusterShootPolicies()
+	s.policyLister = policyInformer.Lister()
+
+	readyFuncs = append(readyFuncs, policyInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (s *ShootPolicy) ValidateInitialization() error {
+	if s.policyLister == nil {
+		return errors.New("missing clustershootpolicy lister")
+	}
+	if s.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	return nil
+}
+
+// Validate rejects Shoots which violate a ClusterShootPolicy matching their Project. For updates, only
+// the values which have been changed compared to the old Shoot are checked against the policies.
+func (s *ShootPolicy) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if s.readyFunc == nil {
+		s.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !s.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	// Ignore all subresource calls
+	if len(a.GetSubresource()) != 0 || (a.GetKind().GroupKind() != garden.Kind("Shoot") && a.GetKind().GroupKind() != core.Kind("Shoot")) {
+		return nil
+	}
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	// Pass if the shoot is intended to get deleted
+	if shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var oldShoot *garden.Shoot
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok = a.GetOldObject().(*garden.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+	}
+
+	policies, err := s.policyLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not list existing clustershootpolicies: %v", err))
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	project, err := admissionutils.GetProject(shoot.Namespace, s.projectLister)
+	if err != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("could not find referenced project: %+v", err.Error()))
+	}
+
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+
+	var violations []string
+	for _, policy := range policies {
+		projectSelector, err := metav1.LabelSelectorAsSelector(policy.Spec.ProjectSelector)
+		if err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("label selector conversion failed: %v for projectSelector: %v", *policy.Spec.ProjectSelector, err))
+		}
+		if !projectSelector.Matches(labels.Set(project.Labels)) {
+			continue
+		}
+
+		if allErrs := validateShootPolicy(&policy.Spec, shoot, oldShoot); len(allErrs) > 0 {
+			violations = append(violations, fmt.Sprintf("shoot violates cluster shoot policy %q: %s", policy.Name, allErrs.ToAggregate().Error()))
+		}
+	}
+
+	if len(violations) > 0 {
+		return admission.NewForbidden(a, errors.New(strings.Join(violations, "; ")))
+	}
+	return nil
+}
+
+// validateShootPolicy checks the Shoot against the given policy. If an old Shoot is given then only
+// values which differ from the old Shoot are checked, so that already existing Shoots which do not
+// conform to a newly created policy can still be updated.
+func validateShootPolicy(policy *settingsv1alpha1.ClusterShootPolicySpec, shoot, oldShoot *garden.Shoot) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		isCreate = oldShoot == nil
+	)
+
+	if policy.KubernetesVersionConstraint != nil && (isCreate || shoot.Spec.Kubernetes.Version != oldShoot.Spec.Kubernetes.Version) {
+		idxPath := field.NewPath("spec", "kubernetes", "version")
+		if ok, err := versionMatchesConstraint(shoot.Spec.Kubernetes.Version, *policy.KubernetesVersionConstraint); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, shoot.Spec.Kubernetes.Version, err.Error()))
+		} else if !ok {
+			allErrs = append(allErrs, field.Invalid(idxPath, shoot.Spec.Kubernetes.Version, fmt.Sprintf("version must satisfy the constraint %q", *policy.KubernetesVersionConstraint)))
+		}
+	}
+
+	if len(policy.Regions) > 0 && (isCreate || shoot.Spec.Region != oldShoot.Spec.Region) {
+		if !sets.NewString(policy.Regions...).Has(shoot.Spec.Region) {
+			allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "region"), shoot.Spec.Region, policy.Regions))
+		}
+	}
+
+	if len(policy.MachineTypes) > 0 {
+		allowedMachineTypes := sets.NewString(policy.MachineTypes...)
+		for i, worker := range shoot.Spec.Provider.Workers {
+			if allowedMachineTypes.Has(worker.Machine.Type) || (!isCreate && oldWorkerMachineType(oldShoot, worker.Name) == worker.Machine.Type) {
+				continue
+			}
+			allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "provider", "workers").Index(i).Child("machine", "type"), worker.Machine.Type, policy.MachineTypes))
+		}
+	}
+
+	if policy.MaxWorkers != nil {
+		if maxWorkers := maximumWorkerCount(shoot); maxWorkers > int(*policy.MaxWorkers) && (isCreate || maxWorkers > maximumWorkerCount(oldShoot)) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "provider", "workers"), maxWorkers, fmt.Sprintf("sum of the maximum worker counts must not exceed %d", *policy.MaxWorkers)))
+		}
+	}
+
+	if policy.AllowPrivilegedContainers != nil && !*policy.AllowPrivilegedContainers {
+		if allowPrivilegedContainers(shoot) && (isCreate || !allowPrivilegedContainers(oldShoot)) {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "kubernetes", "allowPrivilegedContainers"), "privileged containers are not allowed, please set this field to false"))
+		}
+	}
+
+	for _, addon := range policy.RequiredAddons {
+		if addonEnabled(shoot, addon) || (!isCreate && !addonEnabled(oldShoot, addon)) {
+			continue
+		}
+		allErrs = append(allErrs, field.Required(addonPath(addon), fmt.Sprintf("addon %q must be enabled", addon)))
+	}
+
+	for _, extensionType := range policy.RequiredExtensions {
+		if hasExtension(shoot, extensionType) || (!isCreate && !hasExtension(oldShoot, extensionType)) {
+			continue
+		}
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "extensions"), fmt.Sprintf("extension of type %q must be configured", extensionType)))
+	}
+
+	return allErrs
+}
+
+func versionMatchesConstraint(version, constraint string) (bool, error) {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false, fmt.Errorf("policy contains an invalid version constraint: %v", err)
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false, err
+	}
+	return c.Check(v), nil
+}
+
+func oldWorkerMachineType(oldShoot *garden.Shoot, name string) string {
+	for _, worker := range oldShoot.Spec.Provider.Workers {
+		if worker.Name == name {
+			return worker.Machine.Type
+		}
+	}
+	return ""
+}
+
+func maximumWorkerCount(shoot *garden.Shoot) int {
+	count := 0
+	for _, worker := range shoot.Spec.Provider.Workers {
+		count += worker.Maximum
+	}
+	return count
+}
+
+// allowPrivilegedContainers returns the effective value of the field, it defaults to true.
+func allowPrivilegedContainers(shoot *garden.Shoot) bool {
+	return shoot.Spec.Kubernetes.AllowPrivilegedContainers == nil || *shoot.Spec.Kubernetes.AllowPrivilegedContainers
+}
+
+func addonEnabled(shoot *garden.Shoot, addon string) bool {
+	addons := shoot.Spec.Addons
+	if addons == nil {
+		return false
+	}
+
+	switch addon {
+	case settings.ShootPolicyAddonKubernetesDashboard:
+		return addons.KubernetesDashboard != nil && addons.KubernetesDashboard.Enabled
+	case settings.ShootPolicyAddonNginxIngress:
+		return addons.NginxIngress != nil && addons.NginxIngress.Enabled
+	}
+	return false
+}
+
+func addonPath(addon string) *field.Path {
+	switch addon {
+	case settings.ShootPolicyAddonKubernetesDashboard:
+		return field.NewPath("spec", "addons", "kubernetesDashboard", "enabled")
+	case settings.ShootPolicyAddonNginxIngress:
+		return field.NewPath("spec", "addons", "nginxIngress", "enabled")
+	}
+	return field.NewPath("spec", "addons")
+}
+
+func hasExtension(shoot *garden.Shoot, extensionType string) bool {
+	for _, extension := range shoot.Spec.Extensions {
+		if extension.Type == extensionType {
+			return true
+		}
+	}
+	return false
+}
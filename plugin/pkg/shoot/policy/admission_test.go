@@ -0,0 +1,257 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	settingsinformer "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/pointer"
+
+	. "github.com/gardener/gardener/plugin/pkg/shoot/policy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ShootPolicy", func() {
+	Describe("#Validate", func() {
+		var (
+			admissionHandler        *ShootPolicy
+			settingsInformerFactory settingsinformer.SharedInformerFactory
+			gardenInformerFactory   gardeninformers.SharedInformerFactory
+			shoot                   *garden.Shoot
+			project                 *garden.Project
+			policy                  *settingsv1alpha1.ClusterShootPolicy
+		)
+
+		BeforeEach(func() {
+			namespace := "my-namespace"
+			shoot = &garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: namespace,
+				},
+				Spec: garden.ShootSpec{
+					Region: "eu-west-1",
+					Kubernetes: garden.Kubernetes{
+						Version:                   "1.15.2",
+						AllowPrivilegedContainers: pointer.BoolPtr(false),
+					},
+					Provider: garden.Provider{
+						Workers: []garden.Worker{
+							{Name: "worker-1", Machine: garden.Machine{Type: "m5.large"}, Minimum: 1, Maximum: 2},
+							{Name: "worker-2", Machine: garden.Machine{Type: "m5.large"}, Minimum: 1, Maximum: 3},
+						},
+					},
+					Addons: &garden.Addons{
+						NginxIngress: &garden.NginxIngress{Addon: garden.Addon{Enabled: true}},
+					},
+					Extensions: []garden.Extension{{Type: "foo"}},
+				},
+			}
+
+			project = &garden.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "project-1",
+					Labels: map[string]string{"stage": "production"},
+				},
+				Spec: garden.ProjectSpec{
+					Namespace: pointer.StringPtr(namespace),
+				},
+			}
+
+			policy = &settingsv1alpha1.ClusterShootPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "policy-1",
+				},
+				Spec: settingsv1alpha1.ClusterShootPolicySpec{
+					ProjectSelector:             &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "production"}},
+					KubernetesVersionConstraint: pointer.StringPtr(">= 1.15, < 1.16"),
+					Regions:                     []string{"eu-west-1", "eu-central-1"},
+					MachineTypes:                []string{"m5.large"},
+					MaxWorkers:                  pointer.Int32Ptr(5),
+					AllowPrivilegedContainers:   pointer.BoolPtr(false),
+					RequiredAddons:              []string{"nginx-ingress"},
+					RequiredExtensions:          []string{"foo"},
+				},
+			}
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			settingsInformerFactory = settingsinformer.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetSettingsInformerFactory(settingsInformerFactory)
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+		})
+
+		addObjects := func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+			Expect(gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(project)).To(Succeed())
+		}
+
+		create := func() error {
+			attrs := admission.NewAttributesRecord(shoot, nil, garden.Kind("Shoot").WithVersion("v1beta1"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("v1beta1"), "", admission.Create, false, nil)
+			return admissionHandler.Validate(attrs, nil)
+		}
+
+		update := func(oldShoot *garden.Shoot) error {
+			attrs := admission.NewAttributesRecord(shoot, oldShoot, garden.Kind("Shoot").WithVersion("v1beta1"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("v1beta1"), "", admission.Update, false, nil)
+			return admissionHandler.Validate(attrs, nil)
+		}
+
+		It("should allow a shoot which conforms to the policy", func() {
+			addObjects()
+
+			Expect(create()).To(Succeed())
+		})
+
+		It("should allow a shoot if the project selector does not match", func() {
+			policy.Spec.ProjectSelector.MatchLabels = map[string]string{"stage": "dev"}
+			shoot.Spec.Region = "us-east-1"
+			addObjects()
+
+			Expect(create()).To(Succeed())
+		})
+
+		It("should reject a shoot with a kubernetes version not satisfying the constraint", func() {
+			shoot.Spec.Kubernetes.Version = "1.14.4"
+			addObjects()
+
+			err := create()
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`"policy-1"`))
+			Expect(err.Error()).To(ContainSubstring("spec.kubernetes.version"))
+		})
+
+		It("should reject a shoot in a region which is not allowed", func() {
+			shoot.Spec.Region = "us-east-1"
+			addObjects()
+
+			err := create()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.region"))
+		})
+
+		It("should reject a shoot with a machine type which is not allowed", func() {
+			shoot.Spec.Provider.Workers[1].Machine.Type = "m5.24xlarge"
+			addObjects()
+
+			err := create()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.provider.workers[1].machine.type"))
+		})
+
+		It("should reject a shoot exceeding the maximum worker count", func() {
+			shoot.Spec.Provider.Workers[1].Maximum = 4
+			addObjects()
+
+			err := create()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must not exceed 5"))
+		})
+
+		It("should reject a shoot allowing privileged containers", func() {
+			shoot.Spec.Kubernetes.AllowPrivilegedContainers = nil
+			addObjects()
+
+			err := create()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.kubernetes.allowPrivilegedContainers"))
+		})
+
+		It("should reject a shoot without the required addons and extensions", func() {
+			shoot.Spec.Addons = nil
+			shoot.Spec.Extensions = nil
+			addObjects()
+
+			err := create()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.addons.nginxIngress.enabled"))
+			Expect(err.Error()).To(ContainSubstring(`extension of type "foo" must be configured`))
+		})
+
+		It("should report the violations of all matching policies", func() {
+			policy2 := policy.DeepCopy()
+			policy2.Name = "policy-2"
+			policy2.Spec.Regions = []string{"eu-central-1"}
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(policy2)).To(Succeed())
+			shoot.Spec.Region = "us-east-1"
+			addObjects()
+
+			err := create()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`"policy-1"`))
+			Expect(err.Error()).To(ContainSubstring(`"policy-2"`))
+		})
+
+		It("should allow updates of a non-conforming shoot which do not touch the violated values", func() {
+			shoot.Spec.Region = "us-east-1"
+			shoot.Spec.Kubernetes.AllowPrivilegedContainers = nil
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Provider.Workers[0].Minimum = 2
+			addObjects()
+
+			Expect(update(oldShoot)).To(Succeed())
+		})
+
+		It("should reject updates which introduce a violation", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Provider.Workers[0].Machine.Type = "m5.24xlarge"
+			shoot.Spec.Addons.NginxIngress.Enabled = false
+			addObjects()
+
+			err := update(oldShoot)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.provider.workers[0].machine.type"))
+			Expect(err.Error()).To(ContainSubstring("spec.addons.nginxIngress.enabled"))
+		})
+
+		It("should ignore shoots which are being deleted", func() {
+			shoot.Spec.Region = "us-east-1"
+			now := metav1.Now()
+			shoot.DeletionTimestamp = &now
+			addObjects()
+
+			Expect(create()).To(Succeed())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return an error if the project lister is not set", func() {
+			plugin := &ShootPolicy{}
+			plugin.SetSettingsInformerFactory(settingsinformer.NewSharedInformerFactory(nil, 0))
+			Expect(plugin.ValidateInitialization()).NotTo(Succeed())
+		})
+
+		It("should return nil error when everything is set", func() {
+			plugin := &ShootPolicy{}
+			plugin.SetSettingsInformerFactory(settingsinformer.NewSharedInformerFactory(nil, 0))
+			plugin.SetInternalGardenInformerFactory(gardeninformers.NewSharedInformerFactory(nil, 0))
+			Expect(plugin.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
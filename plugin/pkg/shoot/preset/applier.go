@@ -0,0 +1,141 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preset
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+)
+
+// ApplyShootPreset applies the values of the preset template to the shoot. Only values which are not yet set on the
+// shoot are defaulted, i.e. the preset never overwrites the configuration of the shoot owner.
+func ApplyShootPreset(shoot *garden.Shoot, template *settingsv1alpha1.ShootPresetTemplate) error {
+	if shoot == nil || template == nil {
+		return nil
+	}
+
+	if template.Addons != nil {
+		addons := &garden.Addons{}
+		if err := gardencorev1alpha1.Convert_v1alpha1_Addons_To_garden_Addons(template.Addons, addons, nil); err != nil {
+			return err
+		}
+		applyAddons(shoot, addons)
+	}
+
+	for _, extension := range template.Extensions {
+		out := garden.Extension{}
+		if err := gardencorev1alpha1.Convert_v1alpha1_Extension_To_garden_Extension(&extension, &out, nil); err != nil {
+			return err
+		}
+		applyExtension(shoot, out)
+	}
+
+	if template.Hibernation != nil {
+		hibernation := &garden.Hibernation{}
+		if err := gardencorev1alpha1.Convert_v1alpha1_Hibernation_To_garden_Hibernation(template.Hibernation, hibernation, nil); err != nil {
+			return err
+		}
+		applyHibernation(shoot, hibernation)
+	}
+
+	if template.Kubelet != nil && shoot.Spec.Kubernetes.Kubelet == nil {
+		kubelet := &garden.KubeletConfig{}
+		if err := gardencorev1alpha1.Convert_v1alpha1_KubeletConfig_To_garden_KubeletConfig(template.Kubelet, kubelet, nil); err != nil {
+			return err
+		}
+		shoot.Spec.Kubernetes.Kubelet = kubelet
+	}
+
+	if template.Maintenance != nil {
+		maintenance := &garden.Maintenance{}
+		if err := gardencorev1alpha1.Convert_v1alpha1_Maintenance_To_garden_Maintenance(template.Maintenance, maintenance, nil); err != nil {
+			return err
+		}
+		applyMaintenance(shoot, maintenance)
+	}
+
+	if template.Monitoring != nil {
+		monitoring := &garden.Monitoring{}
+		if err := gardencorev1alpha1.Convert_v1alpha1_Monitoring_To_garden_Monitoring(template.Monitoring, monitoring, nil); err != nil {
+			return err
+		}
+		applyMonitoring(shoot, monitoring)
+	}
+
+	return nil
+}
+
+func applyAddons(shoot *garden.Shoot, addons *garden.Addons) {
+	if shoot.Spec.Addons == nil {
+		shoot.Spec.Addons = addons
+		return
+	}
+
+	if shoot.Spec.Addons.KubernetesDashboard == nil {
+		shoot.Spec.Addons.KubernetesDashboard = addons.KubernetesDashboard
+	}
+	if shoot.Spec.Addons.NginxIngress == nil {
+		shoot.Spec.Addons.NginxIngress = addons.NginxIngress
+	}
+}
+
+func applyExtension(shoot *garden.Shoot, extension garden.Extension) {
+	for _, existing := range shoot.Spec.Extensions {
+		if existing.Type == extension.Type {
+			return
+		}
+	}
+	shoot.Spec.Extensions = append(shoot.Spec.Extensions, extension)
+}
+
+func applyHibernation(shoot *garden.Shoot, hibernation *garden.Hibernation) {
+	if shoot.Spec.Hibernation == nil {
+		shoot.Spec.Hibernation = hibernation
+		return
+	}
+
+	if shoot.Spec.Hibernation.Enabled == nil {
+		shoot.Spec.Hibernation.Enabled = hibernation.Enabled
+	}
+	if len(shoot.Spec.Hibernation.Schedules) == 0 {
+		shoot.Spec.Hibernation.Schedules = hibernation.Schedules
+	}
+}
+
+func applyMaintenance(shoot *garden.Shoot, maintenance *garden.Maintenance) {
+	if shoot.Spec.Maintenance == nil {
+		shoot.Spec.Maintenance = maintenance
+		return
+	}
+
+	if shoot.Spec.Maintenance.AutoUpdate == nil {
+		shoot.Spec.Maintenance.AutoUpdate = maintenance.AutoUpdate
+	}
+	if shoot.Spec.Maintenance.TimeWindow == nil {
+		shoot.Spec.Maintenance.TimeWindow = maintenance.TimeWindow
+	}
+}
+
+func applyMonitoring(shoot *garden.Shoot, monitoring *garden.Monitoring) {
+	if shoot.Spec.Monitoring == nil {
+		shoot.Spec.Monitoring = monitoring
+		return
+	}
+
+	if shoot.Spec.Monitoring.Alerting == nil {
+		shoot.Spec.Monitoring.Alerting = monitoring.Alerting
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preset_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gardener/gardener/pkg/api"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	shootstrategy "github.com/gardener/gardener/pkg/registry/garden/shoot"
+	"github.com/gardener/gardener/plugin/pkg/shoot/preset"
+	"k8s.io/utils/pointer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func TestPreset(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Admission ShootPreset Suite")
+}
+
+var _ = Describe("Applier", func() {
+	var (
+		shoot    *garden.Shoot
+		template *settingsv1alpha1.ShootPresetTemplate
+	)
+
+	BeforeEach(func() {
+		shoot = &garden.Shoot{}
+		template = &settingsv1alpha1.ShootPresetTemplate{
+			Addons: &gardencorev1alpha1.Addons{
+				NginxIngress: &gardencorev1alpha1.NginxIngress{Addon: gardencorev1alpha1.Addon{Enabled: true}},
+			},
+			Extensions: []gardencorev1alpha1.Extension{{Type: "foo"}, {Type: "bar"}},
+			Hibernation: &gardencorev1alpha1.Hibernation{
+				Schedules: []gardencorev1alpha1.HibernationSchedule{{Start: pointer.StringPtr("0 20 * * *")}},
+			},
+			Kubelet: &gardencorev1alpha1.KubeletConfig{MaxPods: pointer.Int32Ptr(50)},
+			Maintenance: &gardencorev1alpha1.Maintenance{
+				TimeWindow: &gardencorev1alpha1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+			},
+			Monitoring: &gardencorev1alpha1.Monitoring{
+				Alerting: &gardencorev1alpha1.Alerting{EmailReceivers: []string{"ops@example.com"}},
+			},
+		}
+	})
+
+	It("should not modify anything if no shoot or template is passed", func() {
+		Expect(preset.ApplyShootPreset(nil, template)).To(Succeed())
+
+		shootCopy := shoot.DeepCopy()
+		Expect(preset.ApplyShootPreset(shoot, nil)).To(Succeed())
+		Expect(shoot).To(Equal(shootCopy))
+	})
+
+	It("should default all values of an empty shoot", func() {
+		Expect(preset.ApplyShootPreset(shoot, template)).To(Succeed())
+
+		Expect(shoot.Spec).To(Equal(garden.ShootSpec{
+			Addons: &garden.Addons{
+				NginxIngress: &garden.NginxIngress{Addon: garden.Addon{Enabled: true}},
+			},
+			Extensions: []garden.Extension{{Type: "foo"}, {Type: "bar"}},
+			Hibernation: &garden.Hibernation{
+				Schedules: []garden.HibernationSchedule{{Start: pointer.StringPtr("0 20 * * *")}},
+			},
+			Kubernetes: garden.Kubernetes{
+				Kubelet: &garden.KubeletConfig{MaxPods: pointer.Int32Ptr(50)},
+			},
+			Maintenance: &garden.Maintenance{
+				TimeWindow: &garden.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+			},
+			Monitoring: &garden.Monitoring{
+				Alerting: &garden.Alerting{EmailReceivers: []string{"ops@example.com"}},
+			},
+		}))
+	})
+
+	It("should not overwrite values which are already set", func() {
+		shoot.Spec = garden.ShootSpec{
+			Addons: &garden.Addons{
+				KubernetesDashboard: &garden.KubernetesDashboard{Addon: garden.Addon{Enabled: true}},
+				NginxIngress:        &garden.NginxIngress{Addon: garden.Addon{Enabled: false}},
+			},
+			Extensions: []garden.Extension{{Type: "foo", ProviderConfig: &garden.ProviderConfig{}}},
+			Hibernation: &garden.Hibernation{
+				Enabled:   pointer.BoolPtr(true),
+				Schedules: []garden.HibernationSchedule{{End: pointer.StringPtr("0 8 * * *")}},
+			},
+			Kubernetes: garden.Kubernetes{
+				Kubelet: &garden.KubeletConfig{MaxPods: pointer.Int32Ptr(110)},
+			},
+			Maintenance: &garden.Maintenance{
+				AutoUpdate: &garden.MaintenanceAutoUpdate{KubernetesVersion: true},
+			},
+			Monitoring: &garden.Monitoring{
+				Alerting: &garden.Alerting{},
+			},
+		}
+
+		Expect(preset.ApplyShootPreset(shoot, template)).To(Succeed())
+
+		Expect(shoot.Spec).To(Equal(garden.ShootSpec{
+			Addons: &garden.Addons{
+				KubernetesDashboard: &garden.KubernetesDashboard{Addon: garden.Addon{Enabled: true}},
+				NginxIngress:        &garden.NginxIngress{Addon: garden.Addon{Enabled: false}},
+			},
+			Extensions: []garden.Extension{{Type: "foo", ProviderConfig: &garden.ProviderConfig{}}, {Type: "bar"}},
+			Hibernation: &garden.Hibernation{
+				Enabled:   pointer.BoolPtr(true),
+				Schedules: []garden.HibernationSchedule{{End: pointer.StringPtr("0 8 * * *")}},
+			},
+			Kubernetes: garden.Kubernetes{
+				Kubelet: &garden.KubeletConfig{MaxPods: pointer.Int32Ptr(110)},
+			},
+			Maintenance: &garden.Maintenance{
+				AutoUpdate: &garden.MaintenanceAutoUpdate{KubernetesVersion: true},
+				TimeWindow: &garden.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+			},
+			Monitoring: &garden.Monitoring{
+				Alerting: &garden.Alerting{},
+			},
+		}))
+	})
+
+	Context("shoots defaulted by the API", func() {
+		var ctx = context.TODO()
+
+		BeforeEach(func() {
+			template.Addons.KubernetesDashboard = &gardencorev1alpha1.KubernetesDashboard{Addon: gardencorev1alpha1.Addon{Enabled: true}}
+			template.Maintenance.AutoUpdate = &gardencorev1alpha1.MaintenanceAutoUpdate{KubernetesVersion: false, MachineImageVersion: false}
+		})
+
+		expectPresetApplied := func(shoot *garden.Shoot) {
+			Expect(preset.ApplyShootPreset(shoot, template)).To(Succeed())
+			shootstrategy.Strategy.PrepareForCreate(ctx, shoot)
+
+			Expect(shoot.Spec.Maintenance).To(Equal(&garden.Maintenance{
+				AutoUpdate: &garden.MaintenanceAutoUpdate{KubernetesVersion: false, MachineImageVersion: pointer.BoolPtr(false)},
+				TimeWindow: &garden.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+			}))
+			Expect(shoot.Spec.Addons.KubernetesDashboard.Enabled).To(BeTrue())
+			Expect(shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode).To(PointTo(Equal(garden.KubernetesDashboardAuthModeToken)))
+		}
+
+		It("should apply the preset to a defaulted garden.sapcloud.io/v1beta1 shoot", func() {
+			external := &gardenv1beta1.Shoot{}
+			external.Spec.Kubernetes.Version = "1.16.1"
+			api.Scheme.Default(external)
+
+			Expect(api.Scheme.Convert(external, shoot, nil)).To(Succeed())
+			expectPresetApplied(shoot)
+		})
+
+		It("should apply the preset to a defaulted core.gardener.cloud/v1alpha1 shoot", func() {
+			external := &gardencorev1alpha1.Shoot{}
+			external.Spec.Kubernetes.Version = "1.16.1"
+			api.Scheme.Default(external)
+
+			Expect(api.Scheme.Convert(external, shoot, nil)).To(Succeed())
+			expectPresetApplied(shoot)
+		})
+	})
+})
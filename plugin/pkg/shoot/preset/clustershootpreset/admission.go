@@ -0,0 +1,205 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustershootpreset
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	settingsinformer "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	settingslister "github.com/gardener/gardener/pkg/client/settings/listers/settings/v1alpha1"
+	applier "github.com/gardener/gardener/plugin/pkg/shoot/preset"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ClusterShootPreset"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ClusterShootPreset contains listers and and admission handler.
+type ClusterShootPreset struct {
+	*admission.Handler
+
+	projectLister       gardenlisters.ProjectLister
+	clusterPresetLister settingslister.ClusterShootPresetLister
+	readyFunc           admission.ReadyFunc
+}
+
+var (
+	_                             = admissioninitializer.WantsInternalGardenInformerFactory(&ClusterShootPreset{})
+	_                             = admissioninitializer.WantsSettingsInformerFactory(&ClusterShootPreset{})
+	_ admission.MutationInterface = &ClusterShootPreset{}
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new ClusterShootPreset admission plugin.
+func New() (*ClusterShootPreset, error) {
+	return &ClusterShootPreset{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (c *ClusterShootPreset) AssignReadyFunc(f admission.ReadyFunc) {
+	c.readyFunc = f
+	c.SetReadyFunc(f)
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (c *ClusterShootPreset) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
+	projectInformer := f.Garden().InternalVersion().Projects()
+	c.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// SetSettingsInformerFactory gets Lister from SharedInformerFactory.
+func (c *ClusterShootPreset) SetSettingsInformerFactory(f settingsinformer.SharedInformerFactory) {
+	clusterPresetInformer := f.Settings().V1alpha1().ClusterShootPresets()
+	c.clusterPresetLister = clusterPresetInformer.Lister()
+
+	readyFuncs = append(readyFuncs, clusterPresetInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (c *ClusterShootPreset) ValidateInitialization() error {
+	if c.clusterPresetLister == nil {
+		return errors.New("missing clustershootpreset lister")
+	}
+	if c.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	return nil
+}
+
+// Admit defaults the specification of a new Shoot with the template of the best matching ClusterShootPreset.
+func (c *ClusterShootPreset) Admit(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if c.readyFunc == nil {
+		c.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !c.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	// Ignore all subresource calls
+	// Ignore all operations other than CREATE
+	if len(a.GetSubresource()) != 0 || (a.GetKind().GroupKind() != garden.Kind("Shoot") && a.GetKind().GroupKind() != core.Kind("Shoot")) || a.GetOperation() != admission.Create {
+		return nil
+	}
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	presets, err := c.clusterPresetLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not list existing clustershootpresets: %v", err))
+	}
+	if len(presets) == 0 {
+		return nil
+	}
+
+	projects, err := c.projectLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not list existing projects: %v", err))
+	}
+	var foundProject *garden.Project
+	for _, project := range projects {
+		if project.Spec.Namespace != nil && *project.Spec.Namespace == shoot.Namespace && project.Status.Phase == garden.ProjectReady {
+			foundProject = project
+			break
+		}
+	}
+	if foundProject == nil {
+		return nil
+	}
+
+	preset, err := filterClusterShootPresets(presets, shoot, foundProject)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if preset == nil {
+		return nil
+	}
+
+	if err := applier.ApplyShootPreset(shoot, &preset.Template); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not apply clustershootpreset: %v", err))
+	}
+	return nil
+}
+
+func filterClusterShootPresets(presets []*settingsv1alpha1.ClusterShootPreset, shoot *garden.Shoot, project *garden.Project) (*settingsv1alpha1.ShootPresetSpec, error) {
+	var matchedPreset *settingsv1alpha1.ClusterShootPreset
+
+	for _, preset := range presets {
+		spec := preset.Spec
+		projectSelector, err := metav1.LabelSelectorAsSelector(spec.ProjectSelector)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed: %v for projectSelector: %v", *spec.ProjectSelector, err)
+		}
+		shootSelector, err := metav1.LabelSelectorAsSelector(spec.ShootSelector)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed: %v for shootSelector: %v", *spec.ShootSelector, err)
+		}
+
+		// check if the Shoot / project labels match the selector
+		if !projectSelector.Matches(labels.Set(project.Labels)) || !shootSelector.Matches(labels.Set(shoot.Labels)) {
+			continue
+		}
+
+		if matchedPreset == nil {
+			matchedPreset = preset
+		} else if spec.Weight > matchedPreset.Spec.Weight {
+			matchedPreset = preset
+		} else if spec.Weight == matchedPreset.Spec.Weight && strings.Compare(preset.Name, matchedPreset.Name) > 0 {
+			matchedPreset = preset
+		}
+	}
+
+	if matchedPreset == nil {
+		return nil, nil
+	}
+	return &matchedPreset.Spec.ShootPresetSpec, nil
+}
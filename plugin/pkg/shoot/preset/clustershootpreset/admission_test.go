@@ -0,0 +1,147 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustershootpreset_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	settingsinformer "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/pointer"
+
+	. "github.com/gardener/gardener/plugin/pkg/shoot/preset/clustershootpreset"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ClusterShootPreset", func() {
+	Describe("#Admit", func() {
+		var (
+			admissionHandler        *ClusterShootPreset
+			settingsInformerFactory settingsinformer.SharedInformerFactory
+			gardenInformerFactory   gardeninformers.SharedInformerFactory
+			shoot                   *garden.Shoot
+			project                 *garden.Project
+			preset                  *settingsv1alpha1.ClusterShootPreset
+			expected                *garden.Shoot
+		)
+
+		BeforeEach(func() {
+			namespace := "my-namespace"
+			shoot = &garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: namespace,
+				},
+			}
+
+			project = &garden.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "project-1",
+					Labels: map[string]string{"cost-center": "42"},
+				},
+				Spec: garden.ProjectSpec{
+					Namespace: pointer.StringPtr(namespace),
+				},
+				Status: garden.ProjectStatus{
+					Phase: garden.ProjectReady,
+				},
+			}
+
+			preset = &settingsv1alpha1.ClusterShootPreset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "preset-1",
+				},
+				Spec: settingsv1alpha1.ClusterShootPresetSpec{
+					ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"cost-center": "42"}},
+					ShootPresetSpec: settingsv1alpha1.ShootPresetSpec{
+						ShootSelector: &metav1.LabelSelector{},
+						Weight:        1,
+						Template: settingsv1alpha1.ShootPresetTemplate{
+							Extensions: []gardencorev1alpha1.Extension{{Type: "foo"}},
+						},
+					},
+				},
+			}
+
+			expected = shoot.DeepCopy()
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			settingsInformerFactory = settingsinformer.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetSettingsInformerFactory(settingsInformerFactory)
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+		})
+
+		admit := func() error {
+			attrs := admission.NewAttributesRecord(shoot, nil, garden.Kind("Shoot").WithVersion("v1beta1"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("v1beta1"), "", admission.Create, false, nil)
+			return admissionHandler.Admit(attrs, nil)
+		}
+
+		It("should apply the template if the project selector matches", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPresets().Informer().GetStore().Add(preset)).To(Succeed())
+			Expect(gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot.Spec.Extensions).To(Equal([]garden.Extension{{Type: "foo"}}))
+		})
+
+		It("should do nothing if the project selector does not match", func() {
+			preset.Spec.ProjectSelector.MatchLabels = map[string]string{"cost-center": "43"}
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPresets().Informer().GetStore().Add(preset)).To(Succeed())
+			Expect(gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot).To(Equal(expected))
+		})
+
+		It("should do nothing if the project is not ready", func() {
+			project.Status.Phase = garden.ProjectPending
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPresets().Informer().GetStore().Add(preset)).To(Succeed())
+			Expect(gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot).To(Equal(expected))
+		})
+
+		It("should do nothing if the shoot selector does not match", func() {
+			preset.Spec.ShootSelector.MatchLabels = map[string]string{"not": "existing"}
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPresets().Informer().GetStore().Add(preset)).To(Succeed())
+			Expect(gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot).To(Equal(expected))
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return an error if the project lister is not set", func() {
+			plugin := &ClusterShootPreset{}
+			plugin.SetSettingsInformerFactory(settingsinformer.NewSharedInformerFactory(nil, 0))
+			Expect(plugin.ValidateInitialization()).NotTo(Succeed())
+		})
+
+		It("should return nil error when everything is set", func() {
+			plugin := &ClusterShootPreset{}
+			plugin.SetSettingsInformerFactory(settingsinformer.NewSharedInformerFactory(nil, 0))
+			plugin.SetInternalGardenInformerFactory(gardeninformers.NewSharedInformerFactory(nil, 0))
+			Expect(plugin.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
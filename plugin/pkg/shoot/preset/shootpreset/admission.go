@@ -0,0 +1,168 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootpreset
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	settingsinformer "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	settingslister "github.com/gardener/gardener/pkg/client/settings/listers/settings/v1alpha1"
+	applier "github.com/gardener/gardener/plugin/pkg/shoot/preset"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootPreset"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ShootPreset contains listers and and admission handler.
+type ShootPreset struct {
+	*admission.Handler
+
+	presetLister settingslister.ShootPresetLister
+	readyFunc    admission.ReadyFunc
+}
+
+var (
+	_                             = admissioninitializer.WantsSettingsInformerFactory(&ShootPreset{})
+	_ admission.MutationInterface = &ShootPreset{}
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new ShootPreset admission plugin.
+func New() (*ShootPreset, error) {
+	return &ShootPreset{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (s *ShootPreset) AssignReadyFunc(f admission.ReadyFunc) {
+	s.readyFunc = f
+	s.SetReadyFunc(f)
+}
+
+// SetSettingsInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootPreset) SetSettingsInformerFactory(f settingsinformer.SharedInformerFactory) {
+	presetInformer := f.Settings().V1alpha1().ShootPresets()
+	s.presetLister = presetInformer.Lister()
+
+	readyFuncs = append(readyFuncs, presetInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (s *ShootPreset) ValidateInitialization() error {
+	if s.presetLister == nil {
+		return errors.New("missing shootpreset lister")
+	}
+	return nil
+}
+
+// Admit defaults the specification of a new Shoot with the template of the best matching ShootPreset in its namespace.
+func (s *ShootPreset) Admit(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if s.readyFunc == nil {
+		s.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !s.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	// Ignore all subresource calls
+	// Ignore all operations other than CREATE
+	if len(a.GetSubresource()) != 0 || (a.GetKind().GroupKind() != garden.Kind("Shoot") && a.GetKind().GroupKind() != core.Kind("Shoot")) || a.GetOperation() != admission.Create {
+		return nil
+	}
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	presets, err := s.presetLister.ShootPresets(shoot.Namespace).List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not list existing shootpresets: %v", err))
+	}
+
+	preset, err := filterShootPresets(presets, shoot)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if preset == nil {
+		return nil
+	}
+
+	if err := applier.ApplyShootPreset(shoot, &preset.Template); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not apply shootpreset: %v", err))
+	}
+	return nil
+}
+
+func filterShootPresets(presets []*settingsv1alpha1.ShootPreset, shoot *garden.Shoot) (*settingsv1alpha1.ShootPresetSpec, error) {
+	var matchedPreset *settingsv1alpha1.ShootPreset
+
+	for _, preset := range presets {
+		spec := preset.Spec
+		selector, err := metav1.LabelSelectorAsSelector(spec.ShootSelector)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed: %v for shootSelector: %v", *spec.ShootSelector, err)
+		}
+
+		// check if the Shoot labels match the selector
+		if !selector.Matches(labels.Set(shoot.Labels)) {
+			continue
+		}
+
+		if matchedPreset == nil {
+			matchedPreset = preset
+		} else if spec.Weight > matchedPreset.Spec.Weight {
+			matchedPreset = preset
+		} else if spec.Weight == matchedPreset.Spec.Weight && strings.Compare(preset.Name, matchedPreset.Name) > 0 {
+			matchedPreset = preset
+		}
+	}
+
+	if matchedPreset == nil {
+		return nil, nil
+	}
+	return &matchedPreset.Spec, nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootpreset_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	settingsinformer "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+
+	. "github.com/gardener/gardener/plugin/pkg/shoot/preset/shootpreset"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ShootPreset", func() {
+	Describe("#Admit", func() {
+		var (
+			admissionHandler        *ShootPreset
+			settingsInformerFactory settingsinformer.SharedInformerFactory
+			shoot                   *garden.Shoot
+			preset                  *settingsv1alpha1.ShootPreset
+			expected                *garden.Shoot
+		)
+
+		BeforeEach(func() {
+			namespace := "my-namespace"
+			shoot = &garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: namespace,
+					Labels:    map[string]string{"purpose": "dev"},
+				},
+			}
+
+			preset = &settingsv1alpha1.ShootPreset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "preset-1",
+					Namespace: namespace,
+				},
+				Spec: settingsv1alpha1.ShootPresetSpec{
+					// select everything
+					ShootSelector: &metav1.LabelSelector{},
+					Weight:        1,
+					Template: settingsv1alpha1.ShootPresetTemplate{
+						Maintenance: &gardencorev1alpha1.Maintenance{
+							TimeWindow: &gardencorev1alpha1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+						},
+					},
+				},
+			}
+
+			expected = shoot.DeepCopy()
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			settingsInformerFactory = settingsinformer.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetSettingsInformerFactory(settingsInformerFactory)
+		})
+
+		admit := func(op admission.Operation, subresource string) error {
+			attrs := admission.NewAttributesRecord(shoot, nil, garden.Kind("Shoot").WithVersion("v1beta1"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("v1beta1"), subresource, op, false, nil)
+			return admissionHandler.Admit(attrs, nil)
+		}
+
+		DescribeTable("should do nothing for other operations",
+			func(op admission.Operation) {
+				Expect(settingsInformerFactory.Settings().V1alpha1().ShootPresets().Informer().GetStore().Add(preset)).To(Succeed())
+
+				Expect(admit(op, "")).To(Succeed())
+				Expect(shoot).To(Equal(expected))
+			},
+			Entry("update verb", admission.Update),
+			Entry("delete verb", admission.Delete),
+			Entry("connect verb", admission.Connect),
+		)
+
+		It("should do nothing for subresources", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPresets().Informer().GetStore().Add(preset)).To(Succeed())
+
+			Expect(admit(admission.Create, "status")).To(Succeed())
+			Expect(shoot).To(Equal(expected))
+		})
+
+		It("should do nothing if the shoot selector does not match", func() {
+			preset.Spec.ShootSelector.MatchLabels = map[string]string{"purpose": "production"}
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPresets().Informer().GetStore().Add(preset)).To(Succeed())
+
+			Expect(admit(admission.Create, "")).To(Succeed())
+			Expect(shoot).To(Equal(expected))
+		})
+
+		It("should do nothing if the preset is in another namespace", func() {
+			preset.Namespace = "other-namespace"
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPresets().Informer().GetStore().Add(preset)).To(Succeed())
+
+			Expect(admit(admission.Create, "")).To(Succeed())
+			Expect(shoot).To(Equal(expected))
+		})
+
+		It("should reject objects which are not Shoots", func() {
+			attrs := admission.NewAttributesRecord(&garden.Seed{}, nil, garden.Kind("Shoot").WithVersion("v1beta1"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("v1beta1"), "", admission.Create, false, nil)
+
+			err := admissionHandler.Admit(attrs, nil)
+			Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+		})
+
+		It("should apply the template of the matching preset", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPresets().Informer().GetStore().Add(preset)).To(Succeed())
+
+			Expect(admit(admission.Create, "")).To(Succeed())
+			Expect(shoot.Spec.Maintenance).To(Equal(&garden.Maintenance{
+				TimeWindow: &garden.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+			}))
+		})
+
+		It("should apply the template of the preset with the highest weight", func() {
+			preset2 := preset.DeepCopy()
+			preset2.Name = "preset-0"
+			preset2.Spec.Weight = 100
+			preset2.Spec.Template.Maintenance.TimeWindow.Begin = "010000+0100"
+			preset2.Spec.Template.Maintenance.TimeWindow.End = "020000+0100"
+
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPresets().Informer().GetStore().Add(preset)).To(Succeed())
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPresets().Informer().GetStore().Add(preset2)).To(Succeed())
+
+			Expect(admit(admission.Create, "")).To(Succeed())
+			Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&garden.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}))
+		})
+
+		It("should order presets with the same weight lexicographically", func() {
+			preset2 := preset.DeepCopy()
+			preset2.Name = "preset-2"
+			preset2.Spec.Template.Maintenance.TimeWindow.Begin = "010000+0100"
+			preset2.Spec.Template.Maintenance.TimeWindow.End = "020000+0100"
+
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPresets().Informer().GetStore().Add(preset2)).To(Succeed())
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPresets().Informer().GetStore().Add(preset)).To(Succeed())
+
+			Expect(admit(admission.Create, "")).To(Succeed())
+			Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&garden.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}))
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return an error if the lister is not set", func() {
+			plugin := &ShootPreset{}
+			Expect(plugin.ValidateInitialization()).NotTo(Succeed())
+		})
+
+		It("should return nil error when everything is set", func() {
+			plugin := &ShootPreset{}
+			plugin.SetSettingsInformerFactory(settingsinformer.NewSharedInformerFactory(nil, 0))
+			Expect(plugin.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
@@ -327,15 +327,6 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		}
 	}
 
-	if seed != nil {
-		if shoot.Spec.Networking.Pods == nil && seed.Spec.Networks.ShootDefaults != nil {
-			shoot.Spec.Networking.Pods = seed.Spec.Networks.ShootDefaults.Pods
-		}
-		if shoot.Spec.Networking.Services == nil && seed.Spec.Networks.ShootDefaults != nil {
-			shoot.Spec.Networking.Services = seed.Spec.Networks.ShootDefaults.Services
-		}
-	}
-
 	image, err := getDefaultMachineImage(cloudProfile.Spec.MachineImages)
 	if err != nil {
 		return apierrors.NewBadRequest(err.Error())
@@ -347,6 +338,9 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		}
 	}
 
+	// The in-tree providers are still validated here as long as their deprecated `spec.cloud` section exists. Shoots
+	// of any other provider type are only validated against the generic CloudProfile constraints (see validateProvider),
+	// their provider-specific configuration is validated by the admission components of the provider extensions.
 	switch shoot.Spec.Provider.Type {
 	case "aws":
 		if shoot.Spec.Cloud.AWS.MachineImage == nil {
@@ -359,25 +353,8 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 			}
 		}
 
-		if seed != nil {
-			if shoot.Spec.Cloud.AWS.Networks.Pods == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.AWS.Networks.Pods = seed.Spec.Networks.ShootDefaults.Pods
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "aws", "networks", "pods"), "pods is required"))
-				}
-			}
-
-			if shoot.Spec.Cloud.AWS.Networks.Services == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.AWS.Networks.Services = seed.Spec.Networks.ShootDefaults.Services
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "aws", "networks", "services"), "services is required"))
-				}
-			}
-		}
-
-		allErrs = validateAWS(validationContext)
+		allErrs = append(allErrs, defaultNetworks(&shoot.Spec.Cloud.AWS.Networks.Pods, &shoot.Spec.Cloud.AWS.Networks.Services, seed, field.NewPath("spec", "cloud", "aws", "networks"))...)
+		allErrs = append(allErrs, validateAWS(validationContext)...)
 
 	case "azure":
 		if shoot.Spec.Cloud.Azure.MachineImage == nil {
@@ -390,25 +367,8 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 			}
 		}
 
-		if seed != nil {
-			if shoot.Spec.Cloud.Azure.Networks.Pods == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.Azure.Networks.Pods = seed.Spec.Networks.ShootDefaults.Pods
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "azure", "networks", "pods"), "pods is required"))
-				}
-			}
-
-			if shoot.Spec.Cloud.Azure.Networks.Services == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.Azure.Networks.Services = seed.Spec.Networks.ShootDefaults.Services
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "azure", "networks", "services"), "services is required"))
-				}
-			}
-		}
-
-		allErrs = validateAzure(validationContext)
+		allErrs = append(allErrs, defaultNetworks(&shoot.Spec.Cloud.Azure.Networks.Pods, &shoot.Spec.Cloud.Azure.Networks.Services, seed, field.NewPath("spec", "cloud", "azure", "networks"))...)
+		allErrs = append(allErrs, validateAzure(validationContext)...)
 
 	case "gcp":
 		if shoot.Spec.Cloud.GCP.MachineImage == nil {
@@ -421,25 +381,8 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 			}
 		}
 
-		if seed != nil {
-			if shoot.Spec.Cloud.GCP.Networks.Pods == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.GCP.Networks.Pods = seed.Spec.Networks.ShootDefaults.Pods
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "gcp", "networks", "pods"), "pods is required"))
-				}
-			}
-
-			if shoot.Spec.Cloud.GCP.Networks.Services == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.GCP.Networks.Services = seed.Spec.Networks.ShootDefaults.Services
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "gcp", "networks", "services"), "services is required"))
-				}
-			}
-		}
-
-		allErrs = validateGCP(validationContext)
+		allErrs = append(allErrs, defaultNetworks(&shoot.Spec.Cloud.GCP.Networks.Pods, &shoot.Spec.Cloud.GCP.Networks.Services, seed, field.NewPath("spec", "cloud", "gcp", "networks"))...)
+		allErrs = append(allErrs, validateGCP(validationContext)...)
 
 	case "openstack":
 		if shoot.Spec.Cloud.OpenStack.MachineImage == nil {
@@ -452,25 +395,8 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 			}
 		}
 
-		if seed != nil {
-			if shoot.Spec.Cloud.OpenStack.Networks.Pods == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.OpenStack.Networks.Pods = seed.Spec.Networks.ShootDefaults.Pods
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "openstack", "networks", "pods"), "pods is required"))
-				}
-			}
-
-			if shoot.Spec.Cloud.OpenStack.Networks.Services == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.OpenStack.Networks.Services = seed.Spec.Networks.ShootDefaults.Services
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "openstack", "networks", "services"), "services is required"))
-				}
-			}
-		}
-
-		allErrs = validateOpenStack(validationContext)
+		allErrs = append(allErrs, defaultNetworks(&shoot.Spec.Cloud.OpenStack.Networks.Pods, &shoot.Spec.Cloud.OpenStack.Networks.Services, seed, field.NewPath("spec", "cloud", "openstack", "networks"))...)
+		allErrs = append(allErrs, validateOpenStack(validationContext)...)
 
 	case "packet":
 		if shoot.Spec.Cloud.Packet.MachineImage == nil {
@@ -483,25 +409,8 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 			}
 		}
 
-		if seed != nil {
-			if shoot.Spec.Cloud.Packet.Networks.Pods == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.Packet.Networks.Pods = seed.Spec.Networks.ShootDefaults.Pods
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "packet", "networks", "pods"), "pods is required"))
-				}
-			}
-
-			if shoot.Spec.Cloud.Packet.Networks.Services == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.Packet.Networks.Services = seed.Spec.Networks.ShootDefaults.Services
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "packet", "networks", "services"), "services is required"))
-				}
-			}
-		}
-
-		allErrs = validatePacket(validationContext)
+		allErrs = append(allErrs, defaultNetworks(&shoot.Spec.Cloud.Packet.Networks.Pods, &shoot.Spec.Cloud.Packet.Networks.Services, seed, field.NewPath("spec", "cloud", "packet", "networks"))...)
+		allErrs = append(allErrs, validatePacket(validationContext)...)
 
 	case "alicloud":
 		if shoot.Spec.Cloud.Alicloud.MachineImage == nil {
@@ -514,25 +423,8 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 			}
 		}
 
-		if seed != nil {
-			if shoot.Spec.Cloud.Alicloud.Networks.Pods == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.Alicloud.Networks.Pods = seed.Spec.Networks.ShootDefaults.Pods
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "alicloud", "networks", "pods"), "pods is required"))
-				}
-			}
-
-			if shoot.Spec.Cloud.Alicloud.Networks.Services == nil {
-				if seed.Spec.Networks.ShootDefaults != nil {
-					shoot.Spec.Cloud.Alicloud.Networks.Services = seed.Spec.Networks.ShootDefaults.Services
-				} else {
-					allErrs = append(allErrs, field.Required(field.NewPath("spec", "cloud", "alicloud", "networks", "services"), "services is required"))
-				}
-			}
-		}
-
-		allErrs = validateAlicloud(validationContext)
+		allErrs = append(allErrs, defaultNetworks(&shoot.Spec.Cloud.Alicloud.Networks.Pods, &shoot.Spec.Cloud.Alicloud.Networks.Services, seed, field.NewPath("spec", "cloud", "alicloud", "networks"))...)
+		allErrs = append(allErrs, validateAlicloud(validationContext)...)
 	}
 
 	for idx, worker := range shoot.Spec.Provider.Workers {
@@ -541,23 +433,7 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		}
 	}
 
-	if seed != nil {
-		if shoot.Spec.Networking.Pods == nil {
-			if seed.Spec.Networks.ShootDefaults != nil {
-				shoot.Spec.Networking.Pods = seed.Spec.Networks.ShootDefaults.Pods
-			} else {
-				allErrs = append(allErrs, field.Required(field.NewPath("spec", "networking", "pods"), "pods is required"))
-			}
-		}
-
-		if shoot.Spec.Networking.Services == nil {
-			if seed.Spec.Networks.ShootDefaults != nil {
-				shoot.Spec.Networking.Services = seed.Spec.Networks.ShootDefaults.Services
-			} else {
-				allErrs = append(allErrs, field.Required(field.NewPath("spec", "networking", "services"), "services is required"))
-			}
-		}
-	}
+	allErrs = append(allErrs, defaultNetworks(&shoot.Spec.Networking.Pods, &shoot.Spec.Networking.Services, seed, field.NewPath("spec", "networking"))...)
 
 	allErrs = append(allErrs, validateProvider(validationContext)...)
 
@@ -581,6 +457,34 @@ type validationContext struct {
 	oldShoot     *garden.Shoot
 }
 
+// defaultNetworks defaults the given pod and service networks of a Shoot with the shoot defaults of the Seed. It
+// returns an error for each network which is neither set nor defaulted.
+func defaultNetworks(pods, services **string, seed *garden.Seed, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if seed == nil {
+		return allErrs
+	}
+
+	if *pods == nil {
+		if seed.Spec.Networks.ShootDefaults != nil {
+			*pods = seed.Spec.Networks.ShootDefaults.Pods
+		} else {
+			allErrs = append(allErrs, field.Required(path.Child("pods"), "pods is required"))
+		}
+	}
+
+	if *services == nil {
+		if seed.Spec.Networks.ShootDefaults != nil {
+			*services = seed.Spec.Networks.ShootDefaults.Services
+		} else {
+			allErrs = append(allErrs, field.Required(path.Child("services"), "services is required"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateAWS(c *validationContext) field.ErrorList {
 	var (
 		allErrs = field.ErrorList{}
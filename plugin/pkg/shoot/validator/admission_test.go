@@ -2437,6 +2437,48 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			It("should default the pod and service networks with the shoot defaults of the seed", func() {
+				var (
+					defaultPodsCIDR     = "100.128.0.0/11"
+					defaultServicesCIDR = "100.72.0.0/13"
+				)
+				shoot.Spec.Networking.Pods = nil
+				shoot.Spec.Networking.Services = nil
+				seed.Spec.Networks.ShootDefaults = &garden.ShootNetworks{
+					Pods:     &defaultPodsCIDR,
+					Services: &defaultServicesCIDR,
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Networking.Pods).To(Equal(&defaultPodsCIDR))
+				Expect(shoot.Spec.Networking.Services).To(Equal(&defaultServicesCIDR))
+			})
+
+			It("should reject because the pod and service networks are neither specified nor defaulted by the seed", func() {
+				shoot.Spec.Networking.Pods = nil
+				shoot.Spec.Networking.Services = nil
+				seed.Spec.Networks.ShootDefaults = nil
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.networking.pods"))
+				Expect(err.Error()).To(ContainSubstring("spec.networking.services"))
+			})
+
 			It("should reject because the specified domain is already used by another shoot", func() {
 				anotherShoot := shoot.DeepCopy()
 				anotherShoot.Name = "another-shoot"